@@ -1,2 +1,2 @@
-//go:generate go run ./generator/main.go
+//go:generate go run ./generator
 package asm
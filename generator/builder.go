@@ -0,0 +1,38 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+// generateBuilder writes a Builder method for every generated function. The
+// methods take the same parameters and record the instruction instead of
+// passing it to unsafe.Asm.
+func generateBuilder(funcs []*function, sized []*sizedFunc) error {
+	f := jen.NewFile("x86")
+
+	for _, fn := range funcs {
+		f.Commentf("%s appends %s to the program. See the function %s.", fn.name, fn.mnemonic, fn.name)
+		f.Func().Params(jen.Id("b").Op("*").Id("Builder")).Id(fn.name).ParamsFunc(fn.methodSignature).Block(
+			jen.Id("b").Dot("add").CallFunc(func(g *jen.Group) {
+				g.Lit(fn.mnemonic)
+				g.Lit(fn.name)
+				for _, p := range fn.params {
+					g.Id(p.name)
+				}
+			}),
+		)
+	}
+
+	for _, s := range sized {
+		f.Commentf("%s appends %s to the program with a %d-bit operand size. See the function %s.", s.name, s.fn.mnemonic, s.bits, s.name)
+		f.Func().Params(jen.Id("b").Op("*").Id("Builder")).Id(s.name).ParamsFunc(s.fn.methodSignature).Block(
+			jen.Id("b").Dot("add").CallFunc(func(g *jen.Group) {
+				g.Lit(s.fn.mnemonic)
+				g.Lit(s.name)
+				for _, p := range s.fn.params {
+					g.Id(p.name)
+				}
+			}),
+		)
+	}
+
+	return f.Save("./x86/generated_builder.go")
+}
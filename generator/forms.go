@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/asm/generator/x86spec"
+	"github.com/dave/jennifer/jen"
+)
+
+// generateForms writes the table of instruction forms the encoder selects
+// from: one entry per row of the manual's opcode tables, in the x86spec
+// order (sorted by Intel syntax). Each form is linked to the generated
+// function taking its arguments, and to the function for its operand size
+// if there is one.
+func generateForms(instructions []*x86spec.Instruction, linked map[*x86spec.Instruction]*function, covers map[*x86spec.Instruction]string) error {
+	f := jen.NewFile("x86")
+
+	f.Comment("forms lists every instruction form extracted by x86spec.")
+	f.Var().Id("forms").Op("=").Index().Id("Form").ValuesFunc(func(g *jen.Group) {
+		for _, ins := range instructions {
+			if ins.Opcode == "" || ins.Syntax == "" {
+				continue
+			}
+			g.Line().ValuesFunc(func(g *jen.Group) {
+				g.Id("Syntax").Op(":").Lit(ins.Syntax)
+				g.Id("GoSyntax").Op(":").Lit(ins.GoSyntax)
+				g.Id("GnuSyntax").Op(":").Lit(ins.GnuSyntax)
+				g.Id("Opcode").Op(":").Lit(ins.Opcode)
+				if ins.Encoding != nil {
+					g.Id("Encoding").Op(":").Add(encodingValue(ins.Encoding))
+				}
+				if len(ins.Operands) > 0 {
+					g.Id("Operands").Op(":").Index().Id("FormOperand").ValuesFunc(func(g *jen.Group) {
+						for _, op := range ins.Operands {
+							g.Add(operandValue(op))
+						}
+					})
+				}
+				if ins.Valid32 == "V" {
+					g.Id("Valid32").Op(":").True()
+				}
+				if ins.Valid64 == "V" {
+					g.Id("Valid64").Op(":").True()
+				}
+				if ins.Cpuid != "" {
+					g.Id("Cpuid").Op(":").Lit(ins.Cpuid)
+				}
+				if len(ins.Tags) > 0 {
+					g.Id("Tags").Op(":").Index().String().ValuesFunc(func(g *jen.Group) {
+						for _, t := range ins.Tags {
+							g.Lit(t)
+						}
+					})
+				}
+				if ins.Action != "" {
+					g.Id("Action").Op(":").Lit(ins.Action)
+				}
+				if ins.Multisize == "Y" {
+					g.Id("Multisize").Op(":").True()
+				}
+				if ins.Datasize != 0 {
+					g.Id("Datasize").Op(":").Lit(ins.Datasize)
+				}
+				if ins.Tuple != "" {
+					g.Id("Tuple").Op(":").Lit(string(ins.Tuple))
+				}
+				if fn := linked[ins]; fn != nil {
+					g.Id("Func").Op(":").Lit(fn.name)
+				}
+				if s := covers[ins]; s != "" {
+					g.Id("Sized").Op(":").Lit(s)
+				}
+			})
+		}
+		g.Line()
+	})
+
+	return f.Save("./x86/generated_forms.go")
+}
+
+// encodingValue returns the Encoding literal of a parsed opcode.
+func encodingValue(e *x86spec.Encoding) jen.Code {
+	return jen.Id("Encoding").ValuesFunc(func(g *jen.Group) {
+		if len(e.Prefixes) > 0 {
+			g.Id("Prefixes").Op(":").Add(bytesValue(e.Prefixes))
+		}
+		if e.REX {
+			g.Id("REX").Op(":").True()
+		}
+		if e.REXW {
+			g.Id("REXW").Op(":").True()
+		}
+		if e.REXR {
+			g.Id("REXR").Op(":").True()
+		}
+		if v := e.VEX; v != nil {
+			g.Id("VEX").Op(":").Op("&").Id("VEX").ValuesFunc(func(g *jen.Group) {
+				if v.EVEX {
+					g.Id("EVEX").Op(":").True()
+				}
+				if v.VVVV != "" {
+					g.Id("VVVV").Op(":").Lit(v.VVVV)
+				}
+				if v.L != 0 {
+					g.Id("L").Op(":").Lit(int(v.L))
+				}
+				if v.LIG {
+					g.Id("LIG").Op(":").True()
+				}
+				if v.PP != 0 {
+					g.Id("PP").Op(":").Lit(int(v.PP))
+				}
+				g.Id("MMMMM").Op(":").Lit(int(v.MMMMM))
+				if v.W != 0 {
+					g.Id("W").Op(":").Lit(int(v.W))
+				}
+				if v.WIG {
+					g.Id("WIG").Op(":").True()
+				}
+			})
+		}
+		g.Id("Opcode").Op(":").Add(bytesValue(e.Opcode))
+		if e.Plus != "" {
+			g.Id("Plus").Op(":").Lit(e.Plus)
+		}
+		if e.ModRM {
+			g.Id("ModRM").Op(":").True()
+		}
+		g.Id("Digit").Op(":").Lit(e.Digit)
+		if len(e.Imm) > 0 {
+			g.Id("Imm").Op(":").Index().String().ValuesFunc(func(g *jen.Group) {
+				for _, s := range e.Imm {
+					g.Lit(s)
+				}
+			})
+		}
+		if e.Is4 {
+			g.Id("Is4").Op(":").True()
+		}
+		if len(e.Suffix) > 0 {
+			g.Id("Suffix").Op(":").Add(bytesValue(e.Suffix))
+		}
+	})
+}
+
+// operandValue returns the FormOperand literal of a parsed argument, without
+// the type name.
+func operandValue(op x86spec.Operand) jen.Code {
+	return jen.ValuesFunc(func(g *jen.Group) {
+		g.Id("Syntax").Op(":").Lit(op.Syntax)
+		g.Id("Kind").Op(":").Lit(string(op.Kind))
+		if op.Reg != nil {
+			g.Id("Class").Op(":").Id(registerClasses[op.Reg.Class].class)
+			g.Id("Width").Op(":").Lit(op.Reg.Width)
+		}
+		if op.Fixed != "" {
+			g.Id("Fixed").Op(":").Lit(op.Fixed)
+		}
+		if op.Size != 0 {
+			g.Id("Size").Op(":").Lit(op.Size)
+		}
+		if op.Mem != "" {
+			g.Id("Mem").Op(":").Lit(op.Mem)
+		}
+		if op.MemSize != 0 {
+			g.Id("MemSize").Op(":").Lit(op.MemSize)
+		}
+		if op.Slot != x86spec.SlotNone {
+			g.Id("Slot").Op(":").Lit(string(op.Slot))
+		}
+		if op.Mask {
+			g.Id("Mask").Op(":").True()
+		}
+		if op.Zero {
+			g.Id("Zero").Op(":").True()
+		}
+		if op.Bcst != 0 {
+			g.Id("Bcst").Op(":").Lit(op.Bcst)
+		}
+		if op.Rounding != "" {
+			g.Id("Rounding").Op(":").Lit(op.Rounding)
+		}
+	})
+}
+
+// bytesValue returns a []byte literal written in hex.
+func bytesValue(b []byte) jen.Code {
+	return jen.Index().Byte().ValuesFunc(func(g *jen.Group) {
+		for _, c := range b {
+			g.Id(fmt.Sprintf("0x%02X", c))
+		}
+	})
+}
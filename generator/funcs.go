@@ -0,0 +1,509 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dave/asm/generator/x86spec"
+	"github.com/dave/jennifer/jen"
+)
+
+// function is a generated function: a group of the manual's description
+// table and the forms of the opcode tables it encodes, or the forms of a
+// mnemonic the description table has no group for.
+type function struct {
+	name     string                 // e.g. "ADD_MI"
+	mnemonic string                 // Intel mnemonic passed to unsafe.Asm, e.g. "ADD"
+	suffix   string                 // Op/En suffix of the name, e.g. "_MI", or ""
+	descs    []*x86spec.Instruction // rows of the description table
+	forms    []*x86spec.Instruction // forms linked to the function
+	typed    []*x86spec.Instruction // forms the parameters are typed after
+	params   []param
+}
+
+// param is a parameter of a generated function.
+type param struct {
+	name   string   // e.g. "rm"
+	typ    string   // operand type in package x86, e.g. "RegMem"
+	syntax []string // argument syntaxes of the forms, e.g. "r/m32", "r/m64"
+}
+
+// functions returns the functions of the groups and of the forms no group
+// takes, sorted by name, and the function of each form.
+//
+// A form is linked to the group of its mnemonic that names its Op/En or
+// tuple type, or else whose operand encoding table names the slots of its
+// arguments. The description table is incomplete for some groups, which
+// list no arguments or fewer than the forms have; those take the forms of
+// their mnemonic that are left. Any forms left after that get a function of
+// their own for each way they encode their arguments.
+func functions(instructions []*x86spec.Instruction, grouped map[string]map[string][]*utils) ([]*function, map[*x86spec.Instruction]*function, error) {
+	var funcs []*function
+	byMnemonic := map[string][]*function{} // upper case, as in the forms
+	for _, name := range keys(grouped) {
+		byop := grouped[name]
+		for _, op := range keys(byop) {
+			fn := &function{name: funcName(name, op, byop), mnemonic: name}
+			fn.suffix = strings.TrimPrefix(fn.name, name)
+			for _, u := range byop[op] {
+				fn.descs = append(fn.descs, u.Instruction)
+			}
+			funcs = append(funcs, fn)
+			m := strings.ToUpper(name)
+			byMnemonic[m] = append(byMnemonic[m], fn)
+		}
+	}
+
+	linked := map[*x86spec.Instruction]*function{}
+	var left []*x86spec.Instruction
+	for _, ins := range instructions {
+		if ins.Opcode == "" || ins.Syntax == "" {
+			continue
+		}
+		if fn := groupOf(byMnemonic[strings.ToUpper(ins.Name)], ins); fn != nil {
+			fn.forms = append(fn.forms, ins)
+			linked[ins] = fn
+			continue
+		}
+		left = append(left, ins)
+	}
+
+	// A group whose description lists no arguments keeps the forms taking
+	// the arguments of its first, like AAD without imm8.
+	for _, fn := range funcs {
+		if len(fn.forms) == 0 {
+			continue
+		}
+		fn.typed = typedForms(fn)
+		forms := fn.forms[:0]
+		for _, ins := range fn.forms {
+			if len(ins.Operands) == len(fn.typed[0].Operands) {
+				forms = append(forms, ins)
+				continue
+			}
+			delete(linked, ins)
+			left = append(left, ins)
+		}
+		fn.forms = forms
+	}
+
+	adopted := map[*function]bool{}
+	bySlots := map[string]*function{}
+	for _, ins := range left {
+		if fn := adopter(byMnemonic[strings.ToUpper(ins.Name)], ins, adopted); fn != nil {
+			adopted[fn] = true
+			fn.forms = append(fn.forms, ins)
+			linked[ins] = fn
+			continue
+		}
+		key := ins.Name + "_" + slotKey(ins)
+		fn := bySlots[key]
+		if fn == nil {
+			fn = &function{name: key, mnemonic: ins.Name, suffix: "_" + slotKey(ins)}
+			bySlots[key] = fn
+		}
+		fn.forms = append(fn.forms, ins)
+		linked[ins] = fn
+	}
+
+	// A mnemonic with forms of one kind and no group keeps its own name.
+	names := map[string]bool{}
+	for _, fn := range funcs {
+		names[fn.name] = true
+	}
+	perMnemonic := map[string]int{}
+	for _, fn := range bySlots {
+		perMnemonic[fn.mnemonic]++
+	}
+	for _, key := range keys(bySlots) {
+		fn := bySlots[key]
+		if perMnemonic[fn.mnemonic] == 1 && len(byMnemonic[strings.ToUpper(fn.mnemonic)]) == 0 {
+			fn.name, fn.suffix = fn.mnemonic, ""
+		}
+		if names[fn.name] {
+			return nil, nil, fmt.Errorf("function %s of the forms of %s is also the function of a group", fn.name, fn.forms[0].Syntax)
+		}
+		names[fn.name] = true
+		funcs = append(funcs, fn)
+	}
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].name < funcs[j].name })
+
+	for _, fn := range funcs {
+		if len(fn.typed) == 0 {
+			fn.typed = typedForms(fn)
+		}
+	}
+	for _, fn := range funcs {
+		if len(fn.typed) == 0 {
+			fn.typed = borrowedForms(fn, byMnemonic[strings.ToUpper(fn.mnemonic)])
+		}
+		if len(fn.typed) > 0 {
+			// Pass the mnemonic of the forms, which the description table
+			// sometimes spells in another case, like VSHUFF32x4.
+			fn.mnemonic = fn.typed[0].Name
+		}
+		for _, ins := range fn.typed {
+			if len(ins.Operands) != len(fn.typed[0].Operands) {
+				return nil, nil, fmt.Errorf("function %s takes forms with different arguments: %s and %s", fn.name, fn.typed[0].Syntax, ins.Syntax)
+			}
+		}
+		if len(fn.typed) > 0 {
+			fn.params = params(fn.typed)
+		} else {
+			fn.params = descParams(fn.descs[0])
+		}
+	}
+	return funcs, linked, nil
+}
+
+// groupOf returns the function of the group among fns that the form ins
+// belongs to, or nil. A group naming the form's Op/En wins over one naming
+// its tuple type, which wins over one that only has its slots.
+func groupOf(fns []*function, ins *x86spec.Instruction) *function {
+	var best *function
+	bestScore := 0
+	for _, fn := range fns {
+		desc := fn.descs[0]
+		op := utils{desc}.op()
+		exact := op == utils{ins}.op()
+		tuple := ins.Tuple != "" && strings.HasPrefix(op, string(ins.Tuple))
+		slots := len(desc.Args) > 0 && sameSlots(desc, ins)
+		incomplete := len(desc.Args) == 0 || op != "" && len(desc.Args) != len(ins.Operands)
+		if !slots && !(exact && incomplete) && !(tuple && len(desc.Args) == 0) {
+			continue
+		}
+		score := 1
+		if exact {
+			score += 4
+		}
+		if tuple {
+			score += 2
+		}
+		if score > bestScore {
+			best, bestScore = fn, score
+		}
+	}
+	return best
+}
+
+// adopter returns the function among fns of a group that takes the form
+// ins no group took, or nil: the first whose description is incomplete and
+// has no forms yet, or has adopted forms of the same kind.
+func adopter(fns []*function, ins *x86spec.Instruction, adopted map[*function]bool) *function {
+	for _, fn := range fns {
+		desc := fn.descs[0]
+		if len(desc.Args) > 0 && (utils{desc}.op() != utils{ins}.op()) {
+			continue
+		}
+		if len(fn.forms) == 0 || adopted[fn] && slotKey(fn.forms[0]) == slotKey(ins) {
+			return fn
+		}
+	}
+	return nil
+}
+
+// typedForms returns the forms the parameters of fn are typed after: its
+// forms that encode the arguments in the slots its description names, or
+// else those encoding them like its first form.
+func typedForms(fn *function) []*x86spec.Instruction {
+	var forms []*x86spec.Instruction
+	for _, ins := range fn.forms {
+		if len(fn.descs) > 0 && len(fn.descs[0].Args) > 0 && sameSlots(fn.descs[0], ins) {
+			forms = append(forms, ins)
+		}
+	}
+	if len(forms) > 0 {
+		return forms
+	}
+	for _, ins := range fn.forms {
+		if slotKey(ins) == slotKey(fn.forms[0]) {
+			forms = append(forms, ins)
+		}
+	}
+	return forms
+}
+
+// borrowedForms returns the forms to type the parameters of fn after when
+// it has none of its own: those of the group among fns it repeats, as the
+// description table lists some groups twice, or nil.
+func borrowedForms(fn *function, fns []*function) []*x86spec.Instruction {
+	op := baseOp(utils{fn.descs[0]}.op())
+	for _, other := range fns {
+		if other != fn && len(other.typed) > 0 && baseOp(utils{other.descs[0]}.op()) == op {
+			return other.typed
+		}
+	}
+	return nil
+}
+
+// baseOp returns an Op/En without the tuple type or separators it is
+// sometimes listed with, like "MRI" for "T1S_MRI" or "RVM" for "_RVM".
+func baseOp(op string) string {
+	if i := strings.LastIndex(op, "_"); i >= 0 {
+		return op[i+1:]
+	}
+	return op
+}
+
+// slotKey returns the slots ins encodes its arguments in, in the letters
+// of an Op/En: "RVM" for reg, vvvv and r/m, or "ZO" for none.
+func slotKey(ins *x86spec.Instruction) string {
+	var key string
+	for _, op := range ins.Operands {
+		switch {
+		case op.Kind == x86spec.KindRel:
+			key += "D"
+		case op.Slot == x86spec.SlotReg, op.Slot == x86spec.SlotIs4:
+			key += "R"
+		case op.Slot == x86spec.SlotRM:
+			key += "M"
+		case op.Slot == x86spec.SlotVVVV:
+			key += "V"
+		case op.Slot == x86spec.SlotOpcode:
+			key += "O"
+		case op.Slot == x86spec.SlotImm:
+			key += "I"
+		}
+	}
+	if key == "" {
+		return "ZO"
+	}
+	return key
+}
+
+// sameSlots reports whether the form ins encodes its arguments in the
+// slots the operand encoding table of the description desc names. An
+// argument the table names no slot for, or an implicit operand, matches.
+func sameSlots(desc, ins *x86spec.Instruction) bool {
+	if len(desc.Args) != len(ins.Operands) {
+		return false
+	}
+	for i, arg := range desc.Args {
+		slot, ok := x86spec.EncodingSlot(arg)
+		if ok && ins.Operands[i].Slot != x86spec.SlotNone && slot != ins.Operands[i].Slot {
+			return false
+		}
+	}
+	return true
+}
+
+// descParams returns the parameters of a group without forms to type them
+// after, named after the slots of its operand encoding table. They take
+// any Operand: no form will accept them, but the function stays.
+func descParams(desc *x86spec.Instruction) []param {
+	var params []param
+	used := map[string]int{}
+	for _, arg := range desc.Args {
+		slot, _ := x86spec.EncodingSlot(arg)
+		p := param{name: paramName(x86spec.Operand{Slot: slot}), typ: "Operand", syntax: []string{arg}}
+		if used[p.name]++; used[p.name] > 1 {
+			p.name += string(rune('0' + used[p.name]))
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+// params returns the parameters taking the operands of forms, which all
+// have as many arguments. A parameter is named after the slot its operands
+// are encoded in, and typed to accept the operands of every form.
+func params(forms []*x86spec.Instruction) []param {
+	if len(forms) == 0 {
+		return nil
+	}
+	var params []param
+	used := map[string]int{}
+	for i := range forms[0].Operands {
+		var p param
+		var types []string
+		regmem := true
+		seen := map[string]bool{}
+		var names []string
+		for _, ins := range forms {
+			op := ins.Operands[i]
+			names = append(names, paramName(op))
+			typ, rm := operandType(op)
+			types = append(types, typ)
+			regmem = regmem && rm
+			if !seen[op.Syntax] {
+				seen[op.Syntax] = true
+				p.syntax = append(p.syntax, op.Syntax)
+			}
+		}
+		p.name = mergeNames(forms, i, names)
+		p.typ = mergeTypes(types, regmem)
+		if used[p.name]++; used[p.name] > 1 {
+			p.name += string(rune('0' + used[p.name]))
+		}
+		params = append(params, p)
+	}
+	return params
+}
+
+// mergeNames returns the name of the i'th parameter of forms out of the
+// names of its operands: the name they agree on, or arg. Implicit operands
+// agree with each other, so a parameter taking AL, AX, EAX or RAX is named
+// after the first.
+func mergeNames(forms []*x86spec.Instruction, i int, names []string) string {
+	for j, n := range names {
+		implicit := forms[j].Operands[i].Kind == x86spec.KindImplicit && forms[0].Operands[i].Kind == x86spec.KindImplicit
+		if n != names[0] && !implicit {
+			return "arg"
+		}
+	}
+	return names[0]
+}
+
+// paramName returns the name of a parameter taking op: the register or
+// constant an implicit operand names, or else the slot it is encoded in.
+func paramName(op x86spec.Operand) string {
+	switch op.Kind {
+	case x86spec.KindImplicit:
+		if op.Reg == nil {
+			return "v" + op.Fixed
+		}
+		return strings.ToLower(strings.NewReplacer("(", "", ")", "").Replace(op.Fixed))
+	case x86spec.KindVSIB:
+		return "vsib"
+	case x86spec.KindRel:
+		return "rel"
+	case x86spec.KindMem:
+		if op.Slot == x86spec.SlotImm {
+			return "moffs"
+		}
+	}
+	switch op.Slot {
+	case x86spec.SlotReg:
+		return "reg"
+	case x86spec.SlotRM:
+		return "rm"
+	case x86spec.SlotVVVV:
+		return "vvvv"
+	case x86spec.SlotIs4:
+		return "is4"
+	case x86spec.SlotOpcode:
+		return "opcode"
+	case x86spec.SlotImm:
+		return "imm"
+	}
+	return "arg"
+}
+
+// regMemTypes are the operand types that implement RegMem.
+var regMemTypes = map[string]bool{
+	"Reg": true, "VecReg": true, "MaskReg": true, "MMXReg": true, "BndReg": true,
+	"Mem": true, "RegMem": true,
+}
+
+// operandType returns the operand type in package x86 that takes op, and
+// whether the operands it takes implement RegMem. A register with an
+// opmask or rounding is a Masked or Rounded, so it takes any Register.
+func operandType(op x86spec.Operand) (string, bool) {
+	decorated := op.Mask || op.Zero || op.Rounding != ""
+	switch op.Kind {
+	case x86spec.KindReg, x86spec.KindImplicit:
+		if op.Reg == nil {
+			return "Imm", false
+		}
+		typ := registerClasses[op.Reg.Class].typ
+		if decorated {
+			return "Register", regMemTypes[typ]
+		}
+		return typ, regMemTypes[typ]
+	case x86spec.KindRegMem:
+		return "RegMem", true
+	case x86spec.KindMem, x86spec.KindVSIB:
+		if decorated {
+			return "RegMem", true
+		}
+		return "Mem", true
+	case x86spec.KindRel:
+		return "Rel", false
+	}
+	return "Imm", false
+}
+
+// mergeTypes returns the operand type that takes the operands of all the
+// types: the type itself if they agree, or else Register, RegMem or
+// Operand.
+func mergeTypes(types []string, regmem bool) string {
+	sort.Strings(types)
+	if types[0] == types[len(types)-1] {
+		return types[0]
+	}
+	registers := true
+	for _, t := range types {
+		registers = registers && (t == "Register" || registerType(t))
+	}
+	switch {
+	case registers:
+		return "Register"
+	case regmem:
+		return "RegMem"
+	}
+	return "Operand"
+}
+
+// registerType reports whether typ is the operand type of a register file.
+func registerType(typ string) bool {
+	for _, c := range registerClasses {
+		if c.typ == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// signature adds the typed parameters of the function to g.
+func (fn *function) signature(g *jen.Group) {
+	addParams(g, fn.params, false)
+}
+
+// methodSignature adds the typed parameters of the function's Builder
+// method to g. A branch target there is a Target, so that it can be a
+// Label of the program.
+func (fn *function) methodSignature(g *jen.Group) {
+	addParams(g, fn.params, true)
+}
+
+// addParams adds the typed params to g, with Target for Rel if target.
+func addParams(g *jen.Group, params []param, target bool) {
+	for i, p := range params {
+		g.Id(p.name).Do(func(s *jen.Statement) {
+			// consecutive params of the same type share a single type name
+			if i == len(params)-1 || p.typ != params[i+1].typ {
+				if p.typ == "Rel" && target {
+					s.Id("Target")
+				} else {
+					s.Id(p.typ)
+				}
+			}
+		})
+	}
+}
+
+// cpuids returns the distinct CPUID feature flags of the forms the
+// function takes, sorted.
+func (fn *function) cpuids() []string {
+	var cpuids []string
+	seen := map[string]bool{}
+	for _, ins := range fn.typed {
+		if ins.Cpuid != "" && !seen[ins.Cpuid] {
+			seen[ins.Cpuid] = true
+			cpuids = append(cpuids, ins.Cpuid)
+		}
+	}
+	sort.Strings(cpuids)
+	return cpuids
+}
+
+// page returns the page of the manual describing the function: that of its
+// description, or else of the first description of its mnemonic in pages,
+// or 0 if the manual has none.
+func (fn *function) page(pages map[string]int) int {
+	if len(fn.descs) > 0 {
+		return fn.descs[0].Page
+	}
+	return pages[strings.ToUpper(fn.mnemonic)]
+}
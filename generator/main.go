@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 
 	"strings"
@@ -20,6 +21,7 @@ import (
 const UNSAFE_PACKAGE = "github.com/dave/asm/generator/unsafe-stub"
 
 func main() {
+	flag.Parse()
 	if err := run(); err != nil {
 		fmt.Println("Error")
 		fmt.Println(err)
@@ -27,54 +29,17 @@ func main() {
 	}
 }
 
-var argnames = map[string]string{
-	"":              "arg",
-	"1":             "v1",
-	"3":             "v3",
-	"<XMM0>":        "xmm",
-	"AL":            "al",
-	"AL/AX/EAX/RAX": "al",
-	"AX":            "ax",
-	"AX/EAX/RAX":    "ax",
-	"CL":            "cl",
-	"CS":            "cs",
-	"DS":            "ds",
-	"DX":            "dx",
-	"EAX":           "eax",
-	"ES":            "es",
-	"EVEX.vvvv":     "evex",
-	"FS":            "fs",
-	"GS":            "gs",
-	"ModRM:r/m":     "rm",
-	"ModRM:reg":     "reg",
-	"ModRM:reg (w) ModRM:r/m (r, ModRM:[7:6] must be 11b)": "reg",
-	"Moffs":  "moffs",
-	"Offset": "offset",
-	"RAX":    "rax",
-	"SS":     "ss",
-	"ST(0)":  "st0",
-	"ST(i)":  "sti",
-	"VEX.1vvv (r) ModRM:r/m (r, ModRM:[7:6] must be 11b)": "vex",
-	"VEX.vvvv":                 "vex",
-	"VectorReg(R): VSIB:index": "v",
-	"imm16":                    "imm",
-	"imm8":                     "imm",
-	"imm8/16/32":               "imm",
-	"imm8/16/32/64":            "imm",
-	"imm8[7:4]":                "imm",
-	"implicit XMM0":            "implicit",
-	"iw":                       "iw",
-	"opcode + rd":              "opcode",
-	"vsib":                     "vsib",
-	"vvvv":                     "vvvv",
-}
-
 func run() error {
 
-	config := &x86spec.Config{
-	//DebugPage: "214",
+	if err := generateRegisters(); err != nil {
+		return err
+	}
+
+	snapshot, err := loadSnapshot()
+	if err != nil {
+		return err
 	}
-	instructions := x86spec.Load(config)
+	instructions := snapshot.Instructions
 
 	allargs := map[string]map[string]bool{}
 
@@ -90,95 +55,131 @@ func run() error {
 		if grouped[ins.Name] == nil {
 			grouped[ins.Name] = map[string][]*utils{}
 		}
-		op := strings.Replace(ins.OpEn, "-", "_", -1)
-		op = strings.Replace(op, " ", "_", -1)
+		op := utils{ins}.op()
 		grouped[ins.Name][op] = append(grouped[ins.Name][op], &utils{ins})
 		//fmt.Printf("*** %s %s %#v\n", ins.Name, ins.OpEn, ins)
 	}
 
+	funcs, linked, err := functions(instructions, grouped)
+	if err != nil {
+		return err
+	}
+	sized, covers := sizedFuncs(funcs)
+
+	if err := generateForms(instructions, linked, covers); err != nil {
+		return err
+	}
+
 	fmt.Println("*** ALLARGS")
 	for _, v := range keys(allargs) {
 		fmt.Printf("\"%s\": \"\"\n", v)
 	}
 	fmt.Println("ALLARGS ***")
 
+	pages := map[string]int{} // mnemonic -> page of its first description
+	for _, ins := range instructions {
+		if m := strings.ToUpper(ins.Name); ins.Page != 0 && pages[m] == 0 {
+			pages[m] = ins.Page
+		}
+	}
+
 	f := jen.NewFile("x86")
 	f.ImportName(UNSAFE_PACKAGE, "unsafe")
 
-	for _, name := range keys(grouped) {
-		byop := grouped[name]
-		for _, op := range keys(grouped[name]) {
-			instructions := grouped[name][op]
-			ins := instructions[0]
-
-			fname := name
-			if len(byop) > 1 {
-				fname = name + "_" + op
+	for _, fn := range funcs {
+		// Add the comment with function name and instruction description,
+		// or the syntax of the forms if the manual has no description
+		f.Comment(fn.name)
+		descriptions := map[string]bool{}
+		for _, ins := range fn.descs {
+			if descriptions[ins.Desc] {
+				continue
 			}
-
-			// Add the comment with function name and instruction description
-			f.Comment(fname)
-			descriptions := map[string]bool{}
-			for _, ins := range instructions {
-				if descriptions[ins.Desc] {
-					continue
+			f.Comment(ins.Desc)
+			descriptions[ins.Desc] = true
+		}
+		if len(fn.descs) == 0 {
+			for _, ins := range fn.forms {
+				if !descriptions[ins.Syntax] {
+					f.Comment(ins.Syntax)
+					descriptions[ins.Syntax] = true
 				}
-				f.Comment(ins.Desc)
-				descriptions[ins.Desc] = true
 			}
-			/*
-				buf := &bytes.Buffer{}
-				w := tabwriter.NewWriter(buf, 1, 4, 1, ' ', 0)
-				for _, ins := range instructions {
-					fmt.Fprintf(w, "//\t%s\t%s\t%s\n", ins.Opcode, ins.Desc)
-				}
-				w.Flush()
-				f.Comment(buf.String())
-			*/
-			if len(ins.params()) > 0 {
-				f.Comment("")
-				for i, p := range ins.params() {
-					f.Commentf("%s: %s", p, ins.Args[i])
-				}
+		}
+		if len(fn.params) > 0 {
+			f.Comment("")
+			for _, p := range fn.params {
+				f.Commentf("%s: %s", p.name, strings.Join(p.syntax, ", "))
 			}
+		}
+		if c := fn.cpuids(); len(c) > 0 {
+			f.Comment("")
+			f.Commentf("CPUID: %s", strings.Join(c, ", "))
+		}
+		if page := fn.page(pages); page != 0 {
 			f.Comment("")
-			f.Commentf("Documentation: %s#page=%d", config.URL, ins.Page)
-
-			// Add the Go function
-			f.Func().Id(fname).ParamsFunc(func(g *jen.Group) {
-				for i, p := range ins.params() {
-					g.Id(p).Do(func(s *jen.Statement) {
-						if i == len(ins.Args)-1 {
-							s.Interface() // all params are `interface{}`, so only add type to last item
-						}
-					})
+			f.Commentf("Documentation: %s#page=%d", snapshot.URL, page)
+		}
+
+		// Add the Go function
+		f.Func().Id(fn.name).ParamsFunc(fn.signature).Block(
+			jen.Qual(UNSAFE_PACKAGE, "Asm").CallFunc(func(g *jen.Group) {
+				g.Lit(fn.mnemonic)
+				if len(fn.params) == 0 {
+					g.Nil()
+				}
+				for _, p := range fn.params {
+					g.Id(p.name)
 				}
-			}).Block(
-				jen.Qual(UNSAFE_PACKAGE, "Asm").CallFunc(func(g *jen.Group) {
-					g.Lit(ins.Name)
-					if len(ins.Args) == 0 {
-						g.Nil()
-					} else {
-						for _, p := range ins.params() {
-							g.Id(p)
-						}
-					}
-				}),
-			)
+			}),
+		)
+	}
+	for _, s := range sized {
+		f.Commentf("%s is %s with a %d-bit operand size, for operands", s.name, s.group, s.bits)
+		f.Comment("that do not say it themselves, like memory without a size.")
+		if page := s.fn.page(pages); page != 0 {
+			f.Comment("")
+			f.Commentf("Documentation: %s#page=%d", snapshot.URL, page)
 		}
+		f.Func().Id(s.name).ParamsFunc(s.fn.signature).Block(
+			jen.Qual(UNSAFE_PACKAGE, "Asm").CallFunc(func(g *jen.Group) {
+				g.Lit(s.name)
+				for _, p := range s.fn.params {
+					g.Id(p.name)
+				}
+			}),
+		)
 	}
 	if err := f.Save("./x86/generated.go"); err != nil {
 		return err
 	}
+	if err := generateBuilder(funcs, sized); err != nil {
+		return err
+	}
 	return nil
 }
 
+// funcName returns the name of the function generated for the instructions
+// with the given name and Op/En, out of the groups byop of that name.
+func funcName(name, op string, byop map[string][]*utils) string {
+	if len(byop) > 1 {
+		return name + "_" + op
+	}
+	return name
+}
+
 type utils struct {
 	*x86spec.Instruction
 }
 
 var functionRegex = regexp.MustCompile(`[^a-zA-z0-9]+`)
 
+// op returns the Op/En column in the form used in function names.
+func (u utils) op() string {
+	op := strings.Replace(u.OpEn, "-", "_", -1)
+	return strings.Replace(op, " ", "_", -1)
+}
+
 func (u utils) function() string {
 	return functionRegex.ReplaceAllString(u.GoSyntax, "_")
 }
@@ -190,22 +191,6 @@ func (u utils) instruction() string {
 	return u.GoSyntax
 }
 
-func (u utils) params() []string {
-
-	var args []string
-	for _, arg := range u.Args {
-		arg = strings.TrimSuffix(arg, " (r)")
-		arg = strings.TrimSuffix(arg, " (w)")
-		arg = strings.TrimSuffix(arg, " (r, w)")
-		if argnames[arg] == "" {
-			panic("unknown arg " + arg + " in " + u.Name)
-		}
-		args = append(args, argnames[arg])
-	}
-
-	return args
-}
-
 func keys(i interface{}) []string {
 	var keys []string
 	for _, v := range reflect.ValueOf(i).MapKeys() {
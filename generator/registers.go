@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/dave/asm/generator/x86spec"
+	"github.com/dave/jennifer/jen"
+)
+
+// registerClasses maps the x86spec register file classes to the RegClass
+// constant and the operand type used in package x86.
+var registerClasses = map[string]struct{ class, typ string }{
+	"gp":    {"ClassGP", "Reg"},
+	"ip":    {"ClassIP", "Reg"},
+	"vec":   {"ClassVec", "VecReg"},
+	"mask":  {"ClassMask", "MaskReg"},
+	"seg":   {"ClassSeg", "SegReg"},
+	"x87":   {"ClassX87", "X87Reg"},
+	"mmx":   {"ClassMMX", "MMXReg"},
+	"ctrl":  {"ClassCtrl", "CtrlReg"},
+	"debug": {"ClassDebug", "DebugReg"},
+	"bnd":   {"ClassBnd", "BndReg"},
+}
+
+// generateRegisters writes the register constants and the table describing
+// them. Register values start at 1 so the zero value of each register type
+// means no register.
+func generateRegisters() error {
+	f := jen.NewFile("x86")
+
+	type register struct {
+		x86spec.Register
+		id    string
+		file  *x86spec.RegisterFile
+		class string
+		typ   string
+	}
+	var registers []register
+	for _, file := range x86spec.RegisterFiles {
+		c, ok := registerClasses[file.Class]
+		if !ok {
+			panic("unknown register class " + file.Class)
+		}
+		for _, r := range file.Regs {
+			id := strings.NewReplacer("(", "", ")", "").Replace(r.Name)
+			registers = append(registers, register{r, id, file, c.class, c.typ})
+		}
+	}
+
+	f.Comment("Registers, as described by x86spec.RegisterFiles.")
+	f.Const().DefsFunc(func(g *jen.Group) {
+		for i, r := range registers {
+			g.Id(r.id).Id(r.typ).Op("=").Lit(i + 1)
+		}
+	})
+
+	f.Var().Id("registers").Op("=").Index(jen.Op("...")).Id("RegInfo").ValuesFunc(func(g *jen.Group) {
+		g.Line().Lit(0).Op(":").Values()
+		for _, r := range registers {
+			g.Line().Id(r.id).Op(":").ValuesFunc(func(g *jen.Group) {
+				g.Id("Name").Op(":").Lit(r.Name)
+				g.Id("Class").Op(":").Id(r.class)
+				g.Id("Width").Op(":").Lit(r.file.Width)
+				g.Id("Num").Op(":").Lit(r.Num)
+				if r.REX {
+					g.Id("REX").Op(":").True()
+				}
+				if r.NoREX {
+					g.Id("NoREX").Op(":").True()
+				}
+				if r.EVEX {
+					g.Id("EVEX").Op(":").True()
+				}
+			})
+		}
+		g.Line()
+	})
+
+	return f.Save("./x86/generated_registers.go")
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/dave/asm/generator/x86spec"
+)
+
+// sizedFunc is a function for the forms of one group with a single operand
+// size, named after the Go assembler opcode of that size: ADDQ_MI for the
+// 64-bit forms of ADD_MI, where ADD_MI itself leaves the size to the
+// operands.
+type sizedFunc struct {
+	name  string    // e.g. "ADDQ_MI"
+	group string    // function of the whole group, e.g. "ADD_MI"
+	bits  int       // operand size in bits
+	fn    *function // function of the whole group, for the signature
+}
+
+// identRegex matches the Go opcodes that can name a function, unlike the
+// CALLQ* of an indirect call.
+var identRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]*$`)
+
+// sizedFuncs returns the operand-size specific functions, sorted by name,
+// and the function of each form they cover. A function gets them when its
+// forms come in more than one operand size. A size is left out when its
+// forms do not agree on the Go opcode, when the Go opcode is not an
+// identifier, or when the name is taken by another function.
+func sizedFuncs(funcs []*function) ([]*sizedFunc, map[*x86spec.Instruction]string) {
+	taken := map[string]bool{}
+	for _, fn := range funcs {
+		taken[fn.name] = true
+	}
+	var sized []*sizedFunc
+	covers := map[*x86spec.Instruction]string{}
+	for _, fn := range funcs {
+		bySize := map[int][]*x86spec.Instruction{}
+		for _, ins := range fn.forms {
+			if ins.Multisize == "Y" && ins.Datasize != 0 {
+				bySize[ins.Datasize] = append(bySize[ins.Datasize], ins)
+			}
+		}
+		if len(bySize) < 2 {
+			continue
+		}
+		var sizes []int
+		for bits := range bySize {
+			sizes = append(sizes, bits)
+		}
+		sort.Ints(sizes)
+		for _, bits := range sizes {
+			insts := bySize[bits]
+			goop := utils{insts[0]}.instruction()
+			agree := true
+			for _, ins := range insts {
+				agree = agree && utils{ins}.instruction() == goop
+			}
+			name := goop + fn.suffix
+			if !agree || !identRegex.MatchString(goop) || taken[name] {
+				continue
+			}
+			taken[name] = true
+			sized = append(sized, &sizedFunc{name: name, group: fn.name, bits: bits, fn: fn})
+			for _, ins := range insts {
+				covers[ins] = name
+			}
+		}
+	}
+	sort.Slice(sized, func(i, j int) bool { return sized[i].name < sized[j].name })
+	return sized, covers
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/dave/asm/generator/x86spec"
+)
+
+var (
+	refresh = flag.Bool("refresh", false, "extract the instructions from the manual again and rewrite the snapshot before generating")
+	manual  = flag.String("manual", "", "with -refresh, read the manual from `file`, downloading it if necessary (default: x86manual.pdf)")
+	cache   = flag.String("cache", "", "with -refresh, cache the parsed pages of the manual in `dir`")
+	snap    = flag.String("snapshot", "generator/instructions.json", "generate from the instruction snapshot in `file`")
+)
+
+// loadSnapshot returns the instructions to generate from. They are read
+// from the checked-in snapshot, so that generation needs neither the
+// manual nor the network; with -refresh, they are first extracted from the
+// manual and written to the snapshot.
+func loadSnapshot() (*x86spec.Snapshot, error) {
+	if *refresh {
+		config := &x86spec.Config{
+			File:     *manual,
+			CacheDir: *cache,
+		}
+		insts, diags, err := x86spec.Load(context.Background(), config)
+		for _, d := range diags {
+			fmt.Fprintln(os.Stderr, d)
+		}
+		if err != nil {
+			return nil, err
+		}
+		s := &x86spec.Snapshot{URL: config.URL, Instructions: insts}
+		f, err := os.Create(*snap)
+		if err != nil {
+			return nil, err
+		}
+		if err := x86spec.WriteSnapshot(f, s); err != nil {
+			f.Close()
+			return nil, err
+		}
+		if err := f.Close(); err != nil {
+			return nil, err
+		}
+	}
+	f, err := os.Open(*snap)
+	if err != nil {
+		return nil, fmt.Errorf("%v (run with -refresh to extract it from the manual)", err)
+	}
+	defer f.Close()
+	return x86spec.ReadSnapshot(f)
+}
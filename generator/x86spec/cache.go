@@ -0,0 +1,106 @@
+package x86spec
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// parserVersion is the version of the page parser, part of the key of the
+// page cache. Change it whenever parsePage finds something different in a
+// page, so that listings cached by older versions are not used.
+const parserVersion = 1
+
+// pageCache keeps the listing parsed from each page of a manual in a
+// directory named for the SHA-256 of the manual and the parser version, so
+// that changes to the later stages of Load can be tried without parsing
+// the manual again.
+type pageCache struct {
+	dir string
+}
+
+// openPageCache returns the cache under root for the manual in file.
+func openPageCache(root, file string) (*pageCache, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(root, fmt.Sprintf("%s-v%d", hex.EncodeToString(h.Sum(nil)), parserVersion))
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	return &pageCache{dir: dir}, nil
+}
+
+// cachedListing is a listing as stored in the cache.
+type cachedListing struct {
+	Page      int
+	Manual    string       `json:",omitempty"`
+	Name      string       `json:",omitempty"`
+	MTables   [][][]string `json:",omitempty"`
+	EncTables [][][]string `json:",omitempty"`
+	Compat    string       `json:",omitempty"`
+}
+
+func (c *pageCache) file(pageNum int) string {
+	return filepath.Join(c.dir, fmt.Sprintf("p%04d.json", pageNum))
+}
+
+// load returns the cached listing of the page, if there is one.
+func (c *pageCache) load(pageNum int) (*listing, bool) {
+	data, err := os.ReadFile(c.file(pageNum))
+	if err != nil {
+		return nil, false
+	}
+	var cl cachedListing
+	if err := json.Unmarshal(data, &cl); err != nil || cl.Page != pageNum {
+		return nil, false
+	}
+	return &listing{
+		pageNum:   cl.Page,
+		manual:    cl.Manual,
+		name:      cl.Name,
+		mtables:   cl.MTables,
+		enctables: cl.EncTables,
+		compat:    cl.Compat,
+	}, true
+}
+
+// store caches the listing of a page. The file is written under another
+// name and renamed, so that a partly written listing is never loaded.
+func (c *pageCache) store(p *listing) error {
+	data, err := json.Marshal(cachedListing{
+		Page:      p.pageNum,
+		Manual:    p.manual,
+		Name:      p.name,
+		MTables:   p.mtables,
+		EncTables: p.enctables,
+		Compat:    p.compat,
+	})
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.dir, "page-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), c.file(p.pageNum))
+}
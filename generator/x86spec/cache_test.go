@@ -0,0 +1,75 @@
+package x86spec
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPageCache(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(t.TempDir(), "x86manual.pdf")
+	if err := os.WriteFile(file, syntheticManual(5, 3), 0666); err != nil {
+		t.Fatal(err)
+	}
+	cache, err := openPageCache(root, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &listing{
+		pageNum:   3,
+		name:      "ADD-Add",
+		mtables:   [][][]string{{{"Opcode", "Instruction"}, {"04 ib", "ADD AL, imm8"}}},
+		enctables: [][][]string{{{"Op/En", "Operand 1"}, {"I", "AL/AX/EAX/RAX"}}},
+		compat:    "compat text\n",
+	}
+	if _, ok := cache.load(3); ok {
+		t.Error("load from an empty cache succeeded")
+	}
+	if err := cache.store(p); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := cache.load(3); !ok || !reflect.DeepEqual(got, p) {
+		t.Errorf("load = %+v, %v, want %+v", got, ok, p)
+	}
+
+	// The parsed pages are cached, and read back from the cache.
+	f, err := pdfOpen(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pageNums := []int{1, 2, 3}
+	for _, r := range parsePages(context.Background(), &Config{}, f, cache, pageNums) {
+		if r := <-r; r.err != nil {
+			t.Fatal(r.err)
+		}
+	}
+	results := parsePages(context.Background(), &Config{}, f, cache, pageNums)
+	for i, pageNum := range pageNums {
+		r := <-results[i]
+		if pageNum == 3 && r.parsed.name != p.name {
+			t.Errorf("p.3 was parsed again, not read from the cache")
+		}
+		if pageNum == 1 && r.parsed.manual == "" {
+			t.Errorf("p.1 lost the manual's name in the cache")
+		}
+	}
+
+	// Another manual has another cache.
+	if err := os.WriteFile(file, syntheticManual(6, 3), 0666); err != nil {
+		t.Fatal(err)
+	}
+	other, err := openPageCache(root, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.dir == cache.dir {
+		t.Errorf("two manuals share the cache %s", cache.dir)
+	}
+	if _, ok := other.load(3); ok {
+		t.Error("load from another manual's cache succeeded")
+	}
+}
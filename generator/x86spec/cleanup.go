@@ -5,8 +5,6 @@
 package x86spec
 
 import (
-	"fmt"
-	"os"
 	"sort"
 	"strings"
 )
@@ -80,6 +78,24 @@ var encodeReplace = map[[2]string]string{
 	{"xmm2", "VEX.vvvv"}:       "xmmV",
 	{"ymm1", "VEX.vvvv"}:       "ymmV",
 	{"ymm2", "VEX.vvvv"}:       "ymmV",
+	{"xmm1", "EVEX.vvvv"}:      "xmmV",
+	{"xmm2", "EVEX.vvvv"}:      "xmmV",
+	{"ymm1", "EVEX.vvvv"}:      "ymmV",
+	{"ymm2", "EVEX.vvvv"}:      "ymmV",
+	{"zmm1", "EVEX.vvvv"}:      "zmmV",
+	{"zmm2", "EVEX.vvvv"}:      "zmmV",
+	{"zmm", "ModRM:reg"}:       "zmm1",
+	{"zmm", "ModRM:r/m"}:       "zmm2",
+	{"zmm1", "ModRM:r/m"}:      "zmm2",
+	{"zmm2", "ModRM:reg"}:      "zmm1",
+	{"zmm3", "ModRM:r/m"}:      "zmm2",
+	{"zmm1/m512", "ModRM:r/m"}: "zmm2/m512",
+	{"zmm3/m512", "ModRM:r/m"}: "zmm2/m512",
+	{"zmm2/m512", "ModRM:reg"}: "zmm1/m512",
+	{"k2", "ModRM:reg"}:        "k1",
+	{"k1", "ModRM:r/m"}:        "k2",
+	{"k3", "ModRM:r/m"}:        "k2",
+	{"k2", "EVEX.vvvv"}:        "kV",
 	{"xmm4", "imm8[7:4]"}:      "xmmIH",
 	{"ymm4", "imm8[7:4]"}:      "ymmIH",
 	{"r8", "opcode + rd"}:      "r8op",
@@ -449,6 +465,18 @@ var encodeOK = map[[2]string]bool{
 	{"vm64x", "vsib"}:            true,
 	{"vm32y", "vsib"}:            true,
 	{"vm64y", "vsib"}:            true,
+	{"vm32z", "vsib"}:            true,
+	{"vm64z", "vsib"}:            true,
+	{"xmmV", "EVEX.vvvv"}:        true,
+	{"ymmV", "EVEX.vvvv"}:        true,
+	{"zmm1", "ModRM:reg"}:        true,
+	{"zmm2", "ModRM:r/m"}:        true,
+	{"zmm2/m512", "ModRM:r/m"}:   true,
+	{"zmmV", "EVEX.vvvv"}:        true,
+	{"k1", "ModRM:reg"}:          true,
+	{"k2", "ModRM:r/m"}:          true,
+	{"kV", "EVEX.vvvv"}:          true,
+	{"m512", "ModRM:r/m"}:        true,
 	{"SS", "SS"}:                 true,
 	{"3", "3"}:                   true,
 }
@@ -765,6 +793,95 @@ var extraInsts = []*Instruction{
 	//{syntax: "MOV rmr64, TR0-TR7", opcode: "0F 24 /r", valid32: "N.E.", valid64: "V", tags: []string{"modrm_regonly"}, action: "w,r"},
 	{Syntax: "MOV Sreg, r32/m16", Opcode: "8E /r", Valid32: "V", Valid64: "V", Tags: []string{"operand32"}, Action: "w,r"},
 	{Syntax: "MOV r/m32, Sreg", Opcode: "8C /r", Valid32: "V", Valid64: "V", Tags: []string{"operand32"}, Action: "w,r"},
+
+	// The manual describes these, but the tables of their forms are not
+	// extracted: the opmask instructions and the SHA extensions are laid
+	// out differently, and the VEX forms of the gathers share the pages of
+	// the EVEX ones.
+	{Syntax: "KADDB k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 4A /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KADDD k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W1 4A /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KADDQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 4A /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KADDW k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 4A /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDB k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 41 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDD k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W1 41 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 41 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDW k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 41 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDNB k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 42 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDND k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W1 42 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDNQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 42 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KANDNW k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 42 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KORB k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 45 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KORD k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W1 45 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KORQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 45 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KORW k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 45 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXNORB k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 46 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXNORD k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W1 46 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXNORQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 46 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXNORW k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 46 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXORB k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 47 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXORD k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W1 47 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXORQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 47 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KXORW k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 47 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KNOTB k1, k2", Opcode: "VEX.L0.66.0F.W0 44 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r"},
+	{Syntax: "KNOTD k1, k2", Opcode: "VEX.L0.66.0F.W1 44 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r"},
+	{Syntax: "KNOTQ k1, k2", Opcode: "VEX.L0.0F.W1 44 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r"},
+	{Syntax: "KNOTW k1, k2", Opcode: "VEX.L0.0F.W0 44 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r"},
+	{Syntax: "KORTESTB k1, k2", Opcode: "VEX.L0.66.0F.W0 98 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "r,r"},
+	{Syntax: "KORTESTD k1, k2", Opcode: "VEX.L0.66.0F.W1 98 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "r,r"},
+	{Syntax: "KORTESTQ k1, k2", Opcode: "VEX.L0.0F.W1 98 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "r,r"},
+	{Syntax: "KORTESTW k1, k2", Opcode: "VEX.L0.0F.W0 98 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "r,r"},
+	{Syntax: "KSHIFTLB k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W0 32 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTLD k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W0 33 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTLQ k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W1 33 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTLW k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W1 32 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTRB k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W0 30 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTRD k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W0 31 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTRQ k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W1 31 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KSHIFTRW k1, k2, imm8", Opcode: "VEX.L0.66.0F3A.W1 30 /r ib", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KUNPCKBW k1, kV, k2", Opcode: "VEX.NDS.L1.66.0F.W0 4B /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KUNPCKDQ k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W1 4B /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KUNPCKWD k1, kV, k2", Opcode: "VEX.NDS.L1.0F.W0 4B /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r,r"},
+	{Syntax: "KMOVB k1, k2/m8", Opcode: "VEX.L0.66.0F.W0 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Action: "w,r", OpEn: "RM"},
+	{Syntax: "KMOVB m8, k1", Opcode: "VEX.L0.66.0F.W0 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_memonly"}, Action: "w,r", OpEn: "MR"},
+	{Syntax: "KMOVB k1, rmr32", Opcode: "VEX.L0.66.0F.W0 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVB rmr32, k1", Opcode: "VEX.L0.66.0F.W0 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512DQ", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVD k1, k2/m32", Opcode: "VEX.L0.66.0F.W1 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Action: "w,r", OpEn: "RM"},
+	{Syntax: "KMOVD m32, k1", Opcode: "VEX.L0.66.0F.W1 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_memonly"}, Action: "w,r", OpEn: "MR"},
+	{Syntax: "KMOVD k1, rmr32", Opcode: "VEX.L0.F2.0F.W0 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVD rmr32, k1", Opcode: "VEX.L0.F2.0F.W0 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVQ k1, k2/m64", Opcode: "VEX.L0.0F.W1 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Action: "w,r", OpEn: "RM"},
+	{Syntax: "KMOVQ m64, k1", Opcode: "VEX.L0.0F.W1 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_memonly"}, Action: "w,r", OpEn: "MR"},
+	{Syntax: "KMOVQ k1, rmr64", Opcode: "VEX.L0.F2.0F.W1 92 /r", Valid32: "N.E.", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVQ rmr64, k1", Opcode: "VEX.L0.F2.0F.W1 93 /r", Valid32: "N.E.", Valid64: "V", Cpuid: "AVX512BW", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVW k1, k2/m16", Opcode: "VEX.L0.0F.W0 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Action: "w,r", OpEn: "RM"},
+	{Syntax: "KMOVW m16, k1", Opcode: "VEX.L0.0F.W0 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_memonly"}, Action: "w,r", OpEn: "MR"},
+	{Syntax: "KMOVW k1, rmr32", Opcode: "VEX.L0.0F.W0 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "KMOVW rmr32, k1", Opcode: "VEX.L0.0F.W0 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"modrm_regonly"}, Action: "w,r", OpEn: "RR"},
+	{Syntax: "SHA1MSG1 xmm1, xmm2/m128", Opcode: "0F 38 C9 /r", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r"},
+	{Syntax: "SHA1MSG2 xmm1, xmm2/m128", Opcode: "0F 38 CA /r", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r"},
+	{Syntax: "SHA1NEXTE xmm1, xmm2/m128", Opcode: "0F 38 C8 /r", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r"},
+	{Syntax: "SHA256MSG1 xmm1, xmm2/m128", Opcode: "0F 38 CC /r", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r"},
+	{Syntax: "SHA256MSG2 xmm1, xmm2/m128", Opcode: "0F 38 CD /r", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r"},
+	{Syntax: "SHA1RNDS4 xmm1, xmm2/m128, imm8", Opcode: "0F 3A CC /r ib", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r,r"},
+	{Syntax: "SHA256RNDS2 xmm1, xmm2/m128, <XMM0>", Opcode: "0F 38 CB /r", Valid32: "V", Valid64: "V", Cpuid: "SHA", Action: "rw,r,r"},
+	{Syntax: "RDPID rmr32", Opcode: "F3 0F C7 /7", Valid32: "V", Valid64: "N.E.", Cpuid: "RDPID", Tags: []string{"modrm_regonly"}, Action: "w"},
+	{Syntax: "RDPID rmr64", Opcode: "F3 0F C7 /7", Valid32: "N.E.", Valid64: "V", Cpuid: "RDPID", Tags: []string{"modrm_regonly"}, Action: "w"},
+	{Syntax: "VGATHERDPD xmm1, vm32x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W1 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERDPD ymm1, vm32x, ymmV", Opcode: "VEX.DDS.256.66.0F38.W1 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERDPS xmm1, vm32x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W0 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERDPS ymm1, vm32y, ymmV", Opcode: "VEX.DDS.256.66.0F38.W0 92 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERQPD xmm1, vm64x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W1 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERQPD ymm1, vm64y, ymmV", Opcode: "VEX.DDS.256.66.0F38.W1 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERQPS xmm1, vm64x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W0 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VGATHERQPS xmm1, vm64y, xmmV", Opcode: "VEX.DDS.256.66.0F38.W0 93 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERDD xmm1, vm32x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W0 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERDD ymm1, vm32y, ymmV", Opcode: "VEX.DDS.256.66.0F38.W0 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERDQ xmm1, vm32x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W1 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERDQ ymm1, vm32x, ymmV", Opcode: "VEX.DDS.256.66.0F38.W1 90 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERQD xmm1, vm64x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W0 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERQD xmm1, vm64y, xmmV", Opcode: "VEX.DDS.256.66.0F38.W0 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERQQ xmm1, vm64x, xmmV", Opcode: "VEX.DDS.128.66.0F38.W1 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
+	{Syntax: "VPGATHERQQ ymm1, vm64y, ymmV", Opcode: "VEX.DDS.256.66.0F38.W1 91 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX2", Action: "rw,r,rw", OpEn: "RMV"},
 }
 
 type fixer func(*Instruction)
@@ -817,9 +934,9 @@ func fixOpcode(opcode string) fixer {
 	}
 }
 
-func cleanup(config *Config, insts []*Instruction) []*Instruction {
+func cleanup(l *loader, insts []*Instruction) []*Instruction {
 	var haveOp map[string]bool
-	if config.onlySomePages() {
+	if l.onlySomePages() {
 		haveOp = map[string]bool{}
 	}
 
@@ -865,7 +982,7 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 		} else if len(args) == 0 && len(inst.Args) == 1 && inst.Args[0] == "NA" {
 			inst.Args = []string{}
 		} else if len(args) != len(inst.Args) {
-			fmt.Fprintf(os.Stderr, "p.%d: %s has %d args but %d encoding details:\n\t%s\n", inst.Page, inst.Syntax, len(args), len(inst.Args), strings.Join(inst.Args, "; "))
+			l.report(inst.Page, inst.Syntax, DiagArgs, "%d args but %d encoding details:\n\t%s", len(args), len(inst.Args), strings.Join(inst.Args, "; "))
 			inst.Syntax = joinSyntax(op, args)
 			continue
 		}
@@ -874,6 +991,7 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 		for i, arg := range args {
 			arg = strings.TrimSpace(arg)
 			arg = strings.TrimRight(arg, "*")
+			arg, decor := splitDecorations(arg)
 			if (arg == "reg" || strings.HasPrefix(arg, "reg/")) && containsAll(inst.Desc, "upper bits", "r64", "zero") {
 				arg = "r32" + strings.TrimPrefix(arg, "reg")
 			}
@@ -913,7 +1031,7 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 			case i < len(opAction[op]):
 				action = append(action, opAction[op][i])
 			default:
-				fmt.Fprintf(os.Stderr, "p.%d: %s has encoding %s for %s but no r/w annotations\n", inst.Page, inst.Syntax, enc, arg)
+				l.report(inst.Page, inst.Syntax, DiagAction, "encoding %s for %s but no r/w annotations", enc, arg)
 				action = append(action, "?")
 			}
 
@@ -931,11 +1049,11 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 				addTag(inst, "modrm_regonly")
 				arg = "rmr" + arg[1:]
 			}
-			if (arg == "xmm2" || arg == "ymm2") && enc == "ModRM:r/m" {
+			if (arg == "xmm2" || arg == "ymm2" || arg == "zmm2" || arg == "k2") && enc == "ModRM:r/m" {
 				addTag(inst, "modrm_regonly")
 			}
 
-			if (arg == "m8" || arg == "m16" || arg == "m32" || arg == "m64" || arg == "m128" || arg == "m256") && enc == "ModRM:r/m" {
+			if (arg == "m8" || arg == "m16" || arg == "m32" || arg == "m64" || arg == "m128" || arg == "m256" || arg == "m512") && enc == "ModRM:r/m" {
 				addTag(inst, "modrm_memonly")
 			}
 
@@ -948,10 +1066,10 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 			}
 
 			if !encodeOK[[2]string{arg, enc}] {
-				fmt.Fprintf(os.Stderr, "p.%d: %s has invalid encoding %s for %s\n\t{%q, %q}: true,\n", inst.Page, inst.Syntax, enc, arg, arg, enc)
+				l.report(inst.Page, inst.Syntax, DiagEncoding, "invalid encoding %s for %s\n\t{%q, %q}: true,", enc, arg, arg, enc)
 			}
 
-			args[i] = arg
+			args[i] = arg + decor
 
 			// Intel SETcc and others are missing the /r.
 			// But CALL rel16 and CALL rel32 have a bad encoding table so ignore the ModRM there.
@@ -960,7 +1078,7 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 			}
 			if strings.HasPrefix(enc, "ModRM:reg") && !strings.Contains(inst.Opcode, "/r") {
 				// The opcode is taken up with something else. Bug in table.
-				fmt.Fprintf(os.Stderr, "p.%d: %s has invalid encoding %s: no reg field in %s\n", inst.Page, inst.Syntax, arg, inst.Opcode)
+				l.report(inst.Page, inst.Syntax, DiagEncoding, "invalid encoding %s: no reg field in %s", arg, inst.Opcode)
 			}
 			// XBEGIN is missing cw cd.
 			if enc == "Offset" && arg == "rel16" && !strings.Contains(inst.Opcode, " cw") {
@@ -1005,7 +1123,7 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 			}
 		}
 
-		if config.onlySomePages() {
+		if l.onlySomePages() {
 			op, _ := splitSyntax(inst.Syntax)
 			haveOp[op] = true
 		}
@@ -1114,6 +1232,33 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 		}
 	}
 
+	// A pseudo-op needs the operand-size prefix of the op it stands in
+	// for, but only the op was paired by operand size above: JC rel16
+	// takes the operand16 tag of JB rel16.
+	sizeTags := map[string][]string{}
+	sizeKey := func(inst *Instruction) string {
+		_, args := splitSyntax(inst.Syntax)
+		return inst.Opcode + " " + inst.Valid32 + " " + inst.Valid64 + " " + strings.Join(args, ",")
+	}
+	for _, inst := range insts {
+		if hasTag(inst, "pseudo") {
+			continue
+		}
+		for _, tag := range inst.Tags {
+			if strings.HasPrefix(tag, "operand") {
+				sizeTags[sizeKey(inst)] = append(sizeTags[sizeKey(inst)], tag)
+			}
+		}
+	}
+	for _, inst := range insts {
+		if !hasTag(inst, "pseudo") || hasTag(inst, "operand16") || hasTag(inst, "operand32") || hasTag(inst, "operand64") {
+			continue
+		}
+		for _, tag := range sizeTags[sizeKey(inst)] {
+			addTag(inst, tag)
+		}
+	}
+
 	// Last ditch effort. Manual fixes.
 	// Some things are too hard to infer.
 	for _, inst := range insts {
@@ -1125,15 +1270,14 @@ func cleanup(config *Config, insts []*Instruction) []*Instruction {
 
 	sort.Sort(bySeq(insts))
 
-	if config.onlySomePages() {
-		for _, inst := range extraInsts {
-			op, _ := splitSyntax(inst.Syntax)
-			if haveOp[op] {
-				insts = append(insts, inst)
-			}
+	for _, extra := range extraInsts {
+		op, _ := splitSyntax(extra.Syntax)
+		if l.onlySomePages() && !haveOp[op] {
+			continue
 		}
-	} else {
-		insts = append(insts, extraInsts...)
+		inst := *extra
+		inst.Name = op
+		insts = append(insts, &inst)
 	}
 	return insts
 }
@@ -1321,3 +1465,19 @@ func containsAll(x string, targ ...string) bool {
 	}
 	return true
 }
+
+// splitDecorations splits the EVEX decorations off an argument, so that
+// "zmm1 {k1}{z}" becomes "zmm1" and "{k1}{z}", and "zmm3/m512/m32bcst{er}"
+// becomes "zmm3/m512" and "/m32bcst{er}". The decorations are returned
+// without spaces, to be put back once the argument itself is cleaned up.
+func splitDecorations(arg string) (string, string) {
+	var decor string
+	if i := strings.Index(arg, "{"); i >= 0 {
+		arg, decor = strings.TrimSpace(arg[:i]), strings.Replace(arg[i:], " ", "", -1)
+	}
+	if strings.HasSuffix(arg, "bcst") {
+		i := strings.LastIndex(arg, "/")
+		arg, decor = arg[:i], arg[i:]+decor
+	}
+	return arg, decor
+}
@@ -0,0 +1,109 @@
+package x86spec
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// csvColumns is the number of fields of each line of the CSV format.
+const csvColumns = 11
+
+// csvHeader is the start of the comment that begins a CSV file.
+const csvHeader = "# x86 instruction set description version "
+
+// WriteCSV writes the instructions in the CSV format described in the
+// package documentation, after a comment giving the format version.
+func WriteCSV(w io.Writer, insts []*Instruction) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s%s\n", csvHeader, specFormatVersion)
+	for _, inst := range insts {
+		datasize := ""
+		if inst.Datasize != 0 {
+			datasize = fmt.Sprint(inst.Datasize)
+		}
+		writeRecord(bw, inst.Syntax, inst.GoSyntax, inst.GnuSyntax, inst.Opcode, inst.Valid32, inst.Valid64, inst.Cpuid, strings.Join(inst.Tags, ","), inst.Action, inst.Multisize, datasize)
+	}
+	return bw.Flush()
+}
+
+// Note: not using encoding/csv because we want the CSV to use quotes always,
+// so that it is a little easier to process with non-CSV tools like grep,
+// but the encoding/csv package does not have an "always quote" writing mode.
+func writeRecord(w io.Writer, args ...string) {
+	for i, arg := range args {
+		if i > 0 {
+			fmt.Fprintf(w, ",")
+		}
+		fmt.Fprintf(w, `"%s"`, strings.Replace(arg, `"`, `""`, -1))
+	}
+	fmt.Fprintf(w, "\n")
+}
+
+var csvHeaderRE = regexp.MustCompile(`^` + csvHeader + `([0-9.]+)(,.*)?$`)
+
+// ReadCSV reads instructions in the CSV format, as written by WriteCSV or
+// by the x86spec command. The file must begin with the comment giving the
+// format version, which must be the version WriteCSV writes; later lines
+// starting with # are ignored. Only the fields of the CSV are set, and the
+// Encoding and Operands parsed from them. Errors give the line they are
+// found at.
+func ReadCSV(r io.Reader) ([]*Instruction, error) {
+	br := bufio.NewReader(r)
+	header, err := br.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	m := csvHeaderRE.FindStringSubmatch(strings.TrimRight(header, "\r\n"))
+	if m == nil {
+		return nil, fmt.Errorf("x86spec: line 1: want %q followed by the format version", csvHeader)
+	}
+	if m[1] != specFormatVersion {
+		return nil, fmt.Errorf("x86spec: line 1: format version is %s, want %s", m[1], specFormatVersion)
+	}
+
+	// The line numbers of cr do not count the header.
+	cr := csv.NewReader(br)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = csvColumns
+	var insts []*Instruction
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if pe, ok := err.(*csv.ParseError); ok {
+				return nil, fmt.Errorf("x86spec: line %d: %v", pe.Line+1, pe.Err)
+			}
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		inst := &Instruction{
+			Syntax:    rec[0],
+			GoSyntax:  rec[1],
+			GnuSyntax: rec[2],
+			Opcode:    rec[3],
+			Valid32:   rec[4],
+			Valid64:   rec[5],
+			Cpuid:     rec[6],
+			Action:    rec[8],
+			Multisize: rec[9],
+		}
+		if rec[7] != "" {
+			inst.Tags = strings.Split(rec[7], ",")
+		}
+		if rec[10] != "" {
+			if inst.Datasize, err = strconv.Atoi(rec[10]); err != nil {
+				return nil, fmt.Errorf("x86spec: line %d: data size %q is not a number", line+1, rec[10])
+			}
+		}
+		inst.parseFields()
+		insts = append(insts, inst)
+	}
+	return insts, nil
+}
@@ -0,0 +1,103 @@
+package x86spec
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCSV(t *testing.T) {
+	insts := []*Instruction{
+		{Syntax: "AAA", GoSyntax: "AAA", GnuSyntax: "aaa", Opcode: "37", Valid32: "V", Valid64: "I"},
+		{Syntax: "SHR r/m32, imm8", GoSyntax: "SHRL imm8, r/m32", GnuSyntax: "shrl imm8, r/m32", Opcode: "C1 /5 ib", Valid32: "V", Valid64: "V", Tags: []string{"operand32"}, Action: "rw,r", Multisize: "Y", Datasize: 32},
+		{Syntax: "VADDPS xmm1, xmmV, xmm2/m128", GoSyntax: "VADDPS xmm2/m128, xmmV, xmm1", GnuSyntax: "vaddps xmm2/m128, xmmV, xmm1", Opcode: "VEX.NDS.128.0F.WIG 58 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX", Tags: []string{"modrm_memonly", "pseudo"}, Action: "w,r,r", Datasize: 128},
+	}
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, insts); err != nil {
+		t.Fatal(err)
+	}
+	want := `# x86 instruction set description version 0.2
+"AAA","AAA","aaa","37","V","I","","","","",""
+"SHR r/m32, imm8","SHRL imm8, r/m32","shrl imm8, r/m32","C1 /5 ib","V","V","","operand32","rw,r","Y","32"
+`
+	if !strings.HasPrefix(buf.String(), want) {
+		t.Errorf("WriteCSV wrote:\n%s\nwant it to begin:\n%s", buf.String(), want)
+	}
+
+	got, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(insts) {
+		t.Fatalf("ReadCSV read %d instructions, want %d", len(got), len(insts))
+	}
+	for i, inst := range got {
+		if inst.Encoding == nil || inst.Operands == nil && inst.Syntax != "AAA" {
+			t.Errorf("%s: Encoding or Operands not parsed", inst.Syntax)
+		}
+		inst.Encoding, inst.Operands = nil, nil
+		if !reflect.DeepEqual(inst, insts[i]) {
+			t.Errorf("ReadCSV = %+v, want %+v", inst, insts[i])
+		}
+	}
+
+	// The x86spec command writes a date and more comments.
+	x86csv := `# x86 instruction set description version 0.2, 2017-09-28
+# Based on Intel Instruction Set Reference #325383-057US, December 2015.
+# https://golang.org/x/arch/x86/x86spec
+"AAA","AAA","aaa","37","V","I","","","","",""
+`
+	if got, err := ReadCSV(strings.NewReader(x86csv)); err != nil || len(got) != 1 {
+		t.Errorf("ReadCSV of x86spec output = %v, %v", got, err)
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	tests := []struct {
+		in, err string
+	}{
+		{`"AAA","AAA","aaa","37","V","I","","","","",""`, "line 1: want"},
+		{"# x86 instruction set description version 0.1\n", "line 1: format version is 0.1, want 0.2"},
+		{"# x86 instruction set description version 0.2\n# comment\n\"AAA\",\"AAA\",\"aaa\",\"37\"\n", "line 3: wrong number of fields"},
+		{"# x86 instruction set description version 0.2\n\"AAA\",\"AAA\",\"aaa\",\"37\",\"V\",\"I\",\"\",\"\",\"\",\"\",\"\"\n\"AAA\",\"AAA\",\"aaa\",\"37\",\"V\",\"I\",\"\",\"\",\"\",\"\",\"x\"\n", `line 3: data size "x"`},
+		{"# x86 instruction set description version 0.2\n\"AAA\",\"A\"A\"\n", "line 2:"},
+	}
+	for _, tt := range tests {
+		_, err := ReadCSV(strings.NewReader(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("ReadCSV(%q) = %v, want an error containing %q", tt.in, err, tt.err)
+		}
+	}
+}
+
+// TestSnapshotCSV checks that the CSV fields of the generator's snapshot
+// survive a round trip through the CSV format.
+func TestSnapshotCSV(t *testing.T) {
+	f, err := os.Open("../instructions.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s, err := ReadSnapshot(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, s.Instructions); err != nil {
+		t.Fatal(err)
+	}
+	want := buf.String()
+	got, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again bytes.Buffer
+	if err := WriteCSV(&again, got); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != want {
+		t.Error("the snapshot does not survive WriteCSV and ReadCSV")
+	}
+}
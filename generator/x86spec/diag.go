@@ -0,0 +1,54 @@
+package x86spec
+
+import (
+	"context"
+	"fmt"
+)
+
+// DiagnosticKind classifies the diagnostics of Load.
+type DiagnosticKind string
+
+const (
+	DiagManual      DiagnosticKind = "manual"           // the manual's order number and date
+	DiagCompat      DiagnosticKind = "compat"           // a compatibility statement, reported if Config.Compat is set
+	DiagNoMnemonics DiagnosticKind = "no mnemonics"     // an instruction page without a mnemonic table
+	DiagUnexpected  DiagnosticKind = "unexpected"       // a heading or table outside an instruction listing
+	DiagMissing     DiagnosticKind = "missing"          // an instruction in the table of contents without a listing
+	DiagBadTable    DiagnosticKind = "bad table"        // a mnemonic table that could not be read
+	DiagArgs        DiagnosticKind = "args"             // a syntax whose arguments do not match its encoding details
+	DiagAction      DiagnosticKind = "no r/w"           // an argument without read/write annotations
+	DiagEncoding    DiagnosticKind = "invalid encoding" // an argument encoding or opcode that is not understood
+	DiagOperand     DiagnosticKind = "invalid operand"  // an argument that ParseOperand rejects
+)
+
+// Diagnostic is a problem that Load worked around, or a note about the
+// manual, at a page of the manual.
+type Diagnostic struct {
+	Page    int    // page of the manual, or 0
+	Syntax  string // syntax of the instruction concerned, if any
+	Kind    DiagnosticKind
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	s := d.Message
+	if d.Syntax != "" {
+		s = d.Syntax + ": " + s
+	}
+	if d.Page != 0 {
+		s = fmt.Sprintf("p.%d: %s", d.Page, s)
+	}
+	return s
+}
+
+// loader is the state of a call to Load.
+type loader struct {
+	*Config
+	ctx   context.Context
+	diags []Diagnostic
+}
+
+// report records a diagnostic.
+func (l *loader) report(page int, syntax string, kind DiagnosticKind, format string, args ...interface{}) {
+	l.diags = append(l.diags, Diagnostic{Page: page, Syntax: syntax, Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
@@ -0,0 +1,188 @@
+// Copyright 2016 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86spec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Encoding is the parsed form of an instruction's Opcode column, such as
+// "REX.W 81 /0 id" or "VEX.NDS.LIG.F2.0F.W0 2A /r".
+type Encoding struct {
+	Prefixes []byte   // legacy prefixes that are part of the opcode: 9B, 66, F2 or F3
+	REX      bool     // a REX prefix is required, even with no bits set
+	REXW     bool     // REX.W
+	REXR     bool     // REX.R, which selects CR8
+	VEX      *VEX     // the VEX or EVEX prefix, or nil
+	Opcode   []byte   // opcode bytes, including the 0F escapes of legacy opcodes
+	Plus     string   // register number added to the last opcode byte: "rb", "rw", "rd", "ro" or "i", or ""
+	ModRM    bool     // a ModRM byte follows the opcode, for /r or /digit
+	Digit    int      // ModRM reg field for /digit, or -1
+	Imm      []string // immediates and code offsets in order: "ib", "iw", "id", "io", "cb", "cw", "cd", "cp" or "cm"
+	Is4      bool     // /is4: a register in the top four bits of an immediate byte
+	Suffix   []byte   // opcode bytes after the immediates, as in ENTER imm16, 0
+}
+
+// VEX holds the fields of a VEX or EVEX prefix, as in
+// "EVEX.NDS.512.66.0F38.W1".
+type VEX struct {
+	EVEX  bool
+	VVVV  string // role of the vvvv register: "NDS", "NDD" or "DDS", or "" if unused
+	L     byte   // vector length: 0 for 128 bits or LZ, 1 for 256 and 2 for 512
+	LIG   bool   // the vector length is ignored
+	PP    byte   // implied prefix: 0 for none, 1 for 66, 2 for F3 and 3 for F2
+	MMMMM byte   // opcode map: 1 for 0F, 2 for 0F38 and 3 for 0F3A
+	W     byte
+	WIG   bool // W is ignored
+}
+
+// ParseEncoding parses the Opcode column of an instruction. It returns an
+// error for anything it does not understand, rather than guessing.
+func ParseEncoding(s string) (*Encoding, error) {
+	e := &Encoding{Digit: -1}
+	tokens := strings.Fields(s)
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t == "9B" && i == 0 && len(tokens) > 1,
+			(t == "66" || t == "F2" || t == "F3") && len(e.Opcode) == 0 && e.VEX == nil && i < len(tokens)-1:
+			e.Prefixes = append(e.Prefixes, hexByte(t))
+		case t == "REX", t == "REX.W", t == "REX.R":
+			e.REX = e.REX || t == "REX"
+			e.REXW = e.REXW || t == "REX.W"
+			e.REXR = e.REXR || t == "REX.R"
+			if i+1 < len(tokens) && tokens[i+1] == "+" {
+				// "REX.R + 0F 22 /0", as the manual writes it.
+				i++
+			}
+		case strings.HasPrefix(t, "VEX.") || strings.HasPrefix(t, "EVEX."):
+			if e.VEX != nil || len(e.Opcode) > 0 {
+				return nil, fmt.Errorf("opcode %q: misplaced %s", s, t)
+			}
+			v, err := parseVEX(t)
+			if err != nil {
+				return nil, fmt.Errorf("opcode %q: %v", s, err)
+			}
+			e.VEX = v
+		case isHexByte(t):
+			if e.ModRM || len(e.Imm) > 0 || e.Plus != "" {
+				e.Suffix = append(e.Suffix, hexByte(t))
+			} else {
+				e.Opcode = append(e.Opcode, hexByte(t))
+			}
+		case len(t) > 3 && isHexByte(t[:2]) && t[2] == '+':
+			switch t[3:] {
+			case "rb", "rw", "rd", "ro", "i":
+			default:
+				return nil, fmt.Errorf("opcode %q: unknown token %q", s, t)
+			}
+			if e.Plus != "" || e.ModRM {
+				return nil, fmt.Errorf("opcode %q: misplaced %s", s, t)
+			}
+			e.Opcode = append(e.Opcode, hexByte(t[:2]))
+			e.Plus = t[3:]
+		case t == "/r":
+			e.ModRM = true
+		case len(t) == 2 && t[0] == '/' && t[1] >= '0' && t[1] <= '7':
+			e.ModRM = true
+			e.Digit = int(t[1] - '0')
+		case t == "ib", t == "iw", t == "id", t == "io", t == "cb", t == "cw", t == "cd", t == "cp", t == "cm":
+			e.Imm = append(e.Imm, t)
+		case t == "/is4":
+			e.Is4 = true
+		default:
+			return nil, fmt.Errorf("opcode %q: unknown token %q", s, t)
+		}
+	}
+	if len(e.Opcode) == 0 {
+		return nil, fmt.Errorf("opcode %q: no opcode bytes", s)
+	}
+	if e.VEX != nil && (e.REX || e.REXW || e.REXR || len(e.Prefixes) > 0) {
+		return nil, fmt.Errorf("opcode %q: legacy prefixes with a %s prefix", s, vexName(e.VEX))
+	}
+	return e, nil
+}
+
+// parseVEX parses a VEX or EVEX field list such as "VEX.NDS.128.66.0F38.W0".
+func parseVEX(s string) (*VEX, error) {
+	fields := strings.Split(s, ".")
+	v := &VEX{EVEX: fields[0] == "EVEX"}
+	for _, f := range fields[1:] {
+		switch f {
+		case "NDS", "NDD", "DDS":
+			v.VVVV = f
+		case "128", "L0", "LZ":
+			v.L = 0
+		case "256", "L1":
+			v.L = 1
+		case "512":
+			if !v.EVEX {
+				return nil, fmt.Errorf("unknown VEX field %q", f)
+			}
+			v.L = 2
+		case "LIG":
+			v.LIG = true
+		case "66":
+			v.PP = 1
+		case "F3":
+			v.PP = 2
+		case "F2":
+			v.PP = 3
+		case "0F":
+			v.MMMMM = 1
+		case "0F38":
+			v.MMMMM = 2
+		case "0F3A":
+			v.MMMMM = 3
+		case "W0":
+			v.W = 0
+		case "W1":
+			v.W = 1
+		case "WIG":
+			v.WIG = true
+		default:
+			return nil, fmt.Errorf("unknown %s field %q", fields[0], f)
+		}
+	}
+	if v.MMMMM == 0 {
+		return nil, fmt.Errorf("%s prefix without an opcode map", fields[0])
+	}
+	return v, nil
+}
+
+func vexName(v *VEX) string {
+	if v.EVEX {
+		return "EVEX"
+	}
+	return "VEX"
+}
+
+func isHexByte(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 16, 8)
+	return err == nil && strings.ToUpper(s) == s
+}
+
+func hexByte(s string) byte {
+	b, _ := strconv.ParseUint(s, 16, 8)
+	return byte(b)
+}
+
+// parseEncodings sets the Encoding of each instruction, reporting the
+// opcodes it can not parse.
+func parseEncodings(l *loader, insts []*Instruction) {
+	for _, inst := range insts {
+		enc, err := ParseEncoding(inst.Opcode)
+		if err != nil {
+			l.report(inst.Page, inst.Syntax, DiagEncoding, "%v", err)
+			continue
+		}
+		inst.Encoding = enc
+	}
+}
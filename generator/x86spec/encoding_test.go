@@ -0,0 +1,80 @@
+package x86spec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEncoding(t *testing.T) {
+	tests := []struct {
+		opcode string
+		want   *Encoding
+	}{
+		{"REX.W 0F C8+rd", &Encoding{REXW: true, Opcode: []byte{0x0F, 0xC8}, Plus: "rd", Digit: -1}},
+		{"REX + 8A /r", &Encoding{REX: true, Opcode: []byte{0x8A}, ModRM: true, Digit: -1}},
+		{"REX.R + 0F 22 /0", &Encoding{REXR: true, Opcode: []byte{0x0F, 0x22}, ModRM: true, Digit: 0}},
+		{"9B DF E0", &Encoding{Prefixes: []byte{0x9B}, Opcode: []byte{0xDF, 0xE0}, Digit: -1}},
+		{"66 0F 58 /r", &Encoding{Prefixes: []byte{0x66}, Opcode: []byte{0x0F, 0x58}, ModRM: true, Digit: -1}},
+		{"F3 REX.W 0F B8 /r", &Encoding{Prefixes: []byte{0xF3}, REXW: true, Opcode: []byte{0x0F, 0xB8}, ModRM: true, Digit: -1}},
+		{"VEX.NDS.LIG.F2.0F.W0 2A /r", &Encoding{
+			VEX:    &VEX{VVVV: "NDS", LIG: true, PP: 3, MMMMM: 1},
+			Opcode: []byte{0x2A}, ModRM: true, Digit: -1,
+		}},
+		{"VEX.NDS.256.66.0F3A.W0 4A /r /is4", &Encoding{
+			VEX:    &VEX{VVVV: "NDS", L: 1, PP: 1, MMMMM: 3},
+			Opcode: []byte{0x4A}, ModRM: true, Digit: -1, Is4: true,
+		}},
+		{"VEX.L0.0F.W0 90 /r", &Encoding{VEX: &VEX{MMMMM: 1}, Opcode: []byte{0x90}, ModRM: true, Digit: -1}},
+		{"EVEX.NDS.512.66.0F38.W1 40 /r", &Encoding{
+			VEX:    &VEX{EVEX: true, VVVV: "NDS", L: 2, PP: 1, MMMMM: 2, W: 1},
+			Opcode: []byte{0x40}, ModRM: true, Digit: -1,
+		}},
+		{"EVEX.NDD.128.66.0F.WIG 73 /7 ib", &Encoding{
+			VEX:    &VEX{EVEX: true, VVVV: "NDD", PP: 1, MMMMM: 1, WIG: true},
+			Opcode: []byte{0x73}, ModRM: true, Digit: 7, Imm: []string{"ib"},
+		}},
+		{"81 /0 iw", &Encoding{Opcode: []byte{0x81}, ModRM: true, Digit: 0, Imm: []string{"iw"}}},
+		{"REX.W 81 /0 id", &Encoding{REXW: true, Opcode: []byte{0x81}, ModRM: true, Digit: 0, Imm: []string{"id"}}},
+		{"REX.W B8+rd io", &Encoding{REXW: true, Opcode: []byte{0xB8}, Plus: "rd", Digit: -1, Imm: []string{"io"}}},
+		{"C8 iw ib", &Encoding{Opcode: []byte{0xC8}, Digit: -1, Imm: []string{"iw", "ib"}}},
+		{"D9 C0+i", &Encoding{Opcode: []byte{0xD9, 0xC0}, Plus: "i", Digit: -1}},
+		{"EB cb", &Encoding{Opcode: []byte{0xEB}, Digit: -1, Imm: []string{"cb"}}},
+		{"E8 cw", &Encoding{Opcode: []byte{0xE8}, Digit: -1, Imm: []string{"cw"}}},
+		{"0F 85 cd", &Encoding{Opcode: []byte{0x0F, 0x85}, Digit: -1, Imm: []string{"cd"}}},
+		{"9A cp", &Encoding{Opcode: []byte{0x9A}, Digit: -1, Imm: []string{"cp"}}},
+		{"REX.W A1 cm", &Encoding{REXW: true, Opcode: []byte{0xA1}, Digit: -1, Imm: []string{"cm"}}},
+		{"F3 0F AE /0", &Encoding{Prefixes: []byte{0xF3}, Opcode: []byte{0x0F, 0xAE}, ModRM: true, Digit: 0}},
+	}
+	for _, tt := range tests {
+		got, err := ParseEncoding(tt.opcode)
+		if err != nil {
+			t.Errorf("ParseEncoding(%q): %v", tt.opcode, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseEncoding(%q) = %+v, want %+v", tt.opcode, got, tt.want)
+		}
+	}
+}
+
+func TestParseEncodingErrors(t *testing.T) {
+	for _, opcode := range []string{
+		"",
+		"REX.W",                          // no opcode bytes
+		"0F 38 /8",                       // no such digit
+		"0f 58 /r",                       // lower case hex
+		"B8+rq",                          // unknown register addend
+		"B8+rd B8+rd",                    // two register addends
+		"0F 58 /r ix",                    // unknown immediate
+		"VEX.NDS.512.0F.W0 58 /r",        // 512 bits needs EVEX
+		"VEX.NDS.128.66.W0 58 /r",        // no opcode map
+		"VEX.NDS.128.0F.W2 58 /r",        // unknown field
+		"66 VEX.NDS.128.0F.W0 58 /r",     // legacy prefix with VEX
+		"REX.W EVEX.NDS.512.0F.W1 58 /r", // REX with EVEX
+		"0F VEX.128.0F.W0 58 /r",         // VEX after the opcode
+	} {
+		if e, err := ParseEncoding(opcode); err == nil {
+			t.Errorf("ParseEncoding(%q) = %+v, want an error", opcode, e)
+		}
+	}
+}
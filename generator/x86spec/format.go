@@ -18,7 +18,9 @@ func format(insts []*Instruction) {
 	sort.Sort(bySyntax(insts))
 	needSize := make(map[string]bool)
 	for i := 0; i < 2; i++ {
-		seen := make(map[string]bool)
+		// The same syntax in two encodings, like VEX and EVEX forms, is
+		// not a size variant.
+		seen := make(map[string]string)
 		for _, inst := range insts {
 			if hasTag(inst, "pseudo") || hasTag(inst, "pseudo64") {
 				continue
@@ -34,11 +36,11 @@ func format(insts []*Instruction) {
 				}
 			}
 			unsized := stripSize.Replace(inst.Syntax)
-			if seen[unsized] {
+			if s, ok := seen[unsized]; ok && s != inst.Syntax {
 				op, _ := splitSyntax(inst.Syntax)
 				needSize[op] = true
 			}
-			seen[unsized] = true
+			seen[unsized] = inst.Syntax
 		}
 	}
 
@@ -57,7 +59,7 @@ func format(insts []*Instruction) {
 		op, args := splitSyntax(inst.Syntax)
 	Args:
 		for i := startArg[op]; i < len(args); i++ {
-			switch args[i] {
+			switch baseArg(args[i]) {
 			case "AL", "r8", "r8op", "r/m8":
 				inst.Datasize = 8
 				break Args
@@ -79,6 +81,9 @@ func format(insts []*Instruction) {
 			case "ymm2/m256":
 				inst.Datasize = 256
 				break Args
+			case "zmm2/m512":
+				inst.Datasize = 512
+				break Args
 			}
 		}
 	}
@@ -122,12 +127,14 @@ func format(insts []*Instruction) {
 		// start with GNU op, because it has suffixes already
 		op, _ := splitSyntax(inst.GnuSyntax)
 		op = strings.ToUpper(op)
+		// The suffixed op is more specific than the Intel op: CVTSD2SIQ
+		// must not become CVTSD2SL like CVTSD2SI.
 		if custom, ok := goOpcode[inst.Syntax]; ok {
 			op = custom
-		} else if custom, ok := goOpcode[intelOp]; ok {
-			op = custom
 		} else if custom, ok := goOpcode[op]; ok {
 			op = custom
+		} else if custom, ok := goOpcode[intelOp]; ok {
+			op = custom
 		} else if suffix, ok := goSizeSuffix[op]; ok {
 			op += suffix[inst.Datasize]
 		}
@@ -151,8 +158,24 @@ func format(insts []*Instruction) {
 	}
 }
 
+// baseArg returns an argument without its EVEX decorations: the opmask and
+// zeroing of a destination ({k1}{z}), a broadcast memory form (/m32bcst)
+// and embedded rounding or exception suppression ({er}, {sae}).
+func baseArg(arg string) string {
+	if i := strings.Index(arg, "{"); i >= 0 {
+		arg = arg[:i]
+	}
+	if strings.HasSuffix(arg, "bcst") {
+		arg = arg[:strings.LastIndex(arg, "/")]
+	}
+	return arg
+}
+
 var forceNeedSize = map[string]bool{
 	"SAL": true,
+
+	// Only the r/m64 form has embedded rounding.
+	"VCVTUSI2SD": true,
 }
 
 var stripSize = strings.NewReplacer(
@@ -165,6 +188,7 @@ var stripSize = strings.NewReplacer(
 	"64", "#",
 	"xmm2/m128", "xy/#",
 	"ymm2/m256", "xy/#",
+	"zmm2/m512", "xy/#",
 	"EAX", "AX",
 )
 
@@ -190,6 +214,19 @@ var gnuSizeSuffix = map[string]map[int]string{
 	"vcvttpd2dq": {128: "x", 256: "y"},
 	"vcvttpd2ps": {128: "x", 256: "y"},
 
+	"vcvtpd2udq":  {128: "x", 256: "y"},
+	"vcvttpd2udq": {128: "x", 256: "y"},
+	"vcvtqq2ps":   {128: "x", 256: "y"},
+	"vcvtuqq2ps":  {128: "x", 256: "y"},
+	"vfpclasspd":  {128: "x", 256: "y", 512: "z"},
+	"vfpclassps":  {128: "x", 256: "y", 512: "z"},
+
+	// The register name says the size, and GNU as accepts no suffix.
+	"vcvtsd2usi":  {},
+	"vcvtss2usi":  {},
+	"vcvttsd2usi": {},
+	"vcvttss2usi": {},
+
 	"fadd":  {32: "s", 64: "l"},
 	"fcom":  {32: "s", 64: "l"},
 	"fcomp": {32: "s", 64: "l"},
@@ -265,6 +302,10 @@ var gnuOpcode = map[string]string{
 	"FSUBR ST(i), ST(0)":  "fsub",
 	"FSUBP ST(i), ST(0)":  "fsubrp",
 	"FSUBRP ST(i), ST(0)": "fsubp",
+	"FDIVP":               "fdivrp",
+	"FDIVRP":              "fdivp",
+	"FSUBP":               "fsubrp",
+	"FSUBRP":              "fsubp",
 
 	"MOV r64op, imm64": "movabsq",
 	"MOV moffs64, RAX": "movabsq",
@@ -373,7 +414,11 @@ var startArg = map[string]int{
 }
 
 var goSizeSuffix = map[string]map[int]string{
-	"BSWAP": {16: "W", 32: "L", 64: "Q"},
+	"BSWAP":       {16: "W", 32: "L", 64: "Q"},
+	"VCVTSD2USI":  {32: "L", 64: "Q"},
+	"VCVTSS2USI":  {32: "L", 64: "Q"},
+	"VCVTTSD2USI": {32: "L", 64: "Q"},
+	"VCVTTSS2USI": {32: "L", 64: "Q"},
 }
 
 var goOpcode = map[string]string{
@@ -0,0 +1,308 @@
+package x86spec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// An OperandKind says what an operand of an instruction can be.
+type OperandKind string
+
+const (
+	KindReg      OperandKind = "reg"      // a register
+	KindMem      OperandKind = "mem"      // memory
+	KindRegMem   OperandKind = "reg/mem"  // a register or memory, as in r/m32 or xmm2/m128
+	KindImm      OperandKind = "imm"      // an immediate, including far pointers
+	KindRel      OperandKind = "rel"      // an offset relative to the next instruction
+	KindVSIB     OperandKind = "vsib"     // memory addressed with a vector of indices
+	KindImplicit OperandKind = "implicit" // a fixed register or constant, as in AL or 1
+)
+
+// A Slot is the place in the encoded instruction an operand goes.
+type Slot string
+
+const (
+	SlotNone   Slot = ""       // not encoded, for implicit operands
+	SlotReg    Slot = "reg"    // the reg field of the ModRM byte
+	SlotRM     Slot = "r/m"    // the r/m field of the ModRM byte, and the SIB byte
+	SlotVVVV   Slot = "vvvv"   // the vvvv field of a VEX or EVEX prefix
+	SlotIs4    Slot = "is4"    // the top four bits of an immediate byte
+	SlotOpcode Slot = "opcode" // the low three bits of the last opcode byte
+	SlotImm    Slot = "imm"    // the immediate, displacement or code offset bytes
+)
+
+// An Operand describes one argument of an instruction, parsed from its
+// syntax, its entry in the operand encoding table and its Action.
+type Operand struct {
+	Syntax   string // argument syntax without decorations, e.g. "xmm2/m128"
+	Kind     OperandKind
+	Reg      *RegisterFile // register file of a register or VSIB index, or nil
+	Fixed    string        // register or constant an implicit operand names, e.g. "AL" or "1"
+	Size     int           // size in bits of the register, immediate, offset or VSIB index
+	Mem      string        // memory syntax, e.g. "m128" or "m32fp", or "" for any memory
+	MemSize  int           // size in bits of a memory operand, or 0 if it varies
+	Slot     Slot
+	Access   string // "r", "w" or "rw", or "" if unknown
+	Mask     bool   // accepts an opmask, {k1}
+	Zero     bool   // accepts zeroing, {z}
+	Bcst     int    // element size in bits of a broadcast, m32bcst or m64bcst, or 0
+	Rounding string // "er" for static rounding or "sae" to suppress exceptions, or ""
+}
+
+// memSizes gives the size in bits of the memory operand syntaxes whose
+// name does not say it plainly. Sizes that vary with the operand size or
+// mode, like m14/28byte, are given as 0.
+var memSizes = map[string]int{
+	"m2byte":   16,
+	"m16&16":   32,
+	"m16&32":   48,
+	"m16&64":   80,
+	"m32&32":   64,
+	"m16:16":   32,
+	"m16:32":   48,
+	"m16:64":   80,
+	"m16int":   16,
+	"m32int":   32,
+	"m64int":   64,
+	"m32fp":    32,
+	"m64fp":    64,
+	"m80fp":    80,
+	"m80bcd":   80,
+	"m80dec":   80,
+	"m512byte": 4096,
+
+	"m14/28byte":  0,
+	"m94/108byte": 0,
+}
+
+// ParseOperand parses the argument arg of an instruction, as written in
+// the Syntax column, with its operand encoding enc, as in Args, and its
+// access, from Action. The encoding may be empty, in which case the slot
+// is inferred from the syntax alone.
+func ParseOperand(arg, enc, access string) (Operand, error) {
+	arg, decor := splitDecorations(arg)
+	op := Operand{Syntax: arg, Access: access}
+	if err := op.parseDecorations(decor); err != nil {
+		return Operand{}, fmt.Errorf("operand %q: %v", arg+decor, err)
+	}
+
+	var mem string
+	if i := strings.Index(arg, "/m"); i > 0 {
+		mem = arg[i+1:]
+	}
+	switch {
+	case mem != "":
+		// r/m32 as well as xmm2/m128.
+		op.Kind, op.Slot = KindRegMem, SlotRM
+		op.Reg = ArgRegisterFile(arg)
+		if op.Reg == nil || !op.setMemSize(mem) {
+			return Operand{}, fmt.Errorf("operand %q: unknown register or memory syntax", arg)
+		}
+		op.Size = op.Reg.Width
+	case strings.HasPrefix(arg, "moffs"):
+		op.Kind, op.Slot = KindMem, SlotImm
+		if n := strings.TrimPrefix(arg, "moffs"); n == "" || !op.setMemSize("m"+n) {
+			return Operand{}, fmt.Errorf("operand %q: unknown memory offset size", arg)
+		}
+	case strings.HasPrefix(arg, "vm"):
+		op.Kind, op.Slot = KindVSIB, SlotRM
+		if len(arg) != 5 {
+			return Operand{}, fmt.Errorf("operand %q: unknown VSIB syntax", arg)
+		}
+		op.Size, _ = strconv.Atoi(arg[2:4])
+		op.Reg = LookupRegisterFile(string(arg[4]) + "mm")
+		if op.Reg == nil || (op.Size != 32 && op.Size != 64) {
+			return Operand{}, fmt.Errorf("operand %q: unknown VSIB syntax", arg)
+		}
+	case strings.HasPrefix(arg, "m"):
+		if op.Reg = ArgRegisterFile(arg); op.Reg != nil {
+			// mm1, mm2.
+			op.Kind, op.Slot, op.Size = KindReg, argSlot(arg), op.Reg.Width
+			break
+		}
+		op.Kind, op.Slot = KindMem, SlotRM
+		if !op.setMemSize(arg) {
+			return Operand{}, fmt.Errorf("operand %q: unknown memory syntax", arg)
+		}
+	case strings.HasPrefix(arg, "rel"):
+		op.Kind, op.Slot = KindRel, SlotImm
+		op.Size, _ = strconv.Atoi(strings.TrimPrefix(arg, "rel"))
+	case strings.HasPrefix(arg, "ptr16:"):
+		op.Kind, op.Slot = KindImm, SlotImm
+		n, _ := strconv.Atoi(strings.TrimPrefix(arg, "ptr16:"))
+		op.Size = 16 + n
+	case strings.HasPrefix(arg, "imm"):
+		op.Kind, op.Slot = KindImm, SlotImm
+		op.Size, _ = strconv.Atoi(strings.TrimRight(strings.TrimPrefix(arg, "imm"), "ub"))
+	case arg == "0" || arg == "1" || arg == "3":
+		op.Kind, op.Fixed = KindImplicit, arg
+	default:
+		if op.Reg = ArgRegisterFile(arg); op.Reg != nil {
+			op.Kind, op.Slot, op.Size = KindReg, argSlot(arg), op.Reg.Width
+			break
+		}
+		name := strings.Trim(arg, "<>")
+		if name == "ST" {
+			name = "ST(0)"
+		}
+		if op.Reg = registerFileOf(name); op.Reg == nil {
+			return Operand{}, fmt.Errorf("operand %q: unknown syntax", arg)
+		}
+		op.Kind, op.Fixed, op.Size = KindImplicit, name, op.Reg.Width
+	}
+	if op.Size == 0 && (op.Kind == KindImm || op.Kind == KindRel) {
+		return Operand{}, fmt.Errorf("operand %q: unknown size", arg)
+	}
+
+	if slot, ok := EncodingSlot(enc); ok {
+		op.Slot = slot
+		if slot == SlotImm && op.Kind == KindImplicit && op.Reg == nil {
+			// A constant the manual lists as an immediate, as in AAD 0.
+			op.Kind, op.Size = KindImm, 8
+		}
+	}
+	return op, nil
+}
+
+// parseDecorations records the EVEX decorations split off an argument,
+// like "{k1}{z}" or "/m32bcst{er}".
+func (op *Operand) parseDecorations(decor string) error {
+	if strings.HasPrefix(decor, "/m") {
+		i := strings.Index(decor, "bcst")
+		if i < 0 {
+			return fmt.Errorf("unknown decoration %q", decor)
+		}
+		op.Bcst, _ = strconv.Atoi(decor[2:i])
+		if op.Bcst != 32 && op.Bcst != 64 {
+			return fmt.Errorf("unknown broadcast %q", decor[:i+4])
+		}
+		decor = decor[i+4:]
+	}
+	for decor != "" {
+		i := strings.Index(decor, "}")
+		if decor[0] != '{' || i < 0 {
+			return fmt.Errorf("unknown decoration %q", decor)
+		}
+		switch d := decor[1:i]; d {
+		case "k1", "k2":
+			op.Mask = true
+		case "z":
+			op.Zero = true
+		case "er", "sae":
+			op.Rounding = d
+		default:
+			return fmt.Errorf("unknown decoration %q", decor[:i+1])
+		}
+		decor = decor[i+1:]
+	}
+	return nil
+}
+
+// setMemSize sets the memory syntax and size from a syntax like m128 or
+// m16&32, reporting whether it is known. The syntaxes of memory of any
+// size, m, mem and mib, leave them empty.
+func (op *Operand) setMemSize(mem string) bool {
+	switch mem {
+	case "m", "mem", "mib":
+		return true
+	}
+	if n, ok := memSizes[mem]; ok {
+		op.Mem, op.MemSize = mem, n
+		return true
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(mem, "m"))
+	if err != nil || !strings.HasPrefix(mem, "m") || n%8 != 0 {
+		return false
+	}
+	op.Mem, op.MemSize = mem, n
+	return true
+}
+
+// argSlot returns the slot a register argument is encoded in, judging by
+// its syntax alone: r32op is in the opcode, xmmV in vvvv, xmmIH in an
+// immediate, xmm2 and rmr32 in r/m and the rest in reg.
+func argSlot(arg string) Slot {
+	switch {
+	case strings.HasSuffix(arg, "op"), arg == "ST(i)":
+		return SlotOpcode
+	case strings.HasSuffix(arg, "V"):
+		return SlotVVVV
+	case strings.HasSuffix(arg, "IH"):
+		return SlotIs4
+	case strings.HasPrefix(arg, "rmr"), strings.HasSuffix(arg, "2") && !strings.HasPrefix(arg, "r"):
+		return SlotRM
+	}
+	return SlotReg
+}
+
+// EncodingSlot returns the slot named by an entry of the operand encoding
+// table, as in Args, if it names one.
+func EncodingSlot(enc string) (Slot, bool) {
+	switch {
+	case strings.HasPrefix(enc, "ModRM:reg"):
+		return SlotReg, true
+	case strings.HasPrefix(enc, "ModRM:r/m"), enc == "vsib":
+		return SlotRM, true
+	case strings.HasPrefix(enc, "VEX."), strings.HasPrefix(enc, "EVEX."), enc == "vvvv":
+		return SlotVVVV, true
+	case enc == "imm8[7:4]":
+		return SlotIs4, true
+	case enc == "opcode + rd":
+		return SlotOpcode, true
+	case strings.HasPrefix(enc, "imm"), enc == "iw", enc == "Offset", enc == "Moffs":
+		return SlotImm, true
+	}
+	return SlotNone, false
+}
+
+// registerFileOf returns the register file holding the register named
+// name, or nil.
+func registerFileOf(name string) *RegisterFile {
+	for _, f := range RegisterFiles {
+		for _, r := range f.Regs {
+			if r.Name == name {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// parseOperands sets the Operands of each instruction, reporting the
+// arguments it can not parse.
+func parseOperands(l *loader, insts []*Instruction) {
+	for _, inst := range insts {
+		ops, err := inst.parseOperands()
+		if err != nil {
+			l.report(inst.Page, inst.Syntax, DiagOperand, "%v", err)
+		}
+		inst.Operands = ops
+	}
+}
+
+// parseOperands parses the arguments of the instruction's syntax, with
+// their encodings from Args and their accesses from Action.
+func (inst *Instruction) parseOperands() ([]Operand, error) {
+	_, args := splitSyntax(inst.Syntax)
+	access := strings.Split(inst.Action, ",")
+	var ops []Operand
+	for i, arg := range args {
+		var enc, acc string
+		if i < len(inst.Args) {
+			enc = inst.Args[i]
+			for _, s := range []string{" (r)", " (w)", " (r, w)"} {
+				enc = strings.TrimSuffix(enc, s)
+			}
+		}
+		if len(access) == len(args) && access[i] != "?" {
+			acc = access[i]
+		}
+		op, err := ParseOperand(arg, enc, acc)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
@@ -0,0 +1,72 @@
+package x86spec
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseOperand(t *testing.T) {
+	r32, xmm, zmm, r8 := LookupRegisterFile("r32"), LookupRegisterFile("xmm"), LookupRegisterFile("zmm"), LookupRegisterFile("r8")
+	tests := []struct {
+		arg, enc, access string
+		want             Operand
+	}{
+		{"r/m32", "ModRM:r/m (r, w)", "rw", Operand{Syntax: "r/m32", Kind: KindRegMem, Reg: r32, Size: 32, Mem: "m32", MemSize: 32, Slot: SlotRM, Access: "rw"}},
+		{"r32", "", "", Operand{Syntax: "r32", Kind: KindReg, Reg: r32, Size: 32, Slot: SlotReg}},
+		{"r32V", "", "", Operand{Syntax: "r32V", Kind: KindReg, Reg: r32, Size: 32, Slot: SlotVVVV}},
+		{"r32op", "", "", Operand{Syntax: "r32op", Kind: KindReg, Reg: r32, Size: 32, Slot: SlotOpcode}},
+		{"xmm2/m128", "", "r", Operand{Syntax: "xmm2/m128", Kind: KindRegMem, Reg: xmm, Size: 128, Mem: "m128", MemSize: 128, Slot: SlotRM, Access: "r"}},
+		{"xmmIH", "", "", Operand{Syntax: "xmmIH", Kind: KindReg, Reg: xmm, Size: 128, Slot: SlotIs4}},
+		{"vm32x", "", "", Operand{Syntax: "vm32x", Kind: KindVSIB, Reg: xmm, Size: 32, Slot: SlotRM}},
+		{"vm64z", "vsib", "", Operand{Syntax: "vm64z", Kind: KindVSIB, Reg: zmm, Size: 64, Slot: SlotRM}},
+		{"moffs64", "Moffs", "", Operand{Syntax: "moffs64", Kind: KindMem, Mem: "m64", MemSize: 64, Slot: SlotImm}},
+		{"m16&32", "", "", Operand{Syntax: "m16&32", Kind: KindMem, Mem: "m16&32", MemSize: 48, Slot: SlotRM}},
+		{"m", "", "", Operand{Syntax: "m", Kind: KindMem, Slot: SlotRM}},
+		{"m14/28byte", "", "", Operand{Syntax: "m14/28byte", Kind: KindMem, Mem: "m14/28byte", Slot: SlotRM}},
+		{"imm8", "imm8", "", Operand{Syntax: "imm8", Kind: KindImm, Size: 8, Slot: SlotImm}},
+		{"imm16u", "", "", Operand{Syntax: "imm16u", Kind: KindImm, Size: 16, Slot: SlotImm}},
+		{"rel32", "Offset", "", Operand{Syntax: "rel32", Kind: KindRel, Size: 32, Slot: SlotImm}},
+		{"ptr16:32", "", "", Operand{Syntax: "ptr16:32", Kind: KindImm, Size: 48, Slot: SlotImm}},
+		{"AL", "", "w", Operand{Syntax: "AL", Kind: KindImplicit, Reg: r8, Fixed: "AL", Size: 8, Access: "w"}},
+		{"<XMM0>", "implicit XMM0", "", Operand{Syntax: "<XMM0>", Kind: KindImplicit, Reg: xmm, Fixed: "XMM0", Size: 128}},
+		{"ST", "", "", Operand{Syntax: "ST", Kind: KindImplicit, Reg: LookupRegisterFile("ST"), Fixed: "ST(0)", Size: 80}},
+		{"1", "1", "", Operand{Syntax: "1", Kind: KindImplicit, Fixed: "1"}},
+		{"0", "imm8", "", Operand{Syntax: "0", Kind: KindImm, Fixed: "0", Size: 8, Slot: SlotImm}},
+		{"zmm1 {k1}{z}", "ModRM:reg (w)", "w", Operand{Syntax: "zmm1", Kind: KindReg, Reg: zmm, Size: 512, Slot: SlotReg, Access: "w", Mask: true, Zero: true}},
+		{"zmm2/m512/m32bcst{er}", "", "", Operand{Syntax: "zmm2/m512", Kind: KindRegMem, Reg: zmm, Size: 512, Mem: "m512", MemSize: 512, Slot: SlotRM, Bcst: 32, Rounding: "er"}},
+		{"xmm2/m64{sae}", "", "", Operand{Syntax: "xmm2/m64", Kind: KindRegMem, Reg: xmm, Size: 128, Mem: "m64", MemSize: 64, Slot: SlotRM, Rounding: "sae"}},
+		{"m512{k1}", "", "", Operand{Syntax: "m512", Kind: KindMem, Mem: "m512", MemSize: 512, Slot: SlotRM, Mask: true}},
+		{"xmm1", "VEX.vvvv (r)", "", Operand{Syntax: "xmm1", Kind: KindReg, Reg: xmm, Size: 128, Slot: SlotVVVV}},
+	}
+	for _, tt := range tests {
+		got, err := ParseOperand(tt.arg, tt.enc, tt.access)
+		if err != nil {
+			t.Errorf("ParseOperand(%q, %q): %v", tt.arg, tt.enc, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseOperand(%q, %q) = %+v, want %+v", tt.arg, tt.enc, got, tt.want)
+		}
+	}
+}
+
+func TestParseOperandErrors(t *testing.T) {
+	for _, arg := range []string{
+		"r/m33",             // no such register file
+		"xmm2/m12",          // memory size not in bytes
+		"vm32w",             // no such index register
+		"vm16x",             // no such index size
+		"moffs",             // no offset size
+		"imm",               // no immediate size
+		"rel",               // no offset size
+		"ZZ",                // no such register
+		"zmm1 {k9}",         // no such decoration
+		"zmm1 k1}",          // malformed decoration
+		"zmm2/m512/m16bcst", // no such broadcast
+		"zmm2/m512/bcst",    // broadcast without a size
+	} {
+		if op, err := ParseOperand(arg, "", ""); err == nil {
+			t.Errorf("ParseOperand(%q) = %+v, want an error", arg, op)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package x86spec
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -14,10 +15,11 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
 
 	"rsc.io/pdf"
 )
@@ -26,6 +28,7 @@ import (
 // concerning a single instruction listing.
 type listing struct {
 	pageNum   int
+	manual    string       // order number and date of the manual, on page 1
 	name      string       // instruction heading
 	mtables   [][][]string // mnemonic tables (at most one per page)
 	enctables [][][]string // encoding tables (at most one per page)
@@ -46,8 +49,11 @@ const (
 	numCacheBlock  = 16
 )
 
+// cachedReaderAt caches the most recently read blocks of r. It is safe
+// for concurrent use, so that pages can be parsed in parallel.
 type cachedReaderAt struct {
 	r     io.ReaderAt
+	mu    sync.Mutex // guards cache and the blocks
 	cache *cacheBlock
 }
 
@@ -74,6 +80,8 @@ func (c *cachedReaderAt) ReadAt(p []byte, offset int64) (n int, err error) {
 		return c.r.ReadAt(p, offset)
 	}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	for n < len(p) {
 		o := offset + int64(n)
 		f := o & (cacheBlockSize - 1)
@@ -140,18 +148,18 @@ func pdfOpen(name string) (*pdf.Reader, error) {
 	return pdf.NewReader(newCachedReaderAt(f), fi.Size())
 }
 
-func parse(config *Config) []*Instruction {
+func parse(l *loader) ([]*Instruction, error) {
 	var insts []*Instruction
 
-	f, err := pdfOpen(config.File)
+	f, err := pdfOpen(l.File)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	// Find instruction set reference in outline, to build instruction list.
 	instList := instHeadings(f.Outline())
 	if len(instList) < 200 {
-		log.Fatalf("only found %d instructions in table of contents", len(instList))
+		return nil, fmt.Errorf("x86spec: only found %d instructions in table of contents of %s", len(instList), l.File)
 	}
 
 	// Scan document looking for instructions.
@@ -163,18 +171,47 @@ func parse(config *Config) []*Instruction {
 			return
 		}
 		if len(current.mtables) == 0 || len(current.mtables[0]) <= 1 {
-			fmt.Fprintf(os.Stderr, "p.%d: no mnemonics for instruction %q\n", current.pageNum, current.name)
+			l.report(current.pageNum, "", DiagNoMnemonics, "no mnemonics for instruction %q", current.name)
 		}
-		processListing(config, current, &insts)
+		processListing(l, current, &insts)
 		current = nil
 	}
 
+	var pageNums []int
 	for pageNum := 1; pageNum <= n; pageNum++ {
-		if config.onlySomePages() && !isDebugPage(config, pageNum) {
-			continue
+		if !l.onlySomePages() || isDebugPage(l.Config, pageNum) {
+			pageNums = append(pageNums, pageNum)
+		}
+	}
+	var cache *pageCache
+	if l.CacheDir != "" && !l.debugging() {
+		if cache, err = openPageCache(l.CacheDir, l.File); err != nil {
+			return nil, err
+		}
+	}
+	ctx, cancel := context.WithCancel(l.ctx)
+	defer cancel()
+	results := parsePages(ctx, l.Config, f, cache, pageNums)
+
+	// The pages are parsed concurrently, but their listings are merged in
+	// page order.
+	for i, pageNum := range pageNums {
+		var r pageResult
+		select {
+		case r = <-results[i]:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		parsed := r.parsed
+		if l.Progress != nil {
+			l.Progress(pageNum, n)
+		}
+		if parsed.manual != "" {
+			l.report(pageNum, "", DiagManual, "%s", parsed.manual)
 		}
-		p := f.Page(pageNum)
-		parsed := parsePage(config, p, pageNum)
 		if parsed.name != "" {
 			finishInstruction()
 			for j, headline := range instList {
@@ -185,35 +222,109 @@ func parse(config *Config) []*Instruction {
 				}
 			}
 			if current == nil {
-				fmt.Fprintf(os.Stderr, "p.%d: unexpected instruction %q\n", pageNum, parsed.name)
+				l.report(pageNum, "", DiagUnexpected, "unexpected instruction %q", parsed.name)
 			}
 			continue
 		}
 		if current != nil {
-			merge(current, parsed)
+			merge(l, current, parsed)
 			continue
 		}
 		if parsed.mtables != nil {
-			fmt.Fprintf(os.Stderr, "p.%d: unexpected mnemonic table\n", pageNum)
+			l.report(pageNum, "", DiagUnexpected, "unexpected mnemonic table")
 		}
 		if parsed.enctables != nil {
-			fmt.Fprintf(os.Stderr, "p.%d: unexpected encoding table\n", pageNum)
+			l.report(pageNum, "", DiagUnexpected, "unexpected encoding table")
 		}
 		if parsed.compat != "" {
-			fmt.Fprintf(os.Stderr, "p.%d: unexpected compatibility statement\n", pageNum)
+			l.report(pageNum, "", DiagUnexpected, "unexpected compatibility statement")
 		}
 	}
 	finishInstruction()
 
-	if !config.onlySomePages() {
+	if !l.onlySomePages() {
 		for _, headline := range instList {
 			if headline != "" {
-				fmt.Fprintf(os.Stderr, "missing instruction %q\n", headline)
+				l.report(0, "", DiagMissing, "missing instruction %q", headline)
 			}
 		}
 	}
 
-	return insts
+	return insts, nil
+}
+
+// pageResult is the outcome of parsing a page.
+type pageResult struct {
+	parsed *listing
+	err    error
+}
+
+// parsePages parses the pages with config.Workers goroutines, and returns
+// a channel per page that receives its result. Pages in the cache, if not
+// nil, are not parsed again, and pages parsed are added to it. Parsing
+// stops early if ctx is done.
+func parsePages(ctx context.Context, config *Config, f *pdf.Reader, cache *pageCache, pageNums []int) []chan pageResult {
+	results := make([]chan pageResult, len(pageNums))
+	for i := range results {
+		results[i] = make(chan pageResult, 1)
+	}
+	workers := config.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if config.debugging() {
+		// Keep the debugging output of the pages apart.
+		workers = 1
+	}
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := range pageNums {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				results[i] <- parseCachedPage(config, f, cache, pageNums[i])
+			}
+		}()
+	}
+	return results
+}
+
+// parseCachedPage returns the listing of a page from the cache, or parses
+// the page and caches its listing.
+func parseCachedPage(config *Config, f *pdf.Reader, cache *pageCache, pageNum int) pageResult {
+	if cache == nil {
+		return parsePageSafely(config, f, pageNum)
+	}
+	if parsed, ok := cache.load(pageNum); ok {
+		return pageResult{parsed: parsed}
+	}
+	r := parsePageSafely(config, f, pageNum)
+	if r.err == nil {
+		if err := cache.store(r.parsed); err != nil {
+			r.err = fmt.Errorf("x86spec: caching p.%d: %v", pageNum, err)
+		}
+	}
+	return r
+}
+
+// parsePageSafely parses a page, turning the panics with which package pdf
+// reports a malformed file into an error.
+func parsePageSafely(config *Config, f *pdf.Reader, pageNum int) (r pageResult) {
+	defer func() {
+		if err := recover(); err != nil {
+			r = pageResult{err: fmt.Errorf("x86spec: p.%d: %v", pageNum, err)}
+		}
+	}()
+	return pageResult{parsed: parsePage(config, f.Page(pageNum), pageNum)}
 }
 
 // isDebugPage reports whether the -debugpage flag mentions page n.
@@ -240,9 +351,9 @@ func isDebugPage(config *Config, n int) bool {
 }
 
 // merge merges the content of y into the running collection in x.
-func merge(x, y *listing) {
+func merge(l *loader, x, y *listing) {
 	if y.name != "" {
-		fmt.Fprintf(os.Stderr, "p.%d: merging page incorrectly\n", y.pageNum)
+		l.report(y.pageNum, "", DiagUnexpected, "merging page incorrectly")
 		return
 	}
 
@@ -362,10 +473,7 @@ func parsePage(config *Config, p pdf.Page, pageNum int) *listing {
 			date = "???"
 		}
 
-		fmt.Printf("# x86 instruction set description version %s, %s\n",
-			specFormatVersion, time.Now().Format("2006-01-02"))
-		fmt.Printf("# Based on Intel Instruction Set Reference #%s, %s.\n", num, date)
-		fmt.Printf("# https://golang.org/x/arch/x86/x86spec\n")
+		parsed.manual = fmt.Sprintf("Intel Instruction Set Reference #%s, %s", num, date)
 	}
 
 	// Remove text we should ignore.
@@ -510,7 +618,7 @@ func findWords(chars []pdf.Text) (words []pdf.Text) {
 			f := ck.Font
 			f = strings.TrimSuffix(f, ",Italic")
 			f = strings.TrimSuffix(f, "-Italic")
-			words = append(words, pdf.Text{f, ck.FontSize, ck.X, ck.Y, end, s})
+			words = append(words, pdf.Text{Font: f, FontSize: ck.FontSize, X: ck.X, Y: ck.Y, W: end, S: s})
 			k = l
 		}
 		i = j
@@ -743,8 +851,8 @@ func findCompat(text []pdf.Text) string {
 	return out
 }
 
-func processListing(config *Config, p *listing, insts *[]*Instruction) {
-	if config.debugging() {
+func processListing(l *loader, p *listing, insts *[]*Instruction) {
+	if l.debugging() {
 		for _, table := range p.mtables {
 			fmt.Printf("table:\n")
 			for _, row := range table {
@@ -760,8 +868,8 @@ func processListing(config *Config, p *listing, insts *[]*Instruction) {
 		fmt.Printf("compat:\n%s", p.compat)
 	}
 
-	if config.Compat && p.compat != "" {
-		fmt.Printf("# p.%d: %s\n#\t%s\n", p.pageNum, p.name, strings.Replace(p.compat, "\n", "\n#\t", -1))
+	if l.Compat && p.compat != "" {
+		l.report(p.pageNum, "", DiagCompat, "%s:\n\t%s", p.name, strings.Replace(p.compat, "\n", "\n\t", -1))
 	}
 
 	encs := make(map[string][]string)
@@ -797,7 +905,7 @@ func processListing(config *Config, p *listing, insts *[]*Instruction) {
 					goto BadTable
 				case "Opcode/Instruction":
 					x = row[i]
-					if strings.HasPrefix(x, "\nVEX") {
+					if strings.HasPrefix(x, "\nVEX") || strings.HasPrefix(x, "\nEVEX") {
 						x = x[1:]
 						row[i] = x
 					}
@@ -931,6 +1039,7 @@ func processListing(config *Config, p *listing, insts *[]*Instruction) {
 			fix("PCLMUL- QDQ", "PCLMULQDQ")
 			fix("PCL- MULQDQ", "PCLMULQDQ")
 			fix("Both PCLMULQDQ and AVX flags", "PCLMULQDQ+AVX")
+			fix("Both AES and AVX flags", "AES+AVX")
 
 			inst.Name = inst.Syntax
 			if strings.Contains(inst.Name, " ") {
@@ -946,13 +1055,13 @@ func processListing(config *Config, p *listing, insts *[]*Instruction) {
 	return
 
 BadTable:
-	fmt.Fprintf(os.Stderr, "p.%d: reading %v: %v\n", p.pageNum, p.name, wrong)
+	var rows strings.Builder
 	for _, table := range p.mtables {
 		for _, t := range table {
-			fmt.Fprintf(os.Stderr, "\t%q\n", t)
+			fmt.Fprintf(&rows, "\n\t%q", t)
 		}
 	}
-	fmt.Fprintf(os.Stderr, "\n")
+	l.report(p.pageNum, "", DiagBadTable, "reading %v: %v%s", p.name, wrong, rows.String())
 }
 
 func parseMode(s string) (string, bool) {
@@ -0,0 +1,163 @@
+package x86spec
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"rsc.io/pdf"
+)
+
+// makePDF returns a PDF document with a page for each of pages, holding its
+// lines of text in 9-point NeoSansIntel, as in the manual.
+func makePDF(pages [][]string) []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(format string, args ...interface{}) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n", len(offsets))
+		fmt.Fprintf(&buf, format, args...)
+		buf.WriteString("\nendobj\n")
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /NeoSansIntel /Encoding /WinAnsiEncoding >>")
+	for i, lines := range pages {
+		var content bytes.Buffer
+		for j, line := range lines {
+			fmt.Fprintf(&content, "BT /F1 9 Tf 1 0 0 1 50 %d Tm (%s) Tj ET\n", 750-12*(j%60), line)
+		}
+		object("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", 5+2*i)
+		object("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String())
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+// syntheticManual returns a PDF of n pages of text, the first of which
+// names the manual.
+func syntheticManual(n, lines int) []byte {
+	pages := make([][]string, n)
+	for i := range pages {
+		for j := 0; j < lines; j++ {
+			pages[i] = append(pages[i], fmt.Sprintf("page %d line %d", i+1, j))
+		}
+	}
+	pages[0] = append(pages[0], "Order Number: 325383-057US", "December 2015")
+	return makePDF(pages)
+}
+
+func TestParsePages(t *testing.T) {
+	data := syntheticManual(20, 10)
+	f, err := pdf.NewReader(newCachedReaderAt(bytes.NewReader(data)), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := f.NumPage(); n != 20 {
+		t.Fatalf("NumPage = %d, want 20", n)
+	}
+	pageNums := []int{1, 2, 3, 5, 8, 13}
+	results := parsePages(context.Background(), &Config{Workers: 4}, f, nil, pageNums)
+	for i, pageNum := range pageNums {
+		r := <-results[i]
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if r.parsed.pageNum != pageNum {
+			t.Errorf("result %d is for page %d, want %d", i, r.parsed.pageNum, pageNum)
+		}
+		if want := pageNum == 1; (r.parsed.manual != "") != want {
+			t.Errorf("p.%d: manual = %q", pageNum, r.parsed.manual)
+		}
+	}
+}
+
+func TestCachedReaderAt(t *testing.T) {
+	data := make([]byte, 3*cacheBlockSize*numCacheBlock)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	c := newCachedReaderAt(bytes.NewReader(data))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			p := make([]byte, 1000)
+			for i := 0; i < 500; i++ {
+				off := int64((g*7919 + i*104729) % (len(data) - len(p)))
+				if n, err := c.ReadAt(p, off); n != len(p) || err != nil && err != io.EOF {
+					t.Errorf("ReadAt(%d) = %d, %v", off, n, err)
+					return
+				}
+				if !bytes.Equal(p, data[off:off+int64(len(p))]) {
+					t.Errorf("ReadAt(%d) read the wrong bytes", off)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+// BenchmarkParsePages parses a synthetic manual, and the real one if it is
+// present, with one worker and with GOMAXPROCS workers.
+func BenchmarkParsePages(b *testing.B) {
+	manuals := map[string]func() (*pdf.Reader, error){
+		"synthetic": func() (*pdf.Reader, error) {
+			data := syntheticManual(100, 100)
+			return pdf.NewReader(newCachedReaderAt(bytes.NewReader(data)), int64(len(data)))
+		},
+	}
+	if _, err := os.Stat(*flagFile); err == nil {
+		manuals["manual"] = func() (*pdf.Reader, error) { return pdfOpen(*flagFile) }
+	}
+	for _, name := range []string{"synthetic", "manual"} {
+		open := manuals[name]
+		if open == nil {
+			continue
+		}
+		workerCounts := []int{1}
+		if n := runtime.GOMAXPROCS(0); n > 1 {
+			workerCounts = append(workerCounts, n)
+		}
+		for _, workers := range workerCounts {
+			b.Run(fmt.Sprintf("%s/workers=%d", name, workers), func(b *testing.B) {
+				f, err := open()
+				if err != nil {
+					b.Fatal(err)
+				}
+				var pageNums []int
+				for i := 1; i <= f.NumPage(); i++ {
+					pageNums = append(pageNums, i)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					for _, r := range parsePages(context.Background(), &Config{Workers: workers}, f, nil, pageNums) {
+						if r := <-r; r.err != nil {
+							b.Fatal(r.err)
+						}
+					}
+				}
+			})
+		}
+	}
+}
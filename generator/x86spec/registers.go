@@ -0,0 +1,155 @@
+package x86spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A RegisterFile is a set of registers of the same kind and width,
+// indexed by the encoding number used to select them in a ModRM byte,
+// a VEX/EVEX vvvv field or the low bits of an opcode.
+type RegisterFile struct {
+	Name  string // name of the file, as used in argument syntaxes: r64, xmm, k, ...
+	Class string // gp, ip, vec, mask, seg, x87, mmx, ctrl, debug or bnd
+	Width int    // width of each register in bits
+	Regs  []Register
+}
+
+// A Register is a single register in a RegisterFile.
+type Register struct {
+	Name  string // Intel name, e.g. RAX, R8D, XMM17, ST(3)
+	Num   int    // encoding number
+	REX   bool   // can only be encoded with a REX prefix
+	NoREX bool   // can not be encoded when a REX prefix is present
+	EVEX  bool   // can only be encoded with an EVEX prefix
+}
+
+// RegisterFiles lists every register file, in the order used by the
+// argument syntaxes of the manual: general purpose, vector, opmask,
+// x87, segment, control, debug and bound registers.
+var RegisterFiles = []*RegisterFile{
+	gpFile("r8", 8, []string{"AL", "CL", "DL", "BL", "SPL", "BPL", "SIL", "DIL"}, "B"),
+	{Name: "r8h", Class: "gp", Width: 8, Regs: []Register{
+		{Name: "AH", Num: 4, NoREX: true},
+		{Name: "CH", Num: 5, NoREX: true},
+		{Name: "DH", Num: 6, NoREX: true},
+		{Name: "BH", Num: 7, NoREX: true},
+	}},
+	gpFile("r16", 16, []string{"AX", "CX", "DX", "BX", "SP", "BP", "SI", "DI"}, "W"),
+	gpFile("r32", 32, []string{"EAX", "ECX", "EDX", "EBX", "ESP", "EBP", "ESI", "EDI"}, "D"),
+	gpFile("r64", 64, []string{"RAX", "RCX", "RDX", "RBX", "RSP", "RBP", "RSI", "RDI"}, ""),
+	{Name: "eip", Class: "ip", Width: 32, Regs: []Register{{Name: "EIP"}}},
+	{Name: "rip", Class: "ip", Width: 64, Regs: []Register{{Name: "RIP"}}},
+	vecFile("xmm", 128),
+	vecFile("ymm", 256),
+	vecFile("zmm", 512),
+	numberedFile("k", "mask", 64, "K%d", 8),
+	numberedFile("mm", "mmx", 64, "MM%d", 8),
+	numberedFile("ST", "x87", 80, "ST(%d)", 8),
+	{Name: "Sreg", Class: "seg", Width: 16, Regs: []Register{
+		{Name: "ES", Num: 0},
+		{Name: "CS", Num: 1},
+		{Name: "SS", Num: 2},
+		{Name: "DS", Num: 3},
+		{Name: "FS", Num: 4},
+		{Name: "GS", Num: 5},
+	}},
+	// CR1 and CR5-CR7 are reserved, and CR9-CR15 and DR8-DR15 do not
+	// exist: using them raises #UD.
+	{Name: "CR", Class: "ctrl", Width: 64, Regs: []Register{
+		{Name: "CR0", Num: 0},
+		{Name: "CR2", Num: 2},
+		{Name: "CR3", Num: 3},
+		{Name: "CR4", Num: 4},
+		{Name: "CR8", Num: 8, REX: true},
+	}},
+	numberedFile("DR", "debug", 64, "DR%d", 8),
+	numberedFile("bnd", "bnd", 128, "BND%d", 4),
+}
+
+// gpFile returns a general purpose register file holding the eight
+// legacy registers named by legacy followed by R8-R15 with the given suffix.
+// In the byte file the legacy registers SPL, BPL, SIL and DIL need a REX prefix.
+func gpFile(name string, width int, legacy []string, suffix string) *RegisterFile {
+	f := &RegisterFile{Name: name, Class: "gp", Width: width}
+	for i, r := range legacy {
+		f.Regs = append(f.Regs, Register{Name: r, Num: i, REX: width == 8 && i >= 4})
+	}
+	for i := 8; i < 16; i++ {
+		f.Regs = append(f.Regs, Register{Name: fmt.Sprintf("R%d%s", i, suffix), Num: i, REX: true})
+	}
+	return f
+}
+
+// vecFile returns a vector register file of 32 registers. Registers 16-31,
+// and all ZMM registers, are only reachable with an EVEX prefix.
+func vecFile(name string, width int) *RegisterFile {
+	f := &RegisterFile{Name: name, Class: "vec", Width: width}
+	for i := 0; i < 32; i++ {
+		f.Regs = append(f.Regs, Register{
+			Name: fmt.Sprintf("%s%d", strings.ToUpper(name), i),
+			Num:  i,
+			EVEX: i >= 16 || width == 512,
+		})
+	}
+	return f
+}
+
+func numberedFile(name, class string, width int, format string, n int) *RegisterFile {
+	f := &RegisterFile{Name: name, Class: class, Width: width}
+	for i := 0; i < n; i++ {
+		f.Regs = append(f.Regs, Register{Name: fmt.Sprintf(format, i), Num: i, REX: class != "mask" && i >= 8})
+	}
+	return f
+}
+
+// LookupRegisterFile returns the register file with the given name, or nil.
+func LookupRegisterFile(name string) *RegisterFile {
+	for _, f := range RegisterFiles {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// ArgRegisterFile returns the register file an argument syntax selects
+// its register from, or nil if the argument is not a register operand
+// (or names one specific register, like AL or XMM0).
+// For example r/m64 and r64op select from r64, xmm2/m128 and xmmV from xmm,
+// and bnd1 from bnd.
+func ArgRegisterFile(arg string) *RegisterFile {
+	arg = strings.TrimPrefix(arg, "rmr")
+	arg = strings.TrimPrefix(arg, "r/m")
+	if i := strings.Index(arg, "/"); i >= 0 {
+		arg = arg[:i]
+	}
+	switch {
+	case arg == "8" || arg == "r8" || arg == "r8op":
+		return LookupRegisterFile("r8")
+	case arg == "16" || arg == "r16" || arg == "r16op" || arg == "r16V":
+		return LookupRegisterFile("r16")
+	case arg == "32" || arg == "r32" || arg == "r32op" || arg == "r32V":
+		return LookupRegisterFile("r32")
+	case arg == "64" || arg == "r64" || arg == "r64op" || arg == "r64V":
+		return LookupRegisterFile("r64")
+	case arg == "Sreg":
+		return LookupRegisterFile("Sreg")
+	case arg == "ST(i)":
+		return LookupRegisterFile("ST")
+	case arg == "CR0-CR7":
+		return LookupRegisterFile("CR")
+	case arg == "DR0-DR7":
+		return LookupRegisterFile("DR")
+	}
+	for _, name := range []string{"xmm", "ymm", "zmm", "bnd", "mm", "k"} {
+		if !strings.HasPrefix(arg, name) {
+			continue
+		}
+		switch strings.TrimPrefix(arg, name) {
+		case "", "1", "2", "3", "4", "V", "I", "IH":
+			return LookupRegisterFile(name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package x86spec
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// snapshotVersion is the version of the snapshot format, changed whenever
+// the fields of Instruction that a snapshot holds change incompatibly.
+const snapshotVersion = 1
+
+// Snapshot is the result of Load, in a form that can be checked in and
+// read back without the manual, so that programs generated from the
+// instructions can be rebuilt offline and reproducibly.
+type Snapshot struct {
+	Version      int    // format version; set by WriteSnapshot
+	URL          string // URL of the manual the instructions were extracted from
+	Instructions []*Instruction
+}
+
+// WriteSnapshot writes the snapshot as JSON, one instruction per line so
+// that changes to the extraction give readable diffs. The Encoding and
+// Operands of the instructions are not written: ReadSnapshot parses them
+// again.
+func WriteSnapshot(w io.Writer, s *Snapshot) error {
+	bw := bufio.NewWriter(w)
+	header, err := json.Marshal(struct {
+		Version int
+		URL     string
+	}{snapshotVersion, s.URL})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(bw, "%s,\"Instructions\":[\n", header[:len(header)-1])
+	for i, inst := range s.Instructions {
+		line, err := json.Marshal(inst)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			bw.WriteString(",\n")
+		}
+		bw.Write(line)
+	}
+	bw.WriteString("\n]}\n")
+	return bw.Flush()
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot, in the order it
+// was written, and parses the encodings and operands of its instructions.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("x86spec: reading snapshot: %v", err)
+	}
+	if s.Version != snapshotVersion {
+		return nil, fmt.Errorf("x86spec: snapshot is version %d, want %d", s.Version, snapshotVersion)
+	}
+	for _, inst := range s.Instructions {
+		if inst == nil {
+			return nil, fmt.Errorf("x86spec: snapshot has a null instruction")
+		}
+		inst.parseFields()
+	}
+	return &s, nil
+}
+
+// parseFields sets the Encoding and Operands of an instruction read back
+// from a file. Load reported the instructions they can not be parsed for
+// when the file was written, so they are left unset quietly.
+func (inst *Instruction) parseFields() {
+	if inst.Opcode != "" {
+		inst.Encoding, _ = ParseEncoding(inst.Opcode)
+	}
+	if inst.Syntax != "" {
+		inst.Operands, _ = inst.parseOperands()
+	}
+}
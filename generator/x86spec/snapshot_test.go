@@ -0,0 +1,73 @@
+package x86spec
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	insts := []*Instruction{
+		{Page: 128, Desc: "Add with carry imm8 to AL.", Args: []string{"AL/AX/EAX/RAX", "imm8"}, OpEn: "I", Name: "ADC"},
+		{Syntax: "ADC AL, imm8", Opcode: "14 ib", Valid32: "V", Valid64: "V", Action: "rw,r", GoSyntax: "ADCB imm8, AL", GnuSyntax: "adc imm8, al", Datasize: 8},
+		{Syntax: "VADDPS zmm1 {k1}{z}, zmmV, zmm2/m512/m32bcst", Opcode: "EVEX.NDS.512.0F.W0 58 /r", Valid32: "V", Valid64: "V", Cpuid: "AVX512F", Tags: []string{"operand32"}, Tuple: TupleFV},
+	}
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, &Snapshot{URL: "https://example.com/manual", Instructions: insts}); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != len(insts)+2 {
+		t.Errorf("snapshot has %d lines, want one per instruction and two more:\n%s", n, buf.String())
+	}
+	s, err := ReadSnapshot(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != snapshotVersion || s.URL != "https://example.com/manual" {
+		t.Errorf("ReadSnapshot = version %d, URL %q", s.Version, s.URL)
+	}
+	if len(s.Instructions) != len(insts) {
+		t.Fatalf("ReadSnapshot read %d instructions, want %d", len(s.Instructions), len(insts))
+	}
+	for i, got := range s.Instructions {
+		if got.Opcode != "" && got.Encoding == nil {
+			t.Errorf("%s: Encoding not parsed", got.Syntax)
+		}
+		if got.Syntax != "" && got.Operands == nil {
+			t.Errorf("%s: Operands not parsed", got.Syntax)
+		}
+		got.Encoding, got.Operands = nil, nil
+		if !reflect.DeepEqual(got, insts[i]) {
+			t.Errorf("instruction %d = %+v, want %+v", i, got, insts[i])
+		}
+	}
+
+	old := strings.Replace(buf.String(), `"Version":1`, `"Version":0`, 1)
+	if _, err := ReadSnapshot(strings.NewReader(old)); err == nil {
+		t.Error("ReadSnapshot of version 0 succeeded")
+	}
+}
+
+// TestCheckedInSnapshot checks that the generator's snapshot can be read.
+func TestCheckedInSnapshot(t *testing.T) {
+	f, err := os.Open("../instructions.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s, err := ReadSnapshot(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var forms int
+	for _, inst := range s.Instructions {
+		if inst.Encoding != nil {
+			forms++
+		}
+	}
+	if forms < 3000 {
+		t.Errorf("snapshot has %d instructions with an encoding, want thousands", forms)
+	}
+}
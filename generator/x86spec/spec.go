@@ -22,8 +22,9 @@
 //
 // File Format
 //
-// TODO: Mention comments at top of file.
-// TODO: Mention that this is version 0.2 of the file.
+// The file begins with a comment giving the version of the format, currently
+// "# x86 instruction set description version 0.2". Other lines beginning
+// with # are comments too. WriteCSV writes the format, and ReadCSV reads it.
 // TODO: Mention that file format will change incompatibly until version 1.0.
 //
 // Each CSV line contains these fields:
@@ -197,14 +198,12 @@
 package x86spec
 
 import (
-	"bufio"
+	"context"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"os"
 	"sort"
-	"strings"
 )
 
 const (
@@ -212,31 +211,40 @@ const (
 )
 
 type Instruction struct {
-	Page      int
-	Opcode    string
-	Syntax    string
-	Valid64   string
-	Valid32   string
-	Cpuid     string
-	Desc      string
-	Tags      []string
-	Args      []string
-	Seq       int // for use by cleanup
-	Compat    string
-	Action    string
-	Multisize string
-	Datasize  int
-	GnuSyntax string
-	GoSyntax  string
-	OpEn      string
-	Name      string
+	Page      int       `json:",omitempty"`
+	Opcode    string    `json:",omitempty"`
+	Syntax    string    `json:",omitempty"`
+	Valid64   string    `json:",omitempty"`
+	Valid32   string    `json:",omitempty"`
+	Cpuid     string    `json:",omitempty"`
+	Desc      string    `json:",omitempty"`
+	Tags      []string  `json:",omitempty"`
+	Args      []string  `json:",omitempty"`
+	Seq       int       `json:"-"` // for use by cleanup
+	Compat    string    `json:",omitempty"`
+	Action    string    `json:",omitempty"`
+	Multisize string    `json:",omitempty"`
+	Datasize  int       `json:",omitempty"`
+	GnuSyntax string    `json:",omitempty"`
+	GoSyntax  string    `json:",omitempty"`
+	OpEn      string    `json:",omitempty"`
+	Name      string    `json:",omitempty"`
+	Encoding  *Encoding `json:"-"`          // parsed Opcode, or nil if it could not be parsed
+	Operands  []Operand `json:"-"`          // parsed arguments, or nil if they could not be parsed
+	Tuple     TupleType `json:",omitempty"` // EVEX tuple type, from OpEn
 }
 
 type Config struct {
 	DebugPage string // debug page `n` of the manual (can be comma-separated list)
 	URL       string // use `url` for download if needed (default: https://golang.org/s/x86manual)
 	File      string // read manual from `file`, downloading if necessary (default: x86manual.pdf)
-	Compat    bool   // print compatibility statements
+	Compat    bool   // report compatibility statements as diagnostics
+	Workers   int    // parse `n` pages at once (default: GOMAXPROCS)
+	CacheDir  string // cache the parsed pages of the manual in `dir` (default: no cache)
+
+	// Progress, if set, is called after each of the pages of the manual
+	// is read.
+	Progress func(page, pages int)
 }
 
 func (c Config) debugging() bool {
@@ -247,70 +255,62 @@ func (c Config) onlySomePages() bool {
 	return c.DebugPage != ""
 }
 
-func Load(config *Config) []*Instruction {
+// Load extracts the instructions from the manual, downloading it first if
+// config.File does not exist. Besides the instructions, it returns the
+// problems with the manual that it worked around, or that made it skip an
+// instruction. It returns an error if the manual can not be read, or
+// ctx.Err() if ctx is done first.
+func Load(ctx context.Context, config *Config) ([]*Instruction, []Diagnostic, error) {
 	if config.URL == "" {
 		config.URL = "https://golang.org/s/x86manual"
 	}
 	if config.File == "" {
 		config.File = "x86manual.pdf"
 	}
-	download(config)
-	insts := parse(config)
-	insts = cleanup(config, insts)
+	if err := download(ctx, config); err != nil {
+		return nil, nil, err
+	}
+	l := &loader{Config: config, ctx: ctx}
+	insts, err := parse(l)
+	if err != nil {
+		return nil, l.diags, err
+	}
+	insts = cleanup(l, insts)
 	format(insts)
+	parseEncodings(l, insts)
+	parseOperands(l, insts)
+	parseTuples(insts)
 	sort.Sort(bySyntax(insts))
-	return insts
+	return insts, l.diags, nil
 }
 
-func download(config *Config) {
+func download(ctx context.Context, config *Config) error {
 	_, err := os.Stat(config.File)
 	if !os.IsNotExist(err) {
-		return
+		return nil
 	}
 
 	// Try downloading.
-	log.Printf("downloading manual to %s", config.File)
-	resp, err := http.Get(config.URL)
+	req, err := http.NewRequestWithContext(ctx, "GET", config.URL, nil)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		log.Fatal(resp.Status)
+		return fmt.Errorf("x86spec: downloading %s: %s", config.URL, resp.Status)
 	}
 	f, err := os.Create(config.File)
 	if err != nil {
-		log.Fatal(err)
-	}
-	_, err = io.Copy(f, resp.Body)
-	if err != nil {
-		log.Fatal(err)
-	}
-	if err := f.Close(); err != nil {
-		log.Fatal(err)
+		return err
 	}
-}
-
-func write(w io.Writer, insts []*Instruction) {
-	bw := bufio.NewWriter(w)
-	defer bw.Flush()
-	for _, inst := range insts {
-		datasize := ""
-		if inst.Datasize != 0 {
-			datasize = fmt.Sprint(inst.Datasize)
-		}
-		writeCSV(bw, inst.Syntax, inst.GoSyntax, inst.GnuSyntax, inst.Opcode, inst.Valid32, inst.Valid64, inst.Cpuid, strings.Join(inst.Tags, ","), inst.Action, inst.Multisize, datasize)
-	}
-}
-
-// Note: not using encoding/csv because we want the CSV to use quotes always,
-// so that it is a little easier to process with non-CSV tools like grep,
-// but the encoding/csv package does not have an "always quote" writing mode.
-func writeCSV(w io.Writer, args ...string) {
-	for i, arg := range args {
-		if i > 0 {
-			fmt.Fprintf(w, ",")
-		}
-		fmt.Fprintf(w, `"%s"`, strings.Replace(arg, `"`, `""`, -1))
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		os.Remove(config.File)
+		return err
 	}
-	fmt.Fprintf(w, "\n")
+	return f.Close()
 }
@@ -6,8 +6,12 @@ package x86spec
 
 import (
 	"bytes"
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 	"testing"
@@ -878,18 +882,24 @@ var tests = []struct {
 	`},
 }
 
+var flagFile = flag.String("f", "x86manual.pdf", "read manual from `file`")
+
 func TestOutput(t *testing.T) {
 	if _, err := os.Stat(*flagFile); os.IsNotExist(err) {
 		t.Skipf("no x86manual: %v", err)
 	}
 
 	for _, tt := range tests {
-		*flagDebugPage = tt.pages
-		onlySomePages = true
-		insts := parse()
-		insts = cleanup(insts)
+		l := &loader{Config: &Config{File: *flagFile, DebugPage: tt.pages}, ctx: context.Background()}
+		insts, err := parse(l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		insts = cleanup(l, insts)
 		out := new(bytes.Buffer)
-		write(out, insts)
+		for _, inst := range insts {
+			writeRecord(out, inst.Syntax, inst.Opcode, inst.Valid32, inst.Valid64, inst.Cpuid, strings.Join(inst.Tags, ","))
+		}
 		have := out.String()
 		want := reformat(tt.output)
 		if have != want {
@@ -898,6 +908,45 @@ func TestOutput(t *testing.T) {
 	}
 }
 
+func TestLoadErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	missing := filepath.Join(t.TempDir(), "x86manual.pdf")
+	if _, _, err := Load(ctx, &Config{File: missing, URL: "http://localhost:0/x86manual"}); err == nil {
+		t.Error("Load with a cancelled context succeeded")
+	}
+	if _, err := os.Stat(missing); !os.IsNotExist(err) {
+		t.Errorf("Load with a cancelled context left %s: %v", missing, err)
+	}
+
+	notPDF := filepath.Join(t.TempDir(), "x86manual.pdf")
+	if err := os.WriteFile(notPDF, []byte("not a PDF"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Load(context.Background(), &Config{File: notPDF}); err == nil {
+		t.Error("Load of a file that is not a PDF succeeded")
+	}
+}
+
+func TestCleanupDiagnostics(t *testing.T) {
+	l := &loader{Config: &Config{DebugPage: "1"}, ctx: context.Background()}
+	insts := []*Instruction{
+		{Page: 1, Syntax: "ADD r/m8, r8", Opcode: "00 /r", Args: []string{"ModRM:r/m (r, w)"}},
+		{Page: 2, Syntax: "FROB r8, r/m8", Opcode: "0F FF /r", Args: []string{"ModRM:reg", "ModRM:r/m (r)"}},
+	}
+	cleanup(l, insts)
+	want := []Diagnostic{
+		{1, "ADD r/m8, r8", DiagArgs, "2 args but 1 encoding details:\n\tModRM:r/m (r, w)"},
+		{2, "FROB r8, r/m8", DiagAction, "encoding ModRM:reg for r8 but no r/w annotations"},
+	}
+	if !reflect.DeepEqual(l.diags, want) {
+		t.Errorf("cleanup reported:\n%v\nwant:\n%v", l.diags, want)
+	}
+	if got := want[1].String(); got != "p.2: FROB r8, r/m8: encoding ModRM:reg for r8 but no r/w annotations" {
+		t.Errorf("String = %q", got)
+	}
+}
+
 func indent(s string) string {
 	s = strings.TrimRight(s, "\n")
 	return strings.Join(strings.Split(s, "\n"), "\n\t")
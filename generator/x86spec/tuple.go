@@ -0,0 +1,86 @@
+package x86spec
+
+// A TupleType is the EVEX tuple type of an instruction, which the manual
+// gives in the Op/En column of AVX-512 forms. It says how much memory an
+// instruction accesses, and so the factor N by which EVEX scales an 8-bit
+// displacement (disp8*N).
+type TupleType string
+
+const (
+	TupleFV   TupleType = "FV"    // full vector, or one element with broadcast
+	TupleHV   TupleType = "HV"    // half vector, or one element with broadcast
+	TupleFVM  TupleType = "FVM"   // full vector memory
+	TupleHVM  TupleType = "HVM"   // half vector memory
+	TupleQVM  TupleType = "QVM"   // quarter vector memory
+	TupleOVM  TupleType = "OVM"   // eighth vector memory
+	TupleT1S  TupleType = "T1S"   // one scalar element
+	TupleT1F  TupleType = "T1F"   // one scalar of a fixed size, 32 or 64 bits
+	TupleT2   TupleType = "T2"    // two elements
+	TupleT4   TupleType = "T4"    // four elements
+	TupleT8   TupleType = "T8"    // eight elements
+	TupleM128 TupleType = "M128"  // 128 bits, whatever the vector length
+	TupleDUP  TupleType = "DUP"   // MOVDDUP: 64 bits at 128-bit length, else the full vector
+	TupleT1x4 TupleType = "T1_4X" // four 128-bit blocks read as one 128-bit memory operand
+)
+
+var tupleTypes = map[TupleType]bool{
+	TupleFV: true, TupleHV: true, TupleFVM: true, TupleHVM: true,
+	TupleQVM: true, TupleOVM: true, TupleT1S: true, TupleT1F: true,
+	TupleT2: true, TupleT4: true, TupleT8: true, TupleM128: true,
+	TupleDUP: true, TupleT1x4: true,
+}
+
+// Disp8N returns the factor N by which an 8-bit displacement is scaled for
+// a memory operand of the tuple type. vl is the vector length in bits,
+// elem the size of an element in bits and bcst whether the element is
+// broadcast. For the scalar and tuple types, elem is the size of the
+// element the instruction reads or writes, such as 32 for VADDSS or the
+// index size for a gather. It returns 0 if the tuple type is unknown.
+func (t TupleType) Disp8N(vl, elem int, bcst bool) int {
+	switch t {
+	case TupleFV:
+		if bcst {
+			return elem / 8
+		}
+		return vl / 8
+	case TupleHV:
+		if bcst {
+			return elem / 8
+		}
+		return vl / 16
+	case TupleFVM:
+		return vl / 8
+	case TupleHVM:
+		return vl / 16
+	case TupleQVM:
+		return vl / 32
+	case TupleOVM:
+		return vl / 64
+	case TupleT1S, TupleT1F:
+		return elem / 8
+	case TupleT2:
+		return 2 * elem / 8
+	case TupleT4:
+		return 4 * elem / 8
+	case TupleT8:
+		return 8 * elem / 8
+	case TupleM128, TupleT1x4:
+		return 16
+	case TupleDUP:
+		if vl == 128 {
+			return 8
+		}
+		return vl / 8
+	}
+	return 0
+}
+
+// parseTuples sets the Tuple of each instruction whose Op/En column names
+// a tuple type.
+func parseTuples(insts []*Instruction) {
+	for _, inst := range insts {
+		if t := TupleType(inst.OpEn); tupleTypes[t] {
+			inst.Tuple = t
+		}
+	}
+}
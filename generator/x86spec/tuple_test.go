@@ -0,0 +1,54 @@
+package x86spec
+
+import "testing"
+
+func TestDisp8N(t *testing.T) {
+	tests := []struct {
+		tuple    TupleType
+		vl, elem int
+		bcst     bool
+		want     int
+	}{
+		{TupleFV, 128, 32, false, 16},
+		{TupleFV, 256, 32, false, 32},
+		{TupleFV, 512, 32, false, 64},
+		{TupleFV, 512, 32, true, 4},
+		{TupleFV, 512, 64, true, 8},
+		{TupleHV, 128, 32, false, 8},
+		{TupleHV, 256, 32, false, 16},
+		{TupleHV, 512, 32, false, 32},
+		{TupleHV, 512, 32, true, 4},
+		{TupleFVM, 128, 8, false, 16},
+		{TupleFVM, 256, 8, false, 32},
+		{TupleFVM, 512, 8, false, 64},
+		{TupleHVM, 128, 8, false, 8},
+		{TupleHVM, 256, 8, false, 16},
+		{TupleHVM, 512, 8, false, 32},
+		{TupleQVM, 128, 8, false, 4},
+		{TupleQVM, 256, 8, false, 8},
+		{TupleQVM, 512, 8, false, 16},
+		{TupleOVM, 128, 8, false, 2},
+		{TupleOVM, 256, 8, false, 4},
+		{TupleOVM, 512, 8, false, 8},
+		{TupleT1S, 512, 32, false, 4},
+		{TupleT1S, 128, 64, false, 8},
+		{TupleT1F, 512, 32, false, 4},
+		{TupleT2, 256, 32, false, 8},
+		{TupleT2, 512, 64, false, 16},
+		{TupleT4, 512, 32, false, 16},
+		{TupleT4, 512, 64, false, 32},
+		{TupleT8, 512, 32, false, 32},
+		{TupleM128, 128, 64, false, 16},
+		{TupleM128, 256, 64, false, 16},
+		{TupleM128, 512, 64, false, 16},
+		{TupleDUP, 128, 64, false, 8},
+		{TupleDUP, 256, 64, false, 32},
+		{TupleDUP, 512, 64, false, 64},
+		{TupleType("NONE"), 512, 32, false, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.tuple.Disp8N(tt.vl, tt.elem, tt.bcst); got != tt.want {
+			t.Errorf("%s.Disp8N(%d, %d, %v) = %d, want %d", tt.tuple, tt.vl, tt.elem, tt.bcst, got, tt.want)
+		}
+	}
+}
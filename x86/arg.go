@@ -0,0 +1,225 @@
+package x86
+
+import (
+	"strconv"
+	"strings"
+)
+
+// argSlot says where an operand is encoded in an instruction.
+type argSlot uint8
+
+const (
+	slotImplicit argSlot = iota // not encoded: a fixed register or constant
+	slotReg                     // ModRM reg field
+	slotRM                      // ModRM r/m field, with SIB and displacement for memory
+	slotVVVV                    // VEX or EVEX vvvv field
+	slotOpcode                  // low three bits of the last opcode byte
+	slotIS4                     // top four bits of an immediate byte
+	slotImm                     // immediate
+	slotRel                     // branch displacement
+	slotMoffs                   // absolute memory offset
+)
+
+// arg describes an argument of a form, such as "r/m32", "xmm2/m128" or
+// "imm8", and the operands it accepts.
+type arg struct {
+	syntax   string
+	slot     argSlot
+	class    RegClass // register class accepted, or ClassNone
+	width    int      // register width in bits, or 0 for any
+	mem      bool     // accepts a memory operand
+	memSize  string   // memory form, e.g. "m32fp", or "" for any size
+	memBits  int      // size of the memory form in bits, if known
+	vsib     int      // width of the VSIB index register, or 0
+	fixed    string   // name of the only register accepted, e.g. "AL"
+	constant bool     // accepts only the immediate value
+	value    int64
+	bits     int // size of an immediate, branch displacement or offset
+
+	// EVEX decorations.
+	mask bool // accepts an opmask: {k1}, or {k2} on an opmask destination
+	zero bool // accepts zeroing-masking: {z}
+	bcst int  // size of a broadcast element in bits, or 0
+	er   bool // accepts a static rounding mode: {er}
+	sae  bool // accepts exception suppression alone: {sae}
+}
+
+// newArg returns the argument described by an operand of a form.
+func newArg(o FormOperand) *arg {
+	a := &arg{
+		syntax:  o.Syntax,
+		class:   o.Class,
+		bits:    o.Size,
+		memSize: o.Mem,
+		memBits: o.MemSize,
+		mask:    o.Mask,
+		zero:    o.Zero,
+		bcst:    o.Bcst,
+		er:      o.Rounding == "er",
+		sae:     o.Rounding == "sae",
+	}
+	if o.Class == ClassGP || o.Class == ClassVec {
+		// The other register files hold registers of one width.
+		a.width = o.Width
+	}
+	switch o.Kind {
+	case "implicit":
+		if o.Class == ClassNone {
+			a.constant = true
+			a.value, _ = strconv.ParseInt(o.Fixed, 10, 64)
+		} else {
+			a.fixed = o.Fixed
+		}
+	case "mem", "reg/mem":
+		a.mem = true
+	case "vsib":
+		a.mem, a.vsib, a.class, a.width = true, o.Width, ClassNone, 0
+	}
+	switch o.Slot {
+	case "reg":
+		a.slot = slotReg
+	case "r/m":
+		a.slot = slotRM
+	case "vvvv":
+		a.slot = slotVVVV
+	case "opcode":
+		a.slot = slotOpcode
+	case "is4":
+		a.slot = slotIS4
+	case "imm":
+		switch o.Kind {
+		case "rel":
+			a.slot = slotRel
+		case "mem":
+			a.slot, a.bits = slotMoffs, o.MemSize
+		default:
+			a.slot = slotImm
+		}
+	}
+	return a
+}
+
+// registerNamed returns the index in the register table of the register
+// with the given name.
+func registerNamed(name string) (uint8, bool) {
+	for i, r := range registers {
+		if r.Name == name && name != "" {
+			return uint8(i), true
+		}
+	}
+	return 0, false
+}
+
+func memSizeNamed(name string) (MemSize, bool) {
+	for i, s := range memSizes {
+		if s.name == name {
+			return MemSize(i), true
+		}
+	}
+	return 0, false
+}
+
+// match reports whether the operand is accepted by the argument of form f.
+func (a *arg) match(f *Form, op Operand) bool {
+	masked := false
+	switch o := op.(type) {
+	case Masked:
+		if !a.mask || o.Zero && !a.zero {
+			return false
+		}
+		op, masked = o.Dest, true
+	case Rounded:
+		if o.Mode == SAE && !a.sae || o.Mode != SAE && !a.er {
+			return false
+		}
+		op = o.Reg
+	}
+	if a.mask && !masked && f.HasTag("mask_required") {
+		return false
+	}
+	switch op := op.(type) {
+	case Mem:
+		if !a.mem || a.slot == slotRM && f.HasTag("modrm_regonly") {
+			return false
+		}
+		if op.Broadcast != 0 {
+			// The size hint of a broadcast is that of the element.
+			return a.bcst != 0 && a.bcst*int(op.Broadcast) == a.memBits &&
+				(op.Size == MemAny || op.Size.Bits() == a.bcst)
+		}
+		if op.VSIB() != (a.vsib != 0) || a.vsib != 0 && op.Index.Info().Width != a.vsib {
+			return false
+		}
+		if op.VSIB() && op.Index.Info().EVEX && !f.Encoding.evex() {
+			return false
+		}
+		if a.slot == slotMoffs && (op.Base != 0 || op.HasIndex()) {
+			return false
+		}
+		return a.memSizeMatches(op.Size)
+	case Imm:
+		if a.constant {
+			return int64(op) == a.value
+		}
+		return a.slot == slotImm && a.fitsImm(f, int64(op))
+	case Rel:
+		return a.slot == slotRel && fitsSigned(int64(op), a.bits)
+	case Register:
+		info := op.Info()
+		if info.Class == ClassNone {
+			return false
+		}
+		if a.fixed != "" {
+			return info.Name == a.fixed
+		}
+		if a.class != info.Class || a.width != 0 && a.width != info.Width {
+			return false
+		}
+		if info.EVEX && !f.Encoding.evex() {
+			return false
+		}
+		if a.slot == slotRM && f.HasTag("modrm_memonly") {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// memSizeMatches reports whether a memory operand with the size hint s is
+// accepted. An operand without a hint matches every memory form; a generic
+// hint such as M32 matches every form of that size.
+func (a *arg) memSizeMatches(s MemSize) bool {
+	switch {
+	case s == MemAny, a.memSize == "":
+		return true
+	case s.String() == a.memSize:
+		return true
+	case s <= M512:
+		return s.Bits() == a.memBits
+	}
+	return false
+}
+
+// fitsImm reports whether v can be encoded as the immediate argument a of
+// form f. Immediates are signed, as in the manual, unless the u suffix says
+// otherwise; an immediate as wide as the operation, or one that is not
+// extended to the operand size, may be written either way.
+func (a *arg) fitsImm(f *Form, v int64) bool {
+	switch {
+	case a.bits >= 64:
+		return true
+	case strings.HasSuffix(a.syntax, "u") && f.Datasize != a.bits:
+		return v >= 0 && v < 1<<uint(a.bits)
+	case f.Datasize > a.bits && f.Datasize <= 64, f.Multisize && f.Datasize == 0:
+		return fitsSigned(v, a.bits)
+	}
+	return v >= -1<<uint(a.bits-1) && v < 1<<uint(a.bits)
+}
+
+func fitsSigned(v int64, bits int) bool {
+	if bits >= 64 {
+		return true
+	}
+	return v >= -1<<uint(bits-1) && v < 1<<uint(bits-1)
+}
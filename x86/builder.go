@@ -0,0 +1,285 @@
+package x86
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Instruction is an instruction recorded by a Builder.
+type Instruction struct {
+	Name string    // Intel mnemonic, e.g. "ADD"
+	Func string    // generated function, e.g. "ADD_MR"
+	Args []Operand // the operands, in Intel order
+}
+
+// String returns the instruction in Intel syntax, e.g. "ADD RAX, RBX".
+func (i Instruction) String() string {
+	if len(i.Args) == 0 {
+		return i.Name
+	}
+	return i.Name + " " + formatOperands(i.Args)
+}
+
+// Call returns the instruction as a call of its generated function, as
+// written inside package x86, e.g. "ADD_MR(RAX, RBX)".
+func (i Instruction) Call() string {
+	args := make([]string, len(i.Args))
+	for n, a := range i.Args {
+		args[n] = goOperand(a)
+	}
+	return i.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Encode returns the 64-bit mode machine code of the instruction.
+func (i Instruction) Encode() ([]byte, error) {
+	return i.encode(target{mode: Mode64})
+}
+
+func (i Instruction) encode(t target) ([]byte, error) {
+	_, code, err := selectForm(t, i.Name, i.size(), i.Args)
+	return code, err
+}
+
+// size returns the operand size in bits chosen by the instruction's
+// function, as ADDQ_MI chooses 64, or 0 if the function leaves it to the
+// operands.
+func (i Instruction) size() int {
+	_, bits, _ := sizedFunc(i.Func)
+	return bits
+}
+
+// Builder records a program: an ordered list of instructions. It has a
+// method for every generated instruction function, taking the same
+// parameters, so that code can be built without the side effects of the
+// package functions:
+//
+//	var b Builder
+//	b.MOV_MR(RAX, RDI)
+//	b.ADD_MR(RAX, RSI)
+//	b.RET_NP()
+//	code, err := b.Encode()
+//
+// Branches may target a Label, placed with the Label method:
+//
+//	b.Label("loop")
+//	b.DEC_M(RCX)
+//	b.JNZ(Label("loop"))
+//
+// Setting Mode builds code for 16- or 32-bit mode instead, and setting
+// Features makes an instruction that needs a feature the target lacks an
+// error, rather than an invalid opcode fault when it runs:
+//
+//	b := Builder{Mode: Mode32}
+//	b := Builder{Features: NewFeatures(FeatureSSE2, FeatureAVX, FeatureAVX2)}
+//
+// The zero value is an empty 64-bit program ready to use.
+type Builder struct {
+	Mode     Mode     // processor mode the code runs in; 0 means Mode64
+	Features Features // features of the processors that run the code; 0 means any
+
+	instructions []Instruction
+	labels       map[Label]int // index of the instruction following each label
+	order        []Label       // labels in the order they were placed
+	err          error
+}
+
+func (b *Builder) add(name, fn string, args ...Operand) {
+	b.instructions = append(b.instructions, Instruction{Name: name, Func: fn, Args: args})
+}
+
+// Label places l before the next instruction. Placing a label twice is an
+// error, returned by Encode.
+func (b *Builder) Label(l Label) {
+	if _, ok := b.labels[l]; ok {
+		if b.err == nil {
+			b.err = fmt.Errorf("x86: label %s placed twice", l)
+		}
+		return
+	}
+	if b.labels == nil {
+		b.labels = map[Label]int{}
+	}
+	b.labels[l] = len(b.instructions)
+	b.order = append(b.order, l)
+}
+
+// Instructions returns the instructions recorded so far, in order.
+func (b *Builder) Instructions() []Instruction {
+	return b.instructions
+}
+
+// Len returns the number of instructions recorded so far.
+func (b *Builder) Len() int {
+	return len(b.instructions)
+}
+
+// mode returns the mode the code is built for.
+func (b *Builder) mode() Mode {
+	if b.Mode == 0 {
+		return Mode64
+	}
+	return b.Mode
+}
+
+// target returns what the code is built for.
+func (b *Builder) target() target {
+	return target{mode: b.mode(), features: b.Features}
+}
+
+// Reset removes every instruction and label, so the Builder can be reused.
+// It keeps the Mode and Features. Slices returned by Instructions before
+// the Reset are not changed by the instructions recorded after it.
+func (b *Builder) Reset() {
+	b.instructions = nil
+	b.labels = nil
+	b.order = nil
+	b.err = nil
+}
+
+// branch is an instruction whose target is a label.
+type branch struct {
+	target int  // index of the instruction at the label
+	short  int  // length of the shortest form, usually rel8
+	long   int  // length of the rel32 (rel16) form, or 0 if there is none
+	far    bool // the target is out of reach of the short form
+}
+
+// Encode returns the machine code of the program. Each branch to a label
+// gets the shortest form that reaches: it starts out with its rel8 form,
+// where there is one, and is lengthened to rel32, or rel16 in 16-bit mode,
+// while its target is out of range. If an instruction can not be encoded,
+// the error gives its index.
+func (b *Builder) Encode() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	t := b.target()
+	far := Rel(1 << 30)
+	if t.mode == Mode16 {
+		far = 1 << 14
+	}
+	code := make([][]byte, len(b.instructions))
+	branches := map[int]*branch{}
+	for n, i := range b.instructions {
+		l, ok := i.label()
+		if !ok {
+			c, err := i.encode(t)
+			if err != nil {
+				return nil, fmt.Errorf("instruction %d: %v", n, err)
+			}
+			code[n] = c
+			continue
+		}
+		target, ok := b.labels[l]
+		if !ok {
+			return nil, fmt.Errorf("instruction %d: x86: label %s is not placed", n, l)
+		}
+		short, err := i.resolve(0).encode(t)
+		if err != nil {
+			return nil, fmt.Errorf("instruction %d: %v", n, err)
+		}
+		br := &branch{target: target, short: len(short)}
+		if long, err := i.resolve(far).encode(t); err == nil {
+			br.long = len(long)
+		}
+		branches[n] = br
+	}
+
+	// Lengthening a branch only moves labels further away, so this
+	// terminates.
+	offsets := make([]int, len(b.instructions)+1)
+	for {
+		for n := range b.instructions {
+			size := len(code[n])
+			if br := branches[n]; br != nil {
+				size = br.short
+				if br.far {
+					size = br.long
+				}
+			}
+			offsets[n+1] = offsets[n] + size
+		}
+		changed := false
+		for n, br := range branches {
+			if br.far || br.short == br.long {
+				continue
+			}
+			if !fitsSigned(int64(offsets[br.target]-offsets[n+1]), 8) {
+				if br.long == 0 {
+					return nil, fmt.Errorf("instruction %d: x86: %s out of range", n, b.instructions[n])
+				}
+				br.far = true
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	var out []byte
+	for n := range b.instructions {
+		c := code[n]
+		if br := branches[n]; br != nil {
+			var err error
+			c, err = b.instructions[n].resolve(Rel(offsets[br.target] - offsets[n+1])).encode(t)
+			if err != nil {
+				return nil, fmt.Errorf("instruction %d: %v", n, err)
+			}
+			if len(c) != offsets[n+1]-offsets[n] {
+				return nil, fmt.Errorf("instruction %d: x86: %s encoded in %d bytes, expected %d", n, b.instructions[n], len(c), offsets[n+1]-offsets[n])
+			}
+		}
+		out = append(out, c...)
+	}
+	return out, nil
+}
+
+// label returns the label the instruction branches to, if any.
+func (i Instruction) label() (Label, bool) {
+	for _, a := range i.Args {
+		if l, ok := a.(Label); ok {
+			return l, true
+		}
+	}
+	return "", false
+}
+
+// resolve returns the instruction with its label replaced by rel.
+func (i Instruction) resolve(rel Rel) Instruction {
+	args := make([]Operand, len(i.Args))
+	for n, a := range i.Args {
+		if _, ok := a.(Label); ok {
+			a = rel
+		}
+		args[n] = a
+	}
+	i.Args = args
+	return i
+}
+
+// String returns the program in Intel syntax, one instruction per line, with
+// each label on a line of its own.
+func (b *Builder) String() string {
+	at := b.labelsAt()
+	var s bytes.Buffer
+	for n := 0; n <= len(b.instructions); n++ {
+		for _, l := range at[n] {
+			fmt.Fprintf(&s, "%s:\n", l)
+		}
+		if n < len(b.instructions) {
+			s.WriteString(b.instructions[n].String() + "\n")
+		}
+	}
+	return s.String()
+}
+
+// labelsAt returns the labels placed before each instruction, by index.
+func (b *Builder) labelsAt() map[int][]Label {
+	at := map[int][]Label{}
+	for _, l := range b.order {
+		at[b.labels[l]] = append(at[b.labels[l]], l)
+	}
+	return at
+}
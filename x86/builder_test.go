@@ -0,0 +1,144 @@
+package x86
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	var b Builder
+	b.PUSH_O(RBP)
+	b.MOV_MR(RBP, RSP)
+	b.XOR_MR(EAX, EAX)
+	b.ADD_MI(RAX, Imm(42))
+	b.POP_O(RBP)
+	b.RET_NP()
+
+	if b.Len() != 6 {
+		t.Fatalf("Len = %d, want 6", b.Len())
+	}
+	if got := b.Instructions()[3].Call(); got != "ADD_MI(RAX, Imm(0x2a))" {
+		t.Errorf("Call = %q", got)
+	}
+	want := "PUSH RBP\nMOV RBP, RSP\nXOR EAX, EAX\nADD RAX, 0x2a\nPOP RBP\nRET\n"
+	if got := b.String(); got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	asm, err := Assemble(func(b *Builder) {
+		b.PUSH_O(RBP)
+		b.MOV_MR(RBP, RSP)
+		b.XOR_MR(EAX, EAX)
+		b.ADD_MI(RAX, Imm(42))
+		b.POP_O(RBP)
+		b.RET_NP()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(code, asm) {
+		t.Errorf("Encode = % x, want % x", code, asm)
+	}
+
+	before := b.Instructions()
+	first := before[0].Call()
+	b.Reset()
+	b.NOP_NP()
+	if got := before[0].Call(); got != first {
+		t.Errorf("after Reset, an earlier Instructions()[0] is %s, want %s", got, first)
+	}
+	b.ADD_MI(MustMem(RAX, nil, 0, 0), Imm(1))
+	if _, err := b.Encode(); err == nil {
+		t.Error("Encode with an ambiguous operand size succeeded")
+	}
+}
+
+func TestBuilderSized(t *testing.T) {
+	var b Builder
+	b.ADDQ_MI(MustMem(RAX, nil, 0, 0), Imm(1))
+	b.ADDB_MI(MustMem(RAX, nil, 0, 0), Imm(1))
+	if got := b.Instructions()[0].Call(); got != "ADDQ_MI(Mem{Base: RAX}, Imm(0x1))" {
+		t.Errorf("Call = %q", got)
+	}
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x48, 0x83, 0x00, 0x01, 0x80, 0x00, 0x01}
+	if !bytes.Equal(code, want) {
+		t.Errorf("Encode = % x, want % x", code, want)
+	}
+}
+
+func TestBuilderLabels(t *testing.T) {
+	var b Builder
+	b.Label("loop")
+	b.DEC_M(RCX)
+	b.JNZ(Label("loop"))
+	b.JMP_D(Label("done"))
+	b.NOP_NP()
+	b.Label("done")
+	b.RET_NP()
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x48, 0xff, 0xc9, 0x75, 0xfb, 0xeb, 0x01, 0x90, 0xc3}
+	if !bytes.Equal(code, want) {
+		t.Errorf("Encode = % x, want % x", code, want)
+	}
+	if got, want := b.String(), "loop:\nDEC RCX\nJNZ loop\nJMP done\nNOP\ndone:\nRET\n"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	if got := b.Instructions()[1].Call(); got != `JNZ(Label("loop"))` {
+		t.Errorf("Call = %s", got)
+	}
+
+	// Out of reach of rel8, JZ becomes 0F 84 rel32, while the JMP after it
+	// still reaches with rel8.
+	b.Reset()
+	b.JZ(Label("far"))
+	b.JMP_D(Label("far"))
+	for i := 0; i < 126; i++ {
+		b.NOP_NP()
+	}
+	b.Label("far")
+	b.CALL_D(Label("far"))
+	code, err = b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x0f, 0x84, 0x80, 0x00, 0x00, 0x00}; !bytes.Equal(code[:6], want) {
+		t.Errorf("JZ = % x, want % x", code[:6], want)
+	}
+	if want := []byte{0xeb, 0x7e}; !bytes.Equal(code[6:8], want) {
+		t.Errorf("JMP = % x, want % x", code[6:8], want)
+	}
+	if want := []byte{0xe8, 0xfb, 0xff, 0xff, 0xff}; !bytes.Equal(code[len(code)-5:], want) {
+		t.Errorf("CALL = % x, want % x", code[len(code)-5:], want)
+	}
+
+	for _, f := range []func(b *Builder){
+		func(b *Builder) { b.JMP_D(Label("nowhere")) },
+		func(b *Builder) { b.Label("x"); b.NOP_NP(); b.Label("x") },
+		func(b *Builder) {
+			b.LOOP(Label("far"))
+			for i := 0; i < 200; i++ {
+				b.NOP_NP()
+			}
+			b.Label("far")
+		},
+	} {
+		b.Reset()
+		f(&b)
+		if _, err := b.Encode(); err == nil {
+			t.Errorf("Encode(%q) succeeded", b.String())
+		}
+	}
+	if _, err := Encode("JMP", Label("x")); err == nil {
+		t.Error("Encode with a label succeeded")
+	}
+}
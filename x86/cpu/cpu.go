@@ -0,0 +1,220 @@
+// Package cpu detects the features of the processor a program runs on, in
+// the vocabulary of the x86 package, so that code built at run time can use
+// the instructions the processor and operating system support.
+//
+// The CPUID and XGETBV instructions it executes are built with the x86
+// package and loaded with the jit package, so detection fails where jit
+// does not run code.
+package cpu
+
+import (
+	"sync"
+
+	"github.com/dave/asm/x86"
+	"github.com/dave/asm/x86/jit"
+)
+
+var (
+	detectOnce sync.Once
+	detected   x86.Features
+	detectErr  error
+)
+
+// Detect returns the features of the processor that the operating system
+// has enabled: AVX and its extensions only if the OS saves the YMM
+// registers, and AVX-512 only if it saves the opmask and ZMM registers, as
+// XCR0 says. The result is computed once.
+func Detect() (x86.Features, error) {
+	detectOnce.Do(func() {
+		detected, detectErr = detect()
+	})
+	return detected, detectErr
+}
+
+// Has reports whether the processor has the feature f, as returned by
+// Detect. It is false if detection fails.
+func Has(f x86.Feature) bool {
+	fs, err := Detect()
+	return err == nil && fs.Has(f)
+}
+
+// bit is a CPUID feature bit: bit n of register reg, one of EAX, EBX, ECX
+// and EDX, of a leaf and subleaf.
+type bit struct {
+	leaf, subleaf uint32
+	reg           int
+	n             uint
+	feature       x86.Feature
+}
+
+const (
+	eax = iota
+	ebx
+	ecx
+	edx
+)
+
+var bits = []bit{
+	{1, 0, edx, 8, x86.FeaturePentium},    // CX8
+	{1, 0, edx, 11, x86.FeaturePentiumII}, // SEP: SYSENTER and SYSEXIT
+	{1, 0, edx, 23, x86.FeatureMMX},
+	{1, 0, edx, 25, x86.FeatureSSE},
+	{1, 0, edx, 26, x86.FeatureSSE2},
+	{1, 0, ecx, 0, x86.FeatureSSE3},
+	{1, 0, ecx, 1, x86.FeaturePCLMULQDQ},
+	{1, 0, ecx, 9, x86.FeatureSSSE3},
+	{1, 0, ecx, 12, x86.FeatureFMA},
+	{1, 0, ecx, 19, x86.FeatureSSE41},
+	{1, 0, ecx, 20, x86.FeatureSSE42},
+	{1, 0, ecx, 25, x86.FeatureAES},
+	{1, 0, ecx, 28, x86.FeatureAVX},
+	{1, 0, ecx, 29, x86.FeatureF16C},
+	{1, 0, ecx, 30, x86.FeatureRDRAND},
+	{7, 0, ebx, 0, x86.FeatureFSGSBASE},
+	{7, 0, ebx, 3, x86.FeatureBMI1},
+	{7, 0, ebx, 4, x86.FeatureHLE},
+	{7, 0, ebx, 5, x86.FeatureAVX2},
+	{7, 0, ebx, 8, x86.FeatureBMI2},
+	{7, 0, ebx, 10, x86.FeatureINVPCID},
+	{7, 0, ebx, 11, x86.FeatureRTM},
+	{7, 0, ebx, 14, x86.FeatureMPX},
+	{7, 0, ebx, 16, x86.FeatureAVX512F},
+	{7, 0, ebx, 17, x86.FeatureAVX512DQ},
+	{7, 0, ebx, 18, x86.FeatureRDSEED},
+	{7, 0, ebx, 19, x86.FeatureADX},
+	{7, 0, ebx, 21, x86.FeatureAVX512IFMA},
+	{7, 0, ebx, 26, x86.FeatureAVX512PF},
+	{7, 0, ebx, 27, x86.FeatureAVX512ER},
+	{7, 0, ebx, 28, x86.FeatureAVX512CD},
+	{7, 0, ebx, 29, x86.FeatureSHA},
+	{7, 0, ebx, 30, x86.FeatureAVX512BW},
+	{7, 0, ebx, 31, x86.FeatureAVX512VL},
+	{7, 0, ecx, 0, x86.FeaturePREFETCHWT1},
+	{7, 0, ecx, 1, x86.FeatureAVX512VBMI},
+	{7, 0, ecx, 4, x86.FeatureOSPKE},
+	{7, 0, ecx, 6, x86.FeatureAVX512VBMI2},
+	{7, 0, ecx, 8, x86.FeatureGFNI},
+	{7, 0, ecx, 9, x86.FeatureVAES},
+	{7, 0, ecx, 10, x86.FeatureVPCLMULQDQ},
+	{7, 0, ecx, 11, x86.FeatureAVX512VNNI},
+	{7, 0, ecx, 12, x86.FeatureAVX512BITALG},
+	{7, 0, ecx, 14, x86.FeatureAVX512VPOPCNTDQ},
+	{7, 0, ecx, 22, x86.FeatureRDPID},
+	{0xD, 1, eax, 0, x86.FeatureXSAVEOPT},
+	{0x80000001, 0, ecx, 5, x86.FeatureLZCNT},
+	{0x80000001, 0, ecx, 8, x86.FeaturePRFCHW},
+}
+
+// Features that need state the operating system must save on a context
+// switch, with the XCR0 bits of that state.
+var (
+	avxFeatures = x86.NewFeatures(x86.FeatureAVX, x86.FeatureAVX2, x86.FeatureFMA, x86.FeatureF16C)
+
+	avx512Features = x86.NewFeatures(x86.FeatureAVX512F, x86.FeatureAVX512BW, x86.FeatureAVX512CD,
+		x86.FeatureAVX512DQ, x86.FeatureAVX512ER, x86.FeatureAVX512PF, x86.FeatureAVX512VL,
+		x86.FeatureAVX512BITALG, x86.FeatureAVX512IFMA, x86.FeatureAVX512VBMI, x86.FeatureAVX512VBMI2,
+		x86.FeatureAVX512VNNI, x86.FeatureAVX512VPOPCNTDQ)
+
+	mpxFeatures = x86.NewFeatures(x86.FeatureMPX)
+)
+
+const (
+	xcr0AVX    = 1<<1 | 1<<2                  // SSE and AVX state
+	xcr0AVX512 = xcr0AVX | 1<<5 | 1<<6 | 1<<7 // and opmask, ZMM_Hi256 and Hi16_ZMM state
+	xcr0MPX    = 1<<3 | 1<<4                  // BNDREGS and BNDCSR state
+	osxsave    = 1 << 27                      // CPUID.1:ECX.OSXSAVE
+)
+
+// detect runs CPUID and XGETBV and decodes their results.
+func detect() (x86.Features, error) {
+	cpuid, xgetbv, release, err := load()
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+	return decode(cpuid, xgetbv), nil
+}
+
+// decode returns the features that cpuid and xgetbv report.
+func decode(cpuid func(leaf, subleaf uint32) (a, b, c, d uint32), xgetbv func(xcr uint32) (lo, hi uint32)) x86.Features {
+	// A processor that runs CPUID is at least a 486.
+	fs := x86.NewFeatures(x86.Feature486)
+	maxLeaf, _, _, _ := cpuid(0, 0)
+	maxExt, _, _, _ := cpuid(0x80000000, 0)
+	for _, b := range bits {
+		max := maxLeaf
+		if b.leaf >= 0x80000000 {
+			max = maxExt
+		}
+		if b.leaf > max {
+			continue
+		}
+		a, bx, c, d := cpuid(b.leaf, b.subleaf)
+		if [4]uint32{a, bx, c, d}[b.reg]&(1<<b.n) != 0 {
+			fs = fs.With(b.feature)
+		}
+	}
+
+	var xcr0 uint32
+	if _, _, c, _ := cpuid(1, 0); c&osxsave != 0 {
+		xcr0, _ = xgetbv(0)
+	}
+	if xcr0&xcr0AVX != xcr0AVX {
+		fs &^= avxFeatures
+	}
+	if xcr0&xcr0AVX512 != xcr0AVX512 {
+		fs &^= avx512Features
+	}
+	if xcr0&xcr0MPX != xcr0MPX {
+		fs &^= mpxFeatures
+	}
+	return fs
+}
+
+// load builds and loads the functions that execute CPUID and XGETBV. Under
+// the jit calling convention, the leaf and subleaf arrive in RAX and RBX,
+// and the results leave in RAX, RBX, RCX and RDI.
+func load() (cpuid func(leaf, subleaf uint32) (a, b, c, d uint32), xgetbv func(xcr uint32) (lo, hi uint32), release func(), err error) {
+	var b x86.Builder
+	b.MOV_MR(x86.ECX, x86.EBX)
+	b.CPUID()
+	b.MOV_MR(x86.EDI, x86.EDX)
+	b.RET_NP()
+	cpuidFn, err := loadCode(&b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	b.Reset()
+	b.MOV_MR(x86.ECX, x86.EAX)
+	b.XGETBV()
+	b.MOV_MR(x86.EBX, x86.EDX)
+	b.RET_NP()
+	xgetbvFn, err := loadCode(&b)
+	if err != nil {
+		cpuidFn.Release()
+		return nil, nil, nil, err
+	}
+
+	release = func() {
+		cpuidFn.Release()
+		xgetbvFn.Release()
+	}
+	if err := cpuidFn.Bind(&cpuid); err != nil {
+		release()
+		return nil, nil, nil, err
+	}
+	if err := xgetbvFn.Bind(&xgetbv); err != nil {
+		release()
+		return nil, nil, nil, err
+	}
+	return cpuid, xgetbv, release, nil
+}
+
+func loadCode(b *x86.Builder) (*jit.Func, error) {
+	code, err := b.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return jit.Load(code)
+}
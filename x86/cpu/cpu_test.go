@@ -0,0 +1,88 @@
+package cpu
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/dave/asm/x86"
+)
+
+// fakeCPU answers CPUID from a table of leaves and XGETBV with xcr0.
+type fakeCPU struct {
+	leaves map[[2]uint32][4]uint32
+	xcr0   uint32
+}
+
+func (c fakeCPU) cpuid(leaf, subleaf uint32) (a, b, cx, d uint32) {
+	r := c.leaves[[2]uint32{leaf, subleaf}]
+	return r[0], r[1], r[2], r[3]
+}
+
+func (c fakeCPU) xgetbv(xcr uint32) (lo, hi uint32) {
+	return c.xcr0, 0
+}
+
+func TestDecode(t *testing.T) {
+	// A processor with SSE2, AVX2 and AVX-512F, and an extended leaf for
+	// LZCNT.
+	leaves := map[[2]uint32][4]uint32{
+		{0, 0}:          {7, 0, 0, 0},
+		{1, 0}:          {0, 0, 1<<27 | 1<<28, 1<<11 | 1<<25 | 1<<26},
+		{7, 0}:          {0, 1<<5 | 1<<16, 0, 0},
+		{0x80000000, 0}: {0x80000001, 0, 0, 0},
+		{0x80000001, 0}: {0, 0, 1 << 5, 0},
+	}
+	base := x86.NewFeatures(x86.Feature486, x86.FeaturePentiumII, x86.FeatureSSE, x86.FeatureSSE2, x86.FeatureLZCNT)
+	tests := []struct {
+		xcr0 uint32
+		want x86.Features
+	}{
+		{0xe7, base.With(x86.FeatureAVX, x86.FeatureAVX2, x86.FeatureAVX512F)},
+		{0x07, base.With(x86.FeatureAVX, x86.FeatureAVX2)},
+		{0x03, base},
+	}
+	for _, tt := range tests {
+		c := fakeCPU{leaves, tt.xcr0}
+		if got := decode(c.cpuid, c.xgetbv); got != tt.want {
+			t.Errorf("XCR0 %#x: decode = %s, want %s", tt.xcr0, got, tt.want)
+		}
+	}
+
+	// Without OSXSAVE, XCR0 can not be read, and AVX is not usable. CMOV
+	// (bit 15) is not what PentiumII stands for.
+	leaves[[2]uint32{1, 0}] = [4]uint32{0, 0, 1 << 28, 1<<15 | 1<<26}
+	c := fakeCPU{leaves, 0xe7}
+	if got, want := decode(c.cpuid, c.xgetbv), x86.NewFeatures(x86.Feature486, x86.FeatureSSE2, x86.FeatureLZCNT); got != want {
+		t.Errorf("without OSXSAVE: decode = %s, want %s", got, want)
+	}
+
+	// Leaves past the maximum are not read.
+	leaves[[2]uint32{0, 0}] = [4]uint32{1, 0, 0, 0}
+	if got := decode(c.cpuid, c.xgetbv); got.Has(x86.FeatureAVX512F) {
+		t.Errorf("decode read leaf 7 past the maximum leaf: %s", got)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	fs, err := Detect()
+	if runtime.GOOS != "linux" || runtime.GOARCH != "amd64" {
+		if err == nil {
+			t.Errorf("Detect on %s/%s succeeded", runtime.GOOS, runtime.GOARCH)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every amd64 processor runs x86-64-v1.
+	if v1, _ := x86.LookupProfile("x86-64-v1"); !fs.Contains(v1.Features) {
+		t.Errorf("Detect = %s, which lacks x86-64-v1", fs)
+	}
+	if fs.Has(x86.FeatureAVX512F) && !fs.Has(x86.FeatureAVX) {
+		t.Errorf("Detect = %s, with AVX-512 but not AVX", fs)
+	}
+	if !Has(x86.FeatureSSE2) {
+		t.Error("Has(SSE2) is false")
+	}
+	t.Logf("features: %s", fs)
+}
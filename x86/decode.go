@@ -0,0 +1,755 @@
+package x86
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Inst is a decoded instruction.
+type Inst struct {
+	Form *Form     // the form of the instruction
+	Args []Operand // the operands, in Intel order
+	Len  int       // length of the encoding in bytes
+}
+
+// String returns the instruction in Intel syntax, e.g. "ADD [RBX], RAX".
+func (i Inst) String() string {
+	if len(i.Args) == 0 {
+		return i.Form.Mnemonic()
+	}
+	return i.Form.Mnemonic() + " " + formatOperands(i.Args)
+}
+
+// Call returns the instruction as a call of its generated function, as
+// written inside package x86, e.g. "ADD_MR(Mem{Base: RBX}, RAX)". It returns
+// "" if the form has no generated function taking its arguments.
+func (i Inst) Call() string {
+	if i.Form.Func == "" {
+		return ""
+	}
+	args := make([]string, len(i.Args))
+	for n, a := range i.Args {
+		args[n] = goOperand(a)
+	}
+	return i.Form.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+// goOperand returns the Go expression of an operand.
+func goOperand(op Operand) string {
+	switch op := op.(type) {
+	case Imm:
+		return fmt.Sprintf("Imm(%#x)", int64(op))
+	case Rel:
+		return fmt.Sprintf("Rel(%d)", int32(op))
+	case Label:
+		return fmt.Sprintf("Label(%q)", string(op))
+	case Mem:
+		var fields []string
+		if op.Symbol != "" {
+			fields = append(fields, fmt.Sprintf("Symbol: %q", op.Symbol))
+		}
+		if op.Segment != 0 {
+			fields = append(fields, "Segment: "+goOperand(op.Segment))
+		}
+		if op.Base != 0 {
+			fields = append(fields, "Base: "+goOperand(op.Base))
+		}
+		if op.HasIndex() {
+			fields = append(fields, "Index: "+goOperand(op.Index), fmt.Sprintf("Scale: %d", op.Scale))
+		}
+		if op.Disp != 0 {
+			fields = append(fields, fmt.Sprintf("Disp: %#x", op.Disp))
+		}
+		if op.Size != MemAny && int(op.Size) < len(memSizes) {
+			fields = append(fields, "Size: "+memSizes[op.Size].id)
+		}
+		if op.Broadcast != 0 {
+			fields = append(fields, fmt.Sprintf("Broadcast: %d", op.Broadcast))
+		}
+		return "Mem{" + strings.Join(fields, ", ") + "}"
+	case Masked:
+		s := "Mask(" + goOperand(op.Dest) + ", " + goOperand(op.Mask) + ")"
+		if op.Zero {
+			s += ".Z()"
+		}
+		return s
+	case Rounded:
+		mode := op.Mode.String()
+		if int(op.Mode) < len(roundings) {
+			mode = roundings[op.Mode].id
+		}
+		return "Round(" + goOperand(op.Reg) + ", " + mode + ")"
+	}
+	return strings.NewReplacer("(", "", ")", "").Replace(op.String())
+}
+
+// ErrTruncated is returned by Decode when the code ends in the middle of
+// an instruction.
+var ErrTruncated = errors.New("x86: truncated instruction")
+
+var errNoMatch = errors.New("x86: no matching form")
+
+// Decode decodes the first instruction in code, in 64-bit mode. It accepts
+// the instructions that Encode produces: prefixes that no form asks for,
+// such as REP, are rejected. LOCK, XACQUIRE and XRELEASE are instructions
+// of their own, as Encode writes them, so F0 01 03 decodes as LOCK, with
+// ADD [RBX], EAX following it.
+func Decode(code []byte) (Inst, error) {
+	return Mode64.Decode(code)
+}
+
+// Decode decodes the first instruction in code, in mode m, as Decode does
+// for 64-bit mode. An FWAIT followed by the no-wait form of a waiting
+// instruction, such as FNSTSW, decodes as the waiting form, FSTSW.
+func (m Mode) Decode(code []byte) (Inst, error) {
+	if err := m.check(); err != nil {
+		return Inst{}, err
+	}
+	decodeOnce.Do(indexDecoder)
+	index := decodeIndexes[m]
+	if len(code) > 1 && code[0] == 0x9B {
+		if inst, err := index.decode(m, code[1:]); err == nil {
+			if w := index.wait[waitKey(inst.Form)]; w != nil {
+				inst.Form, inst.Len = w, inst.Len+1
+				return inst, nil
+			}
+		}
+	}
+	inst, err := index.decode(m, code)
+	if err != nil && (err != ErrTruncated || len(code) == 1) && len(code) > 0 {
+		if f := index.bare[code[0]]; f != nil {
+			return Inst{Form: f, Len: 1}, nil
+		}
+	}
+	return inst, err
+}
+
+func (index *decodeIndex) decode(mode Mode, code []byte) (Inst, error) {
+	d := &decoder{code: code, mode: mode}
+	if err := d.prefixes(); err != nil {
+		return Inst{}, err
+	}
+	if d.pos >= len(code) {
+		return Inst{}, ErrTruncated
+	}
+	var best Inst
+	bestScore := -1
+	truncated := false
+	// As in Encode, pseudo forms are only tried when no other form matches.
+	for _, forms := range []map[byte][]*compiledForm{index.forms, index.pseudo} {
+		for _, c := range forms[code[d.pos]] {
+			inst, score, err := d.match(c)
+			if err == ErrTruncated {
+				truncated = true
+			}
+			if err == nil && score > bestScore {
+				best, bestScore = inst, score
+			}
+		}
+		if bestScore >= 0 {
+			break
+		}
+	}
+	if bestScore < 0 {
+		if truncated {
+			return Inst{}, ErrTruncated
+		}
+		if len(code) > 15 {
+			code = code[:15]
+		}
+		return Inst{}, fmt.Errorf("x86: unknown instruction % x", code)
+	}
+	return best, nil
+}
+
+// decodeIndex holds the forms that can be decoded in one mode.
+type decodeIndex struct {
+	forms  map[byte][]*compiledForm // forms by first opcode byte
+	pseudo map[byte][]*compiledForm // pseudo forms by first opcode byte
+	bare   map[byte]*Form           // prefixes that are forms, like LOCK
+	wait   map[string]*Form         // waiting forms by the waitKey of their no-wait forms
+}
+
+var (
+	decodeOnce    sync.Once
+	decodeIndexes map[Mode]*decodeIndex
+)
+
+// indexDecoder indexes the forms that can be decoded in each mode by the
+// first byte of their opcode.
+func indexDecoder() {
+	// A form that ignores REX.W, like SYSEXIT, has a pseudo form with
+	// REX.W, which decoding keeps.
+	rexW := map[string]bool{}
+	for i := range forms {
+		if f := &forms[i]; f.HasTag("ignoreREXW") {
+			rexW["REX.W "+f.Opcode] = true
+		}
+	}
+	decodeIndexes = map[Mode]*decodeIndex{}
+	for _, m := range []Mode{Mode16, Mode32, Mode64} {
+		index := &decodeIndex{
+			forms:  map[byte][]*compiledForm{},
+			pseudo: map[byte][]*compiledForm{},
+			bare:   map[byte]*Form{},
+			wait:   map[string]*Form{},
+		}
+		for i := range forms {
+			f := &forms[i]
+			if !m.valid(f) {
+				continue
+			}
+			c := compile(f)
+			if c.err != nil {
+				continue
+			}
+			if len(c.args) == 0 && len(c.enc.Opcode) == 1 && isLegacyPrefix(c.enc.Opcode[0]) {
+				index.bare[c.enc.Opcode[0]] = f
+				continue
+			}
+			if c.enc.wait() {
+				index.wait[waitKey(f)] = f
+				continue
+			}
+			byte0 := index.forms
+			if f.HasTag("pseudo") && !rexW[f.Opcode] {
+				byte0 = index.pseudo
+			}
+			first := c.enc.Opcode[0]
+			if c.enc.Plus != "" && len(c.enc.Opcode) == 1 {
+				for r := byte(0); r < 8; r++ {
+					byte0[first+r] = append(byte0[first+r], c)
+				}
+				continue
+			}
+			byte0[first] = append(byte0[first], c)
+		}
+		decodeIndexes[m] = index
+	}
+}
+
+// waitKey returns the opcode and arguments of a form, without the FWAIT of
+// a waiting form, so that FSTSW m2byte and FNSTSW m2byte have the same key.
+func waitKey(f *Form) string {
+	return strings.TrimPrefix(f.Opcode, "9B ") + " " + strings.Join(f.Args(), ", ")
+}
+
+// decoder holds the prefixes of the instruction being decoded.
+type decoder struct {
+	code   []byte
+	mode   Mode
+	pos    int // start of the opcode
+	seg    SegReg
+	opsize bool // 66
+	addr   bool // 67
+	rep    byte // F2 or F3
+	rex    byte // REX prefix, or 0
+	vex    *VEX // VEX or EVEX prefix, or nil
+
+	// Extension bits from REX, VEX or EVEX: R, X, B, W, R', V' and the
+	// vvvv register number.
+	r, x, b, w, rhi, vhi, vvvv byte
+
+	// EVEX opmask register, zeroing, and broadcast or rounding control.
+	aaa, z, bc byte
+}
+
+var segmentByPrefix = map[byte]SegReg{0x26: ES, 0x2E: CS, 0x36: SS, 0x3E: DS, 0x64: FS, 0x65: GS}
+
+// isLegacyPrefix reports whether b is a segment, operand-size,
+// address-size, LOCK or REP prefix.
+func isLegacyPrefix(b byte) bool {
+	switch b {
+	case 0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65, 0x66, 0x67, 0xF0, 0xF2, 0xF3:
+		return true
+	}
+	return false
+}
+
+func (d *decoder) prefixes() error {
+	code := d.code
+	for ; d.pos < len(code); d.pos++ {
+		switch p := code[d.pos]; p {
+		case 0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65:
+			d.seg = segmentByPrefix[p]
+			continue
+		case 0x66:
+			d.opsize = true
+			continue
+		case 0x67:
+			d.addr = true
+			continue
+		case 0xF2, 0xF3:
+			d.rep = p
+			continue
+		case 0xF0:
+			return fmt.Errorf("x86: LOCK prefix is only decoded as an instruction of its own")
+		}
+		break
+	}
+	if d.mode == Mode64 && d.pos < len(code) && code[d.pos]&0xF0 == 0x40 {
+		d.rex = code[d.pos]
+		d.w, d.r, d.x, d.b = d.rex>>3&1, d.rex>>2&1, d.rex>>1&1, d.rex&1
+		d.pos++
+		// A REX prefix followed by another prefix is ignored, or makes
+		// VEX and EVEX undefined, so Encode never writes one.
+		if d.pos < len(code) && (isLegacyPrefix(code[d.pos]) || code[d.pos]&0xF0 == 0x40 ||
+			code[d.pos] == 0xC4 || code[d.pos] == 0xC5 || code[d.pos] == 0x62) {
+			return fmt.Errorf("x86: REX prefix %02x is not right before the opcode", d.rex)
+		}
+		return nil
+	}
+	if d.pos >= len(code) {
+		return nil
+	}
+	// Outside 64-bit mode, C4, C5 and 62 are LES, LDS and BOUND unless
+	// the next byte would be a ModRM byte selecting a register, which
+	// those do not take.
+	if d.mode != Mode64 && (d.pos+1 >= len(code) || code[d.pos+1]&0xC0 != 0xC0) {
+		return nil
+	}
+	switch code[d.pos] {
+	case 0xC5:
+		if d.pos+2 >= len(code) {
+			return ErrTruncated
+		}
+		p := code[d.pos+1]
+		d.vex = &VEX{MMMMM: 1, L: p >> 2 & 1, PP: p & 3}
+		d.r, d.vvvv = ^p>>7&1, ^p>>3&15
+		d.pos += 2
+	case 0xC4:
+		if d.pos+3 >= len(code) {
+			return ErrTruncated
+		}
+		p0, p1 := code[d.pos+1], code[d.pos+2]
+		d.vex = &VEX{MMMMM: p0 & 31, L: p1 >> 2 & 1, PP: p1 & 3}
+		d.r, d.x, d.b = ^p0>>7&1, ^p0>>6&1, ^p0>>5&1
+		d.w, d.vvvv = p1>>7, ^p1>>3&15
+		d.pos += 3
+	case 0x62:
+		if d.pos+4 >= len(code) {
+			return ErrTruncated
+		}
+		p0, p1, p2 := code[d.pos+1], code[d.pos+2], code[d.pos+3]
+		d.vex = &VEX{EVEX: true, MMMMM: p0 & 3, L: p2 >> 5 & 3, PP: p1 & 3}
+		d.r, d.x, d.b, d.rhi = ^p0>>7&1, ^p0>>6&1, ^p0>>5&1, ^p0>>4&1
+		d.w, d.vvvv, d.vhi = p1>>7, ^p1>>3&15, ^p2>>3&1
+		d.z, d.bc, d.aaa = p2>>7, p2>>4&1, p2&7
+		d.pos += 4
+	}
+	return nil
+}
+
+// match decodes the instruction as form c. The score ranks the forms that
+// match: the more of the encoding a form pins down, the higher it is.
+func (d *decoder) match(c *compiledForm) (Inst, int, error) {
+	e, f := c.enc, c.form
+	score := 0
+
+	// Prefixes.
+	if (e.VEX == nil) != (d.vex == nil) {
+		return Inst{}, 0, errNoMatch
+	}
+	if v := e.VEX; v != nil {
+		if v.EVEX != d.vex.EVEX || v.MMMMM != d.vex.MMMMM || v.PP != d.vex.PP ||
+			!v.LIG && v.L != d.vex.L && d.bc == 0 || !v.WIG && v.W != d.w ||
+			v.VVVV == "" && d.vvvv != 0 {
+			return Inst{}, 0, errNoMatch
+		}
+		if d.opsize || d.rep != 0 {
+			return Inst{}, 0, errNoMatch
+		}
+	} else {
+		opsize, rep := d.opsize, d.rep
+		for _, p := range e.mandatory() {
+			switch {
+			case p == 0x66 && opsize:
+				opsize = false
+			case p == rep:
+				rep = 0
+			default:
+				return Inst{}, 0, errNoMatch
+			}
+			score += 2
+		}
+		if rep != 0 {
+			return Inst{}, 0, errNoMatch
+		}
+		if opsize != c.operandSizePrefix(d.mode) && !(opsize && f.HasTag("operand"+strconv.Itoa(d.mode.overrideSize()))) {
+			return Inst{}, 0, errNoMatch
+		}
+		if d.w == 1 && !e.REXW && !f.HasTag("ignoreREXW") && !f.HasTag("operand64") || d.w == 0 && e.REXW ||
+			e.REXR && d.r == 0 {
+			return Inst{}, 0, errNoMatch
+		}
+		if e.REXW {
+			score++
+		}
+	}
+	tagged, sized := false, false
+	for _, t := range sizeTags {
+		if f.HasTag(t.address) {
+			tagged, sized = true, sized || t.bits == d.addressSize()
+		}
+	}
+	if tagged && !sized {
+		return Inst{}, 0, errNoMatch
+	}
+
+	// Opcode.
+	pos := d.pos
+	if pos+len(e.Opcode) > len(d.code) {
+		return Inst{}, 0, ErrTruncated
+	}
+	var low byte
+	for i, b := range e.Opcode {
+		got := d.code[pos+i]
+		if e.Plus != "" && i == len(e.Opcode)-1 {
+			low, got = got&7, got&^7
+		}
+		if got != b {
+			return Inst{}, 0, errNoMatch
+		}
+	}
+	pos += len(e.Opcode)
+	score += 4 * len(e.Opcode)
+
+	var rmArg *arg
+	for _, a := range c.args {
+		if a.slot == slotRM {
+			rmArg = a
+		}
+	}
+
+	// ModRM, SIB and displacement.
+	var mod, regField, rmField byte
+	var mem Mem
+	if e.ModRM {
+		if pos >= len(d.code) {
+			return Inst{}, 0, ErrTruncated
+		}
+		modrm := d.code[pos]
+		pos++
+		mod, regField, rmField = modrm>>6, modrm>>3&7, modrm&7
+		if e.Digit >= 0 {
+			if int(regField) != e.Digit {
+				return Inst{}, 0, errNoMatch
+			}
+			score++
+		}
+		if mod == 3 && (f.HasTag("modrm_memonly") || rmArg != nil && rmArg.class == ClassNone) ||
+			mod != 3 && (f.HasTag("modrm_regonly") || rmArg != nil && !rmArg.mem) {
+			return Inst{}, 0, errNoMatch
+		}
+		if mod != 3 {
+			var err error
+			scale := 1
+			if d.vex != nil && d.vex.EVEX {
+				scale = c.disp8Scale(d.bc == 1)
+			}
+			if mod == 1 && scale == 0 {
+				return Inst{}, 0, errNoMatch
+			}
+			mem, pos, err = d.memory(pos, mod, rmField, rmArg, scale)
+			if err != nil {
+				return Inst{}, 0, err
+			}
+		}
+	}
+	// With EVEX.b, L'L holds the rounding mode if r/m is a register, and
+	// the vector length as usual for a broadcast.
+	rounding := d.bc == 1 && e.ModRM && mod == 3
+	if v := e.VEX; v != nil && d.bc == 1 && !rounding && !v.LIG && v.L != d.vex.L {
+		return Inst{}, 0, errNoMatch
+	}
+
+	// Immediates.
+	var imms []int64
+	for i := range e.Imm {
+		size := e.immSize(i, d.addressSize())
+		if pos+size > len(d.code) {
+			return Inst{}, 0, ErrTruncated
+		}
+		var v uint64
+		for i := size - 1; i >= 0; i-- {
+			v = v<<8 | uint64(d.code[pos+i])
+		}
+		imms = append(imms, int64(v))
+		pos += size
+	}
+	var is4 byte
+	if e.Is4 {
+		if pos >= len(d.code) {
+			return Inst{}, 0, ErrTruncated
+		}
+		is4 = d.code[pos] >> 4
+		pos++
+	}
+	for _, b := range e.Suffix {
+		if pos >= len(d.code) {
+			return Inst{}, 0, ErrTruncated
+		}
+		if d.code[pos] != b {
+			return Inst{}, 0, errNoMatch
+		}
+		pos++
+	}
+	score += 4 * len(e.Suffix)
+
+	// Operands.
+	inst := Inst{Form: f, Len: pos}
+	var used decorated
+	for _, a := range c.args {
+		var op Operand
+		var ok bool
+		switch a.slot {
+		case slotImplicit:
+			if a.constant {
+				op, ok = Imm(a.value), true
+			} else {
+				var r uint8
+				r, ok = registerNamed(a.fixed)
+				op = regOperand(r)
+			}
+		case slotReg:
+			op, ok = d.register(a, regField|d.r<<3|d.rhi<<4)
+		case slotRM:
+			if mod == 3 {
+				num := rmField | d.b<<3
+				if d.vex != nil && d.vex.EVEX {
+					num |= d.x << 4
+				}
+				op, ok = d.register(a, num)
+			} else {
+				op, ok = mem, true
+			}
+		case slotVVVV:
+			op, ok = d.register(a, d.vvvv|d.vhi<<4)
+		case slotOpcode:
+			op, ok = d.register(a, low|d.b<<3)
+		case slotIS4:
+			op, ok = d.register(a, is4)
+		case slotImm:
+			op, ok = Imm(extend(imms[0], a.bits, !strings.HasSuffix(a.syntax, "u"))), true
+			imms = imms[1:]
+		case slotRel:
+			op, ok = Rel(extend(imms[0], a.bits, true)), true
+			imms = imms[1:]
+		case slotMoffs:
+			op, ok = Mem{Segment: d.seg, Disp: imms[0], Size: a.memSizeHint()}, true
+			imms = imms[1:]
+		}
+		if !ok {
+			return Inst{}, 0, errNoMatch
+		}
+		inst.Args = append(inst.Args, d.decorate(a, op, rounding, &used))
+	}
+	if d.aaa != 0 && !used.mask || d.z == 1 && !used.zero || d.bc == 1 && !used.bc ||
+		d.aaa == 0 && f.HasTag("mask_required") {
+		return Inst{}, 0, errNoMatch
+	}
+	return inst, score, nil
+}
+
+// decorated records which of the EVEX decorations of an instruction were
+// taken up by its operands.
+type decorated struct {
+	mask, zero, bc bool
+}
+
+// decorate adds the EVEX decorations that argument a accepts to the
+// decoded operand op.
+func (d *decoder) decorate(a *arg, op Operand, rounding bool, used *decorated) Operand {
+	if m, ok := op.(Mem); ok && d.bc == 1 && a.bcst != 0 {
+		m.Broadcast = uint8(a.memBits / a.bcst)
+		m.Size, _ = memSizeNamed("m" + strconv.Itoa(a.bcst))
+		op, used.bc = m, true
+	}
+	if r, ok := op.(Register); ok && rounding && (a.er || a.sae) {
+		mode := SAE
+		if a.er {
+			mode = Rounding(d.vex.L)
+		}
+		op, used.bc = Round(r, mode), true
+	}
+	if a.mask && d.aaa != 0 {
+		k, _ := d.lookup(ClassMask, 0, d.aaa)
+		m := Mask(op, MaskReg(k))
+		if _, mem := op.(Mem); d.z == 1 && a.zero && !mem {
+			m, used.zero = m.Z(), true
+		}
+		op, used.mask = m, true
+	}
+	return op
+}
+
+// memory decodes the memory operand addressed by a ModRM byte at code[pos-1].
+// A one byte displacement is multiplied by scale.
+func (d *decoder) memory(pos int, mod, rm byte, a *arg, scale int) (Mem, int, error) {
+	m := Mem{Segment: d.seg}
+	if a != nil {
+		m.Size = a.memSizeHint()
+	}
+	width := d.addressSize()
+	gp := func(num byte) Reg {
+		r, _ := d.lookup(ClassGP, width, num)
+		return Reg(r)
+	}
+	dispSize := map[byte]int{0: 0, 1: 1, 2: 4}[mod]
+	switch {
+	case width == 16:
+		if a != nil && a.vsib != 0 {
+			return m, pos, errNoMatch
+		}
+		if rm == 6 && mod == 0 {
+			dispSize = 2
+			break
+		}
+		if mod == 2 {
+			dispSize = 2
+		}
+		pair := base16[rm]
+		m.Base = pair[0]
+		if pair[1] != 0 {
+			m.Index, m.Scale = pair[1], 1
+		}
+	case rm == 4:
+		if pos >= len(d.code) {
+			return m, pos, ErrTruncated
+		}
+		sib := d.code[pos]
+		pos++
+		index, base := sib>>3&7|d.x<<3, sib&7
+		switch {
+		case a != nil && a.vsib != 0:
+			r, _ := d.lookup(ClassVec, a.vsib, index|d.vhi<<4)
+			m.Index, m.Scale = VecReg(r), 1<<(sib>>6)
+		case index != 4:
+			m.Index, m.Scale = gp(index), 1<<(sib>>6)
+		}
+		if base == 5 && mod == 0 {
+			dispSize = 4
+		} else {
+			m.Base = gp(base | d.b<<3)
+		}
+	case rm == 5 && mod == 0:
+		// Outside 64-bit mode, this is an absolute address.
+		dispSize = 4
+		switch {
+		case d.mode != Mode64:
+		case width == 32:
+			m.Base = EIP
+		default:
+			m.Base = RIP
+		}
+	default:
+		m.Base = gp(rm | d.b<<3)
+	}
+	if pos+dispSize > len(d.code) {
+		return m, pos, ErrTruncated
+	}
+	var v uint64
+	for i := dispSize - 1; i >= 0; i-- {
+		v = v<<8 | uint64(d.code[pos+i])
+	}
+	m.Disp = extend(int64(v), dispSize*8, true)
+	if dispSize == 1 {
+		m.Disp *= int64(scale)
+	}
+	return m, pos + dispSize, nil
+}
+
+// base16 is the base and index register of each r/m field of 16-bit
+// addressing, as rm16 encodes them; a lone SI or DI is the base.
+var base16 = [8][2]Reg{{BX, SI}, {BX, DI}, {BP, SI}, {BP, DI}, {SI, 0}, {DI, 0}, {BP, 0}, {BX, 0}}
+
+// addressSize returns the address size in bits of the instruction: that of
+// the mode, or the one the 67 prefix selects.
+func (d *decoder) addressSize() int {
+	switch {
+	case !d.addr:
+		return int(d.mode)
+	case d.mode == Mode32:
+		return 16
+	}
+	return 32
+}
+
+// register returns the register selected by num for argument a.
+func (d *decoder) register(a *arg, num byte) (Operand, bool) {
+	switch a.class {
+	case ClassMMX, ClassSeg, ClassX87:
+		num &= 7
+	}
+	r, ok := d.lookup(a.class, a.width, num)
+	if !ok {
+		return nil, false
+	}
+	return regOperand(r), true
+}
+
+// lookup returns the index in the register table of the register with the
+// given class, width (0 for any) and number.
+func (d *decoder) lookup(class RegClass, width int, num byte) (uint8, bool) {
+	for i, r := range registers {
+		if r.Class != class || width != 0 && r.Width != width || r.Num != num {
+			continue
+		}
+		// Byte registers 4-7 are SPL-DIL with a REX prefix and AH-BH without.
+		if r.NoREX && d.rex != 0 || r.REX && r.Num < 8 && d.rex == 0 {
+			continue
+		}
+		if d.mode != Mode64 && (r.REX || r.Num >= 8) {
+			continue
+		}
+		return uint8(i), true
+	}
+	return 0, false
+}
+
+// regOperand returns the register with index i in the register table as
+// an operand of its register type.
+func regOperand(i uint8) Operand {
+	switch registers[i].Class {
+	case ClassGP, ClassIP:
+		return Reg(i)
+	case ClassVec:
+		return VecReg(i)
+	case ClassMask:
+		return MaskReg(i)
+	case ClassSeg:
+		return SegReg(i)
+	case ClassX87:
+		return X87Reg(i)
+	case ClassMMX:
+		return MMXReg(i)
+	case ClassCtrl:
+		return CtrlReg(i)
+	case ClassDebug:
+		return DebugReg(i)
+	case ClassBnd:
+		return BndReg(i)
+	}
+	return nil
+}
+
+// memSizeHint returns the size hint of the memory form of a.
+func (a *arg) memSizeHint() MemSize {
+	s, _ := memSizeNamed(a.memSize)
+	return s
+}
+
+// extend sign- or zero-extends the low bits of v.
+func extend(v int64, bits int, signed bool) int64 {
+	if bits == 0 || bits >= 64 {
+		return v
+	}
+	shift := uint(64 - bits)
+	if signed {
+		return v << shift >> shift
+	}
+	return int64(uint64(v) << shift >> shift)
+}
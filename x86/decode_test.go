@@ -0,0 +1,331 @@
+package x86
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		code []byte
+		want string
+		call string
+	}{
+		{[]byte{0x48, 0x01, 0x03}, "ADD [RBX], RAX", "ADD_MR(Mem{Base: RBX, Size: M64}, RAX)"},
+		{[]byte{0x48, 0x83, 0x44, 0xcb, 0x10, 0x01}, "ADD [RBX+RCX*8+0x10], 0x1", "ADD_MI(Mem{Base: RBX, Index: RCX, Scale: 8, Disp: 0x10, Size: M64}, Imm(0x1))"},
+		{[]byte{0x65, 0x8b, 0x0c, 0x25, 0x00, 0x10, 0x00, 0x00}, "MOV ECX, GS:[0x1000]", "MOV_RM(ECX, Mem{Segment: GS, Disp: 0x1000, Size: M32})"},
+		{[]byte{0x48, 0x8b, 0x05, 0x20, 0x00, 0x00, 0x00}, "MOV RAX, [RIP+0x20]", "MOV_RM(RAX, Mem{Base: RIP, Disp: 0x20, Size: M64})"},
+		{[]byte{0x41, 0x54}, "PUSH R12", "PUSH_O(R12)"},
+		{[]byte{0xeb, 0xfe}, "JMP .-0x2", "JMP_D(Rel(-2))"},
+		{[]byte{0x0f, 0xb6, 0xc4}, "MOVZX EAX, AH", "MOVZX(EAX, AH)"},
+		{[]byte{0x40, 0x0f, 0xb6, 0xc4}, "MOVZX EAX, SPL", "MOVZX(EAX, SPL)"},
+		{[]byte{0xf3, 0x90}, "PAUSE", "PAUSE()"},
+		{[]byte{0xd8, 0xc3}, "FADD ST(0), ST(3)", "FADD(ST0, ST3)"},
+		{[]byte{0xc4, 0xc1, 0x1c, 0x58, 0x48, 0x40}, "VADDPS YMM1, YMM12, [R8+0x40]", "VADDPS_RVM(YMM1, YMM12, Mem{Base: R8, Disp: 0x40, Size: M256})"},
+		{[]byte{0x62, 0xf1, 0x6c, 0xf9, 0x58, 0xcb}, "VADDPS ZMM1{K1}{z}, ZMM2, ZMM3{rz-sae}", "VADDPS_FV(Mask(ZMM1, K1).Z(), ZMM2, Round(ZMM3, RZSAE))"},
+		{[]byte{0x62, 0xf1, 0x6c, 0x58, 0x58, 0x08}, "VADDPS ZMM1, ZMM2, [RAX]{1to16}", "VADDPS_FV(ZMM1, ZMM2, Mem{Base: RAX, Size: M32, Broadcast: 16})"},
+		{[]byte{0x62, 0xf1, 0x6c, 0x48, 0x58, 0x48, 0x01}, "VADDPS ZMM1, ZMM2, [RAX+0x40]", "VADDPS_FV(ZMM1, ZMM2, Mem{Base: RAX, Disp: 0x40, Size: M512})"},
+		{[]byte{0x62, 0xf1, 0x6c, 0x58, 0x58, 0x48, 0x10}, "VADDPS ZMM1, ZMM2, [RAX+0x40]{1to16}", "VADDPS_FV(ZMM1, ZMM2, Mem{Base: RAX, Disp: 0x40, Size: M32, Broadcast: 16})"},
+		{[]byte{0xf0}, "LOCK", "LOCK()"},
+		{[]byte{0xf2}, "XACQUIRE", "XACQUIRE()"},
+	}
+	for _, tt := range tests {
+		inst, err := Decode(tt.code)
+		if err != nil {
+			t.Errorf("Decode(% x): %v", tt.code, err)
+			continue
+		}
+		if inst.Len != len(tt.code) {
+			t.Errorf("Decode(% x).Len = %d, want %d", tt.code, inst.Len, len(tt.code))
+		}
+		if got := inst.String(); got != tt.want {
+			t.Errorf("Decode(% x) = %s, want %s", tt.code, got, tt.want)
+		}
+		if got := inst.Call(); got != tt.call {
+			t.Errorf("Decode(% x).Call() = %s, want %s", tt.code, got, tt.call)
+		}
+	}
+
+	for _, code := range [][]byte{{0x48}, {0x48, 0x01}, {0x48, 0x83, 0x44, 0xcb}} {
+		if _, err := Decode(code); err != ErrTruncated {
+			t.Errorf("Decode(% x) = %v, want ErrTruncated", code, err)
+		}
+	}
+
+	// LOCK ADD [RBX], EAX is two instructions, as Encode writes it.
+	lock, err := Encode("LOCK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	add, err := Encode("ADD", MustMem(RBX, nil, 0, 0), EAX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := append(lock, add...)
+	if inst, err := Decode(code); err != nil || inst.String() != "LOCK" || inst.Len != 1 {
+		t.Errorf("Decode(% x) = %v, %v, want LOCK of length 1", code, inst, err)
+	} else if inst, err := Decode(code[1:]); err != nil || inst.String() != "ADD [RBX], EAX" {
+		t.Errorf("Decode(% x) = %v, %v, want ADD [RBX], EAX", code[1:], inst, err)
+	}
+
+	for _, code := range [][]byte{
+		{0x48, 0xf3, 0x0f, 0xb8, 0xc0},             // REX before a mandatory prefix
+		{0x48, 0xf0, 0x01, 0x03},                   // REX before LOCK
+		{0x66, 0xf0, 0x01, 0x03},                   // LOCK after another prefix
+		{0x40, 0x48, 0x01, 0x03},                   // two REX prefixes
+		{0x48, 0xc5, 0xf8, 0x58, 0xc1},             // REX before VEX
+		{0x41, 0x62, 0xf1, 0x6c, 0x48, 0x58, 0xcb}, // REX before EVEX
+	} {
+		if inst, err := Decode(code); err == nil {
+			t.Errorf("Decode(% x) = %s, want an error", code, inst)
+		}
+	}
+}
+
+// TestRoundTrip encodes every form in each mode it is valid in, with
+// operands that exercise the REX and VEX extension bits in 64-bit mode,
+// and checks that the code decodes to the same form and operands. A form
+// may decode to another with the same opcode and arguments, like JE to JZ.
+func TestRoundTrip(t *testing.T) {
+	checked := 0
+	for i := range forms {
+		f := &forms[i]
+		if f.Func == "" {
+			t.Errorf("%s (%s) has no function", f.Syntax, f.Opcode)
+		}
+		for _, m := range []Mode{Mode16, Mode32, Mode64} {
+			if m.valid(f) {
+				roundTrip(t, m, f)
+				checked++
+			}
+		}
+	}
+	t.Logf("checked %d forms in the modes they are valid in", checked)
+}
+
+// roundTrip checks that the sample operands of f encode, in mode m, to
+// bytes that decode back to f and the same operands.
+func roundTrip(t *testing.T, m Mode, f *Form) {
+	c := compile(f)
+	if c.err != nil {
+		t.Errorf("%s: %v", f.Syntax, c.err)
+		return
+	}
+	for _, ops := range sampleOperands(m, c) {
+		code, err := c.encode(m, ops)
+		if err != nil {
+			t.Errorf("%s in %s: encode(%s): %v", f.Syntax, m, formatOperands(ops), err)
+			continue
+		}
+		inst, err := m.Decode(code)
+		if err != nil {
+			t.Errorf("%s in %s: Decode(% x): %v", f.Syntax, m, code, err)
+			continue
+		}
+		if inst.Len != len(code) {
+			t.Errorf("%s in %s: Decode(% x) = %s, length %d", f.Syntax, m, code, inst, inst.Len)
+			continue
+		}
+		g := inst.Form
+		if g != f && f.HasTag("pseudo") {
+			// A pseudo form stands in for another, which decoding
+			// prefers.
+			again, err := compile(g).encode(m, inst.Args)
+			if err != nil || !bytes.Equal(again, code) {
+				t.Errorf("%s in %s: % x decodes to %s, which encodes to % x, %v", f.Syntax, m, code, inst, again, err)
+			}
+			continue
+		}
+		order, ok := alias(f, g)
+		if !ok {
+			t.Errorf("%s in %s: % x decodes to %s, form %s (%s)", f.Syntax, m, code, inst, g.Syntax, g.Opcode)
+			continue
+		}
+		args := make([]Operand, len(ops))
+		for i, j := range order {
+			args[i] = ops[j]
+		}
+		if got, want := inst.Call(), (Instruction{Func: g.Func, Args: args}).Call(); got != want {
+			t.Errorf("%s in %s: % x decodes to %s, want %s", f.Syntax, m, code, got, want)
+		}
+	}
+}
+
+// alias reports whether g is f or has the same encoding and arguments, in
+// any order, like SETC and SETB or XCHG r8, r/m8 and XCHG r/m8, r8. It
+// returns, for each argument of g, the index of the same argument of f.
+func alias(f, g *Form) ([]int, bool) {
+	if g != f && !reflect.DeepEqual(g.Encoding, f.Encoding) {
+		return nil, false
+	}
+	fargs, gargs := f.Args(), g.Args()
+	if len(fargs) != len(gargs) {
+		return nil, false
+	}
+	order := make([]int, len(gargs))
+	used := make([]bool, len(fargs))
+next:
+	for i, a := range gargs {
+		for j, b := range fargs {
+			if a == b && !used[j] {
+				order[i], used[j] = j, true
+				continue next
+			}
+		}
+		return nil, false
+	}
+	return order, true
+}
+
+// sampleOperands returns operands accepted by the arguments of c: one set
+// with registers wherever possible and, if c takes memory, one with memory.
+// EVEX forms are also tried with all the decorations they accept, and only
+// with them if the opmask is required.
+func sampleOperands(m Mode, c *compiledForm) [][]Operand {
+	var regs, mems []Operand
+	hasMem := false
+	for i, a := range c.args {
+		var reg, mem Operand
+		switch {
+		case a.constant:
+			reg = Imm(a.value)
+		case a.fixed != "":
+			r, _ := registerNamed(a.fixed)
+			reg = regOperand(r)
+		case a.slot == slotImm:
+			reg = Imm(0x12)
+		case a.slot == slotRel:
+			reg = Rel(0x10)
+		case a.slot == slotMoffs:
+			mem = Abs(0x1234).Sized(a.memSizeHint())
+		}
+		if a.class != ClassNone && a.fixed == "" {
+			reg = sampleRegister(m, a, byte(9+i))
+		}
+		if a.mem && a.slot == slotRM {
+			mem = sampleMem(m, a)
+		}
+		if mem != nil {
+			hasMem = true
+		}
+		if reg == nil {
+			reg = mem
+		}
+		if mem == nil {
+			mem = reg
+		}
+		regs, mems = append(regs, reg), append(mems, mem)
+	}
+	var sets [][]Operand
+	switch {
+	case !hasMem:
+		sets = [][]Operand{regs}
+	case containsNil(regs):
+		sets = [][]Operand{mems}
+	default:
+		sets = [][]Operand{regs, mems}
+	}
+	var decorated [][]Operand
+	for _, ops := range sets {
+		if d := sampleDecorations(c, ops); d != nil {
+			decorated = append(decorated, d)
+		}
+	}
+	if c.form.HasTag("mask_required") {
+		return decorated
+	}
+	return append(sets, decorated...)
+}
+
+// sampleDecorations returns ops with every EVEX decoration that c accepts,
+// or nil if there are none.
+func sampleDecorations(c *compiledForm, ops []Operand) []Operand {
+	out := make([]Operand, len(ops))
+	any := false
+	// EVEX.b means broadcast with memory, so rounding needs registers.
+	mem := false
+	for _, op := range ops {
+		if _, ok := op.(Mem); ok {
+			mem = true
+		}
+	}
+	for i, a := range c.args {
+		op := ops[i]
+		switch o := op.(type) {
+		case Mem:
+			if a.bcst != 0 {
+				o.Size, _ = memSizeNamed("m" + strconv.Itoa(a.bcst))
+				op, any = o.Bcst(uint8(a.memBits/a.bcst)), true
+			}
+		case Register:
+			switch {
+			case mem:
+			case a.er:
+				op, any = Round(o, RZSAE), true
+			case a.sae:
+				op, any = Round(o, SAE), true
+			}
+		}
+		if a.mask {
+			m := Mask(op, K6)
+			if _, mem := op.(Mem); a.zero && !mem {
+				m = m.Z()
+			}
+			op, any = m, true
+		}
+		out[i] = op
+	}
+	if !any {
+		return nil
+	}
+	return out
+}
+
+func containsNil(ops []Operand) bool {
+	for _, op := range ops {
+		if op == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func sampleRegister(m Mode, a *arg, num byte) Operand {
+	return regOperand(sampleRegisterIndex(m, a.class, a.width, num))
+}
+
+// sampleMem returns a memory operand for argument a with a base, an index
+// and a displacement, in the address size of mode m.
+func sampleMem(m Mode, a *arg) Mem {
+	mem := Mem{Base: R13, Index: R10, Scale: 4, Disp: 0x40, Size: a.memSizeHint()}
+	switch {
+	case m == Mode16 && a.vsib == 0:
+		mem.Base, mem.Index, mem.Scale = BX, SI, 1
+	case m != Mode64:
+		mem.Base, mem.Index = EBX, ESI
+	}
+	if a.vsib != 0 {
+		mem.Index = VecReg(sampleRegisterIndex(m, ClassVec, a.vsib, 10))
+	}
+	return mem
+}
+
+// sampleRegisterIndex returns a register of the class and width that mode
+// m has, numbered num if there is one and with a smaller number otherwise.
+func sampleRegisterIndex(m Mode, class RegClass, width int, num byte) uint8 {
+	masks := []byte{15, 7, 3, 1, 0}
+	if m != Mode64 {
+		masks = masks[1:]
+	}
+	for _, mask := range masks {
+		for i, r := range registers {
+			if r.Class == class && (width == 0 || r.Width == width) && r.Num == num&mask && !r.NoREX && (class != ClassCtrl || r.Num != 1) &&
+				(m == Mode64 || !r.REX) {
+				return uint8(i)
+			}
+		}
+	}
+	panic("no register")
+}
@@ -0,0 +1,665 @@
+package x86
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// Encode returns the 64-bit mode machine code of the instruction with the
+// Intel mnemonic name and the operands ops, in Intel order. When several
+// forms accept the operands, the shortest encoding is chosen. Use the
+// Encode method of Mode16 or Mode32 for the other modes.
+func Encode(name string, ops ...Operand) ([]byte, error) {
+	return Mode64.Encode(name, ops...)
+}
+
+// EncodeSized is like Encode, but only considers the forms with an
+// operand size of bits, so that ADD [RAX], 1 can be told to add a byte or
+// a quadword without sizing the memory operand.
+func EncodeSized(name string, bits int, ops ...Operand) ([]byte, error) {
+	return Mode64.EncodeSized(name, bits, ops...)
+}
+
+// selectForm returns the form Encode chooses for the operands on the
+// target, and the code. If bits is not 0, only forms of that operand size
+// are considered.
+func selectForm(t target, name string, bits int, ops []Operand) (*compiledForm, []byte, error) {
+	mode := t.mode
+	if err := mode.check(); err != nil {
+		return nil, nil, err
+	}
+	forms := Forms(name)
+	if len(forms) == 0 {
+		return nil, nil, fmt.Errorf("x86: no encoding known for %s", name)
+	}
+	if bits != 0 {
+		var sized []*Form
+		for _, f := range forms {
+			if f.Datasize == bits {
+				sized = append(sized, f)
+			}
+		}
+		if len(sized) == 0 {
+			return nil, nil, fmt.Errorf("x86: no %d-bit form of %s", bits, name)
+		}
+		forms = sized
+	}
+	for i, op := range ops {
+		if op == nil {
+			return nil, nil, fmt.Errorf("x86: %s: operand %d is nil", name, i+1)
+		}
+		switch o := op.(type) {
+		case Masked:
+			if err := o.Validate(); err != nil {
+				return nil, nil, err
+			}
+			op = o.Dest
+		case Rounded:
+			if err := o.Validate(); err != nil {
+				return nil, nil, err
+			}
+		}
+		switch op := op.(type) {
+		case Mem:
+			if err := op.Validate(); err != nil {
+				return nil, nil, err
+			}
+			if op.Symbol != "" {
+				return nil, nil, fmt.Errorf("x86: %s: symbol %s can only be printed as assembly", name, op.Symbol)
+			}
+		case Label:
+			return nil, nil, fmt.Errorf("x86: %s: label %s is only resolved by a Builder", name, op)
+		}
+	}
+	matches := matchForms(mode, forms, ops, false)
+	if len(matches) == 0 {
+		// Pseudo forms, like PAUSE for REP NOP, only stand in for forms
+		// that are missing.
+		matches = matchForms(mode, forms, ops, true)
+	}
+	if len(matches) == 0 {
+		if !anyValid(mode, forms) {
+			return nil, nil, fmt.Errorf("x86: %s is not available in %s", name, mode)
+		}
+		if bits != 0 {
+			return nil, nil, fmt.Errorf("x86: no %d-bit form of %s accepts %s", bits, name, formatOperands(ops))
+		}
+		return nil, nil, fmt.Errorf("x86: no form of %s accepts %s", name, formatOperands(ops))
+	}
+	matches, err := supported(matches, t.features)
+	if err != nil {
+		return nil, nil, fmt.Errorf("x86: %s %s: %v", name, formatOperands(ops), err)
+	}
+	if err := ambiguous(matches, ops); err != nil {
+		return nil, nil, fmt.Errorf("x86: %s %s: %v", name, formatOperands(ops), err)
+	}
+	var best *compiledForm
+	var code []byte
+	for _, m := range matches {
+		b, e := m.encode(mode, ops)
+		if e != nil {
+			if err == nil {
+				err = e
+			}
+			continue
+		}
+		if code == nil || len(b) < len(code) {
+			best, code = m, b
+		}
+	}
+	if code == nil {
+		return nil, nil, err
+	}
+	return best, code, nil
+}
+
+// Assemble calls f with an empty Builder and returns the 64-bit mode
+// machine code of the instructions f records in it.
+func Assemble(f func(b *Builder)) ([]byte, error) {
+	return Mode64.Assemble(f)
+}
+
+func formatOperands(ops []Operand) string {
+	s := make([]string, len(ops))
+	for i, op := range ops {
+		s[i] = op.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+// compiledForm is a form with its encoding and arguments parsed.
+type compiledForm struct {
+	form *Form
+	enc  *Encoding
+	args []*arg
+	err  error
+}
+
+var (
+	compiledMu    sync.Mutex
+	compiledForms = map[*Form]*compiledForm{}
+)
+
+func compile(f *Form) *compiledForm {
+	compiledMu.Lock()
+	defer compiledMu.Unlock()
+	if c, ok := compiledForms[f]; ok {
+		return c
+	}
+	c := &compiledForm{form: f}
+	c.enc = &f.Encoding
+	if len(c.enc.Opcode) == 0 {
+		c.err = fmt.Errorf("x86: opcode %q: no opcode bytes", f.Opcode)
+	}
+	for _, o := range f.Operands {
+		c.args = append(c.args, newArg(o))
+	}
+	compiledForms[f] = c
+	return c
+}
+
+// anyValid reports whether any of the forms can be encoded in mode.
+func anyValid(mode Mode, forms []*Form) bool {
+	for _, f := range forms {
+		if mode.valid(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchForms returns the forms that accept the operands in mode, either
+// the pseudo forms or all others.
+func matchForms(mode Mode, forms []*Form, ops []Operand, pseudo bool) []*compiledForm {
+	var matches []*compiledForm
+	override := false
+	for _, f := range forms {
+		if !mode.valid(f) || f.HasTag("pseudo") != pseudo {
+			continue
+		}
+		c := compile(f)
+		if c.err != nil || len(c.args) != len(ops) {
+			continue
+		}
+		ok := true
+		for i, a := range c.args {
+			if !a.match(f, ops[i]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matches = append(matches, c)
+			override = override || c.operandSizePrefix(mode)
+		}
+	}
+	if !override || sizedTo(ops, mode.overrideSize()) {
+		return matches
+	}
+	// Forms that override the operand size are only chosen when an operand
+	// asks for that size or there is no other choice; in 64-bit mode,
+	// PUSH imm16 and XBEGIN rel16 would otherwise win for being shortest.
+	var full []*compiledForm
+	for _, c := range matches {
+		if !c.operandSizePrefix(mode) {
+			full = append(full, c)
+		}
+	}
+	if len(full) == 0 {
+		return matches
+	}
+	return full
+}
+
+// sizedTo reports whether any operand is explicitly bits wide.
+func sizedTo(ops []Operand, bits int) bool {
+	for _, op := range ops {
+		switch op := op.(type) {
+		case Mem:
+			if op.Size.Bits() == bits {
+				return true
+			}
+		case Register:
+			if info := op.Info(); info.Class == ClassGP && info.Width == bits {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// supported returns the matching forms that processors with the features
+// can run, or an error naming the features that are missing if there are
+// none. Zero features support every form.
+//
+// Operands that a VEX form accepts always get a VEX form, even where the
+// compressed displacement of EVEX is shorter or the target lacks the
+// features of the VEX form: the EVEX form is for the operands that need
+// it, and processors without AVX-512 do not run it.
+func supported(matches []*compiledForm, features Features) ([]*compiledForm, error) {
+	var vex []*compiledForm
+	for _, c := range matches {
+		if !c.form.Encoding.evex() {
+			vex = append(vex, c)
+		}
+	}
+	if len(vex) > 0 {
+		matches = vex
+	}
+	if features == 0 {
+		return matches, nil
+	}
+	var ok []*compiledForm
+	var needs []string
+	seen := map[string]bool{}
+	for _, c := range matches {
+		if c.form.RunsOn(features) {
+			ok = append(ok, c)
+		} else if !seen[c.form.Cpuid] {
+			seen[c.form.Cpuid] = true
+			needs = append(needs, c.form.Cpuid)
+		}
+	}
+	if len(ok) == 0 {
+		return nil, fmt.Errorf("needs %s, which the target lacks", strings.Join(needs, " or "))
+	}
+	return ok, nil
+}
+
+// ambiguous returns an error if the matching forms disagree on the size of
+// a memory operand that has no size hint.
+func ambiguous(matches []*compiledForm, ops []Operand) error {
+	for i, op := range ops {
+		if o, ok := op.(Masked); ok {
+			op = o.Dest
+		}
+		m, ok := op.(Mem)
+		if !ok || m.Size != MemAny {
+			continue
+		}
+		size := ""
+		for _, c := range matches {
+			s := c.args[i].memSize
+			if s == "" {
+				continue
+			}
+			if size != "" && s != size {
+				return fmt.Errorf("size of %s is ambiguous (%s or %s), use Mem.Sized", m, size, s)
+			}
+			size = s
+		}
+	}
+	return nil
+}
+
+// operandSizePrefix reports whether the form needs the 66 operand-size
+// prefix in mode.
+func (c *compiledForm) operandSizePrefix(mode Mode) bool {
+	operand, _ := mode.prefixes(c.form)
+	return operand
+}
+
+var segmentPrefixes = [...]byte{0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65}
+
+// encode returns the machine code, in mode, of the form applied to the
+// operands.
+func (c *compiledForm) encode(mode Mode, ops []Operand) ([]byte, error) {
+	e := c.enc
+	var flags evexFlags
+	plain := make([]Operand, len(ops))
+	for i, op := range ops {
+		plain[i] = undecorate(op, &flags)
+	}
+	ops = plain
+	var (
+		reg, vvvv, opreg, is4 *RegInfo
+		rm                    Operand
+		imms                  []int64
+		regs                  []RegInfo
+		seg                   SegReg
+	)
+	for i, a := range c.args {
+		var info *RegInfo
+		if r, ok := ops[i].(Register); ok {
+			ri := r.Info()
+			info = &ri
+			regs = append(regs, ri)
+		}
+		switch a.slot {
+		case slotReg:
+			reg = info
+		case slotRM:
+			rm = ops[i]
+		case slotVVVV:
+			vvvv = info
+		case slotOpcode:
+			opreg = info
+		case slotIS4:
+			is4 = info
+		case slotImm:
+			imms = append(imms, int64(ops[i].(Imm)))
+		case slotRel:
+			imms = append(imms, int64(ops[i].(Rel)))
+		case slotMoffs:
+			m := ops[i].(Mem)
+			imms = append(imms, m.Disp)
+			seg = m.Segment
+		}
+	}
+	if len(imms) != len(e.Imm) {
+		return nil, fmt.Errorf("x86: %s: operands do not match encoding %q", c.form.Syntax, c.form.Opcode)
+	}
+	evex := e.evex()
+	if !evex && flags != (evexFlags{}) {
+		return nil, fmt.Errorf("x86: %s: opmasks, broadcasts and rounding need an EVEX prefix", c.form.Syntax)
+	}
+	for _, r := range regs {
+		if r.EVEX && !evex {
+			return nil, fmt.Errorf("x86: %s: %s can only be encoded with an EVEX prefix", c.form.Syntax, r.Name)
+		}
+		if mode != Mode64 && (r.REX || r.Num >= 8) {
+			return nil, fmt.Errorf("x86: %s: %s is only available in 64-bit mode", c.form.Syntax, r.Name)
+		}
+	}
+
+	// Extension bits of the register numbers: REX.R, X and B, and the
+	// EVEX R' and V' bits.
+	var w, r, x, b, rhi, vhi byte
+	if e.REXW {
+		w = 1
+	}
+	if e.REXR {
+		r = 1
+	}
+
+	var modrm []byte
+	var addr address
+	if e.ModRM {
+		// A /r form without a register operand, such as SETcc, leaves the
+		// reg field zero.
+		field := byte(0)
+		if e.Digit >= 0 {
+			field = byte(e.Digit)
+		} else if reg != nil {
+			field = reg.Num & 7
+			r, rhi = reg.Num>>3&1, reg.Num>>4&1
+		}
+		switch op := rm.(type) {
+		case Mem:
+			if flags.round {
+				// With a memory operand, EVEX.b selects broadcast.
+				return nil, fmt.Errorf("x86: %s: rounding needs a register operand in the r/m field, not memory", c.form.Syntax)
+			}
+			var err error
+			scale := 1
+			if evex {
+				scale = c.disp8Scale(flags.b == 1)
+			}
+			addr, err = encodeAddress(mode, op, scale)
+			if err != nil {
+				return nil, err
+			}
+			seg = op.Segment
+			x, b, vhi = addr.x, addr.b, addr.v
+			modrm = append(modrm, addr.mod<<6|field<<3|addr.rm)
+			if addr.hasSIB {
+				modrm = append(modrm, addr.sib)
+			}
+			modrm = appendInt(modrm, addr.disp, addr.dispSize)
+		case Register:
+			info := op.Info()
+			b = info.Num >> 3 & 1
+			if evex {
+				x = info.Num >> 4 & 1
+			}
+			modrm = append(modrm, 0xC0|field<<3|info.Num&7)
+		default:
+			return nil, fmt.Errorf("x86: %s: no operand for the ModRM r/m field", c.form.Syntax)
+		}
+	}
+
+	opcode := append([]byte(nil), e.Opcode...)
+	if e.Plus != "" {
+		if opreg == nil {
+			return nil, fmt.Errorf("x86: %s: no operand for the opcode register", c.form.Syntax)
+		}
+		opcode[len(opcode)-1] += opreg.Num & 7
+		b = opreg.Num >> 3 & 1
+	}
+
+	var code []byte
+	if e.wait() {
+		code = append(code, 0x9B)
+	}
+	if seg != 0 {
+		code = append(code, segmentPrefixes[seg.Info().Num])
+	}
+	operand, address := mode.prefixes(c.form)
+	if addr.override || address {
+		code = append(code, 0x67)
+	}
+	if operand {
+		code = append(code, 0x66)
+	}
+	code = append(code, e.mandatory()...)
+
+	if e.VEX == nil {
+		rex := w<<3 | r<<2 | x<<1 | b
+		needREX := rex != 0 || e.REX
+		for _, r := range regs {
+			if r.REX {
+				needREX = true
+			}
+		}
+		if needREX && mode != Mode64 {
+			return nil, fmt.Errorf("x86: %s: a REX prefix is only available in 64-bit mode", c.form.Syntax)
+		}
+		if needREX {
+			for _, r := range regs {
+				if r.NoREX {
+					return nil, fmt.Errorf("x86: %s: %s can not be encoded with a REX prefix", c.form.Syntax, r.Name)
+				}
+			}
+			code = append(code, 0x40|rex)
+		}
+	} else {
+		var v byte
+		if vvvv != nil {
+			v = vvvv.Num
+			vhi |= v >> 4 & 1
+		}
+		code = appendVEX(code, e.VEX, w|e.VEX.W, r, x, b, rhi, vhi, v&15, flags)
+	}
+
+	code = append(code, opcode...)
+	code = append(code, modrm...)
+	for i := range e.Imm {
+		code = appendInt(code, imms[i], e.immSize(i, int(mode)))
+	}
+	if e.Is4 {
+		if is4 == nil {
+			return nil, fmt.Errorf("x86: %s: no operand for the is4 register", c.form.Syntax)
+		}
+		code = append(code, is4.Num<<4)
+	}
+	code = append(code, e.Suffix...)
+	return code, nil
+}
+
+// appendVEX appends a VEX or EVEX prefix. r, x, b, rhi (R') and vhi (V')
+// are the extension bits of the operands and v the low four bits of the
+// vvvv register; the prefix stores all of them inverted. flags are the
+// EVEX fields of decorated operands.
+func appendVEX(code []byte, p *VEX, w, r, x, b, rhi, vhi, v byte, flags evexFlags) []byte {
+	if p.EVEX {
+		l := p.L
+		if flags.round {
+			l = flags.rc
+		}
+		return append(code, 0x62,
+			(^r&1)<<7|(^x&1)<<6|(^b&1)<<5|(^rhi&1)<<4|p.MMMMM,
+			w<<7|(^v&15)<<3|1<<2|p.PP,
+			flags.z<<7|l<<5|flags.b<<4|(^vhi&1)<<3|flags.aaa)
+	}
+	if x == 0 && b == 0 && w == 0 && p.MMMMM == 1 {
+		return append(code, 0xC5, (^r&1)<<7|(^v&15)<<3|p.L<<2|p.PP)
+	}
+	return append(code, 0xC4,
+		(^r&1)<<7|(^x&1)<<6|(^b&1)<<5|p.MMMMM,
+		w<<7|(^v&15)<<3|p.L<<2|p.PP)
+}
+
+// address holds the ModRM and SIB fields and the displacement of a memory
+// operand.
+type address struct {
+	mod, rm  byte
+	sib      byte
+	hasSIB   bool
+	disp     int64
+	dispSize int
+	x, b     byte // REX.X and REX.B
+	v        byte // EVEX.V': bit 4 of a VSIB index
+	override bool // needs the 67 address-size prefix
+}
+
+// encodeAddress returns the addressing of m in mode. A one byte
+// displacement is multiplied by scale, which is 1 except with EVEX (see
+// disp8Scale); a scale of 0 rules out the one byte form.
+func encodeAddress(mode Mode, m Mem, scale int) (address, error) {
+	var a address
+	base, index := m.Base.Info(), RegInfo{}
+	if m.HasIndex() {
+		index = m.Index.Info()
+	}
+	size := addressSize(mode, m)
+	switch {
+	case base.Class == ClassIP && mode != Mode64:
+		return a, fmt.Errorf("x86: %s: %s-relative addressing is only available in 64-bit mode", m, m.Base)
+	case size == 64 && mode != Mode64:
+		return a, fmt.Errorf("x86: %s: 64-bit addressing is only available in 64-bit mode", m)
+	case size == 16 && mode == Mode64:
+		return a, fmt.Errorf("x86: %s: 16-bit addressing is not available in 64-bit mode", m)
+	}
+	for _, r := range []RegInfo{base, index} {
+		if mode != Mode64 && r.Num >= 8 {
+			return a, fmt.Errorf("x86: %s: %s is only available in 64-bit mode", m, r.Name)
+		}
+	}
+	if size == 16 {
+		a = encodeAddress16(m, scale)
+	}
+	a.override = size != int(mode)
+	if size == 16 {
+		return a, nil
+	}
+
+	if base.Class == ClassIP {
+		a.rm, a.disp, a.dispSize = 5, m.Disp, 4
+		return a, nil
+	}
+	if m.Base == 0 && !fitsSigned(m.Disp, 32) {
+		return a, fmt.Errorf("x86: %s: address does not fit in 32 bits", m)
+	}
+
+	switch {
+	case m.Base == 0:
+		a.mod, a.disp, a.dispSize = 0, m.Disp, 4
+		if mode != Mode64 && !m.HasIndex() {
+			// Outside 64-bit mode, the encoding of RIP-relative
+			// addressing is an absolute address without a SIB byte.
+			a.rm = 5
+			return a, nil
+		}
+	case m.Disp == 0 && base.Num&7 != 5:
+		a.mod = 0
+	case m.Disp == 0:
+		a.mod, a.disp, a.dispSize = 1, 0, 1
+	case scale != 0 && m.Disp%int64(scale) == 0 && fitsSigned(m.Disp/int64(scale), 8):
+		a.mod, a.disp, a.dispSize = 1, m.Disp/int64(scale), 1
+	default:
+		a.mod, a.disp, a.dispSize = 2, m.Disp, 4
+	}
+	a.b = base.Num >> 3 & 1
+
+	if m.Base != 0 && !m.HasIndex() && base.Num&7 != 4 {
+		a.rm = base.Num & 7
+		return a, nil
+	}
+
+	// A SIB byte: for an index, a base of RSP or R12, or no base at all.
+	a.rm, a.hasSIB = 4, true
+	sibIndex, sibBase := byte(4), byte(5)
+	if m.HasIndex() {
+		sibIndex = index.Num & 7
+		a.x, a.v = index.Num>>3&1, index.Num>>4&1
+	}
+	if m.Base != 0 {
+		sibBase = base.Num & 7
+	}
+	a.sib = scaleBits[m.Scale]<<6 | sibIndex<<3 | sibBase
+	return a, nil
+}
+
+// addressSize returns the address size in bits of m in mode: the width of
+// its registers, or else the mode's, except that in 16-bit mode an address
+// beyond 16 bits, or a VSIB one, takes 32.
+func addressSize(mode Mode, m Mem) int {
+	if m.Base != 0 {
+		return m.Base.Info().Width
+	}
+	if m.HasIndex() && !m.VSIB() {
+		return m.Index.Info().Width
+	}
+	if mode == Mode16 && (m.VSIB() || m.Disp < math.MinInt16 || m.Disp > math.MaxUint16) {
+		return 32
+	}
+	return int(mode)
+}
+
+// rm16 is the r/m field of each base and index pair of 16-bit addressing.
+var rm16 = map[[2]Reg]byte{
+	{BX, SI}: 0, {BX, DI}: 1, {BP, SI}: 2, {BP, DI}: 3,
+	{0, SI}: 4, {0, DI}: 5, {BP, 0}: 6, {BX, 0}: 7,
+}
+
+// encodeAddress16 returns the 16-bit addressing of m, which Validate has
+// checked.
+func encodeAddress16(m Mem, scale int) address {
+	var a address
+	base, index := m.Base, Reg(0)
+	if m.HasIndex() {
+		index = m.Index.(Reg)
+	}
+	if index == 0 && (base == SI || base == DI) {
+		base, index = 0, base
+	}
+	disp := m.Disp
+	switch {
+	case base == 0 && index == 0:
+		// [BP] with no displacement encodes an absolute address.
+		a.mod, a.rm, a.disp, a.dispSize = 0, 6, disp, 2
+		return a
+	case disp == 0 && !(base == BP && index == 0):
+		a.mod = 0
+	case disp == 0:
+		a.mod, a.dispSize = 1, 1
+	case scale != 0 && disp%int64(scale) == 0 && fitsSigned(disp/int64(scale), 8):
+		a.mod, a.disp, a.dispSize = 1, disp/int64(scale), 1
+	default:
+		a.mod, a.disp, a.dispSize = 2, disp, 2
+	}
+	a.rm = rm16[[2]Reg{base, index}]
+	return a
+}
+
+var scaleBits = [...]byte{0: 0, 1: 0, 2: 1, 4: 2, 8: 3}
+
+// appendInt appends the size low bytes of v in little-endian order.
+func appendInt(b []byte, v int64, size int) []byte {
+	for i := 0; i < size; i++ {
+		b = append(b, byte(v>>(8*uint(i))))
+	}
+	return b
+}
@@ -0,0 +1,279 @@
+package x86
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []Operand
+		want []byte
+	}{
+		{"ADD", []Operand{RAX, Imm(1)}, []byte{0x48, 0x83, 0xc0, 0x01}},
+		{"ADD", []Operand{EAX, Imm(1000)}, []byte{0x05, 0xe8, 0x03, 0x00, 0x00}},
+		{"ADD", []Operand{AL, Imm(200)}, []byte{0x04, 0xc8}},
+		{"ADD", []Operand{R9, R10}, []byte{0x4d, 0x01, 0xd1}},
+		{"ADD", []Operand{SIL, DL}, []byte{0x40, 0x00, 0xd6}},
+		{"ADD", []Operand{MustMem(RBX, RCX, 8, 16).Sized(M64), Imm(1)}, []byte{0x48, 0x83, 0x44, 0xcb, 0x10, 0x01}},
+		{"ADD", []Operand{MustMem(RSP, nil, 0, 0), RAX}, []byte{0x48, 0x01, 0x04, 0x24}},
+		{"ADD", []Operand{MustMem(R13, nil, 0, 0), RAX}, []byte{0x49, 0x01, 0x45, 0x00}},
+		{"ADD", []Operand{MustMem(R12, R13, 2, 0x1000), R8D}, []byte{0x47, 0x01, 0x84, 0x6c, 0x00, 0x10, 0x00, 0x00}},
+		{"MOV", []Operand{RAX, Imm(0x123456789)}, []byte{0x48, 0xb8, 0x89, 0x67, 0x45, 0x23, 0x01, 0x00, 0x00, 0x00}},
+		{"MOV", []Operand{EAX, Imm(0xffffffff)}, []byte{0xb8, 0xff, 0xff, 0xff, 0xff}},
+		{"MOV", []Operand{RAX, RIPRel(0x20)}, []byte{0x48, 0x8b, 0x05, 0x20, 0x00, 0x00, 0x00}},
+		{"MOV", []Operand{AL, Abs(0x1122334455)}, []byte{0xa0, 0x55, 0x44, 0x33, 0x22, 0x11, 0x00, 0x00, 0x00}},
+		{"MOV", []Operand{ECX, Abs(0x1000).Seg(GS)}, []byte{0x65, 0x8b, 0x0c, 0x25, 0x00, 0x10, 0x00, 0x00}},
+		{"MOV", []Operand{ECX, MustMem(EAX, nil, 0, 4)}, []byte{0x67, 0x8b, 0x48, 0x04}},
+		{"MOV", []Operand{CR8, RAX}, []byte{0x44, 0x0f, 0x22, 0xc0}},
+		{"MOV", []Operand{RAX, CR3}, []byte{0x0f, 0x20, 0xd8}},
+		{"MOV", []Operand{RAX, DR7}, []byte{0x0f, 0x21, 0xf8}},
+		{"LEA", []Operand{RAX, MustMem(RBP, RAX, 4, -8)}, []byte{0x48, 0x8d, 0x44, 0x85, 0xf8}},
+		{"PUSH", []Operand{R12}, []byte{0x41, 0x54}},
+		{"PUSH", []Operand{Imm(1000)}, []byte{0x68, 0xe8, 0x03, 0x00, 0x00}},
+		{"RET", nil, []byte{0xc3}},
+		{"JMP", []Operand{Rel(-2)}, []byte{0xeb, 0xfe}},
+		{"JMP", []Operand{Rel(0x1000)}, []byte{0xe9, 0x00, 0x10, 0x00, 0x00}},
+		{"SHL", []Operand{RAX, Imm(1)}, []byte{0x48, 0xd1, 0xe0}},
+		{"IMUL", []Operand{RAX, RBX, Imm(10)}, []byte{0x48, 0x6b, 0xc3, 0x0a}},
+		{"MOVZX", []Operand{EAX, AH}, []byte{0x0f, 0xb6, 0xc4}},
+		{"FADD", []Operand{ST0, ST3}, []byte{0xd8, 0xc3}},
+		{"FLD", []Operand{MustMem(RAX, nil, 0, 0).Sized(M80FP)}, []byte{0xdb, 0x28}},
+		{"PSHUFD", []Operand{XMM1, XMM2, Imm(0xe4)}, []byte{0x66, 0x0f, 0x70, 0xca, 0xe4}},
+		{"POPCNT", []Operand{R8, RAX}, []byte{0xf3, 0x4c, 0x0f, 0xb8, 0xc0}},
+		{"VADDPS", []Operand{YMM1, YMM12, MustMem(R8, nil, 0, 64)}, []byte{0xc4, 0xc1, 0x1c, 0x58, 0x48, 0x40}},
+		{"VCVTSI2SD", []Operand{XMM1, XMM2, RAX}, []byte{0xc4, 0xe1, 0xeb, 0x2a, 0xc8}},
+		{"VBLENDVPD", []Operand{XMM1, XMM2, XMM3, XMM4}, []byte{0xc4, 0xe3, 0x69, 0x4b, 0xcb, 0x40}},
+		{"ANDN", []Operand{RAX, RBX, RCX}, []byte{0xc4, 0xe2, 0xe0, 0xf2, 0xc1}},
+		{"XGETBV", nil, []byte{0x0f, 0x01, 0xd0}},
+		{"VADDPS", []Operand{Mask(ZMM1, K1).Z(), ZMM2, Round(ZMM3, RZSAE)}, []byte{0x62, 0xf1, 0x6c, 0xf9, 0x58, 0xcb}},
+		{"VADDPS", []Operand{ZMM1, ZMM2, MustMem(RAX, nil, 0, 0).Bcst(16)}, []byte{0x62, 0xf1, 0x6c, 0x58, 0x58, 0x08}},
+		{"VCMPPS", []Operand{Mask(K1, K2), ZMM2, Round(ZMM3, SAE), Imm(1)}, []byte{0x62, 0xf1, 0x6c, 0x1a, 0xc2, 0xcb, 0x01}},
+		{"VMOVAPS", []Operand{Mask(MustMem(RAX, nil, 0, 0), K1), ZMM1}, []byte{0x62, 0xf1, 0x7c, 0x49, 0x29, 0x08}},
+		{"VPSCATTERDD", []Operand{Mask(MustMem(RAX, ZMM2, 4, 0), K1), ZMM1}, []byte{0x62, 0xf2, 0x7d, 0x49, 0xa0, 0x0c, 0x90}},
+		{"VCVTUSI2SS", []Operand{XMM2, XMM1, Round(RAX, RDSAE)}, []byte{0x62, 0xf1, 0xf6, 0x38, 0x7b, 0xd0}},
+		// disp8*N: EVEX scales a one byte displacement by the memory size
+		// of the tuple type, or the element size when broadcasting.
+		{"VADDPS", []Operand{ZMM1, ZMM2, MustMem(RAX, nil, 0, 0x40)}, []byte{0x62, 0xf1, 0x6c, 0x48, 0x58, 0x48, 0x01}},
+		{"VADDPS", []Operand{ZMM1, ZMM2, MustMem(RAX, nil, 0, 0x40).Bcst(16)}, []byte{0x62, 0xf1, 0x6c, 0x58, 0x58, 0x48, 0x10}},
+		{"VADDPS", []Operand{ZMM1, ZMM2, MustMem(RAX, nil, 0, 0x44)}, []byte{0x62, 0xf1, 0x6c, 0x48, 0x58, 0x88, 0x44, 0x00, 0x00, 0x00}},
+		{"VADDSS", []Operand{XMM17, XMM2, MustMem(RAX, nil, 0, -0x200)}, []byte{0x62, 0xe1, 0x6e, 0x08, 0x58, 0x48, 0x80}},
+		{"VPSCATTERDD", []Operand{Mask(MustMem(RAX, ZMM2, 4, 0x100), K1), ZMM1}, []byte{0x62, 0xf2, 0x7d, 0x49, 0xa0, 0x4c, 0x90, 0x40}},
+		{"VBROADCASTF32X4", []Operand{ZMM1, MustMem(RAX, nil, 0, 0x30)}, []byte{0x62, 0xf2, 0x7d, 0x48, 0x1a, 0x48, 0x03}},
+	}
+	for _, tt := range tests {
+		got, err := Encode(tt.name, tt.ops...)
+		if err != nil {
+			t.Errorf("%s %s: %v", tt.name, formatOperands(tt.ops), err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s %s = % x, want % x", tt.name, formatOperands(tt.ops), got, tt.want)
+		}
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []Operand
+	}{
+		{"ADD", []Operand{MustMem(RAX, nil, 0, 0), Imm(1)}}, // ambiguous size
+		{"ADD", []Operand{RAX, Imm(1 << 40)}},
+		{"ADD", []Operand{RAX, XMM1}},
+		{"MOVZX", []Operand{R8D, AH}},
+		{"PADDD", []Operand{XMM16, XMM1}},
+		{"MOV", []Operand{RAX, MustMem(BX, SI, 1, 0)}},
+		{"NOSUCH", nil},
+		{"VADDPS", []Operand{ZMM1, ZMM2, Round(ZMM3, SAE)}},                         // {er}, not {sae}
+		{"VCMPPS", []Operand{K1, ZMM2, Round(ZMM3, RNSAE), Imm(1)}},                 // {sae}, not {er}
+		{"VADDPS", []Operand{YMM1, YMM2, Round(YMM3, RNSAE)}},                       // rounding needs 512 bits
+		{"VCVTPS2PH", []Operand{MustMem(RAX, nil, 0, 0), Round(ZMM1, SAE), Imm(0)}}, // EVEX.b with memory is broadcast
+		{"VADDPS", []Operand{Mask(ZMM1, K0), ZMM2, ZMM3}},
+		{"VADDPS", []Operand{ZMM1, ZMM2, MustMem(RAX, nil, 0, 0).Bcst(8)}},
+		{"VCMPPS", []Operand{Mask(K1, K2).Z(), ZMM2, ZMM3, Imm(1)}},
+		{"VMOVAPS", []Operand{Mask(MustMem(RAX, nil, 0, 0), K1).Z(), ZMM1}},
+		{"VPSCATTERDD", []Operand{MustMem(RAX, ZMM2, 4, 0), ZMM1}}, // opmask required
+		{"PADDD", []Operand{Mask(XMM1, K1), XMM2}},
+	}
+	for _, tt := range tests {
+		if got, err := Encode(tt.name, tt.ops...); err == nil {
+			t.Errorf("%s %s = % x, want error", tt.name, formatOperands(tt.ops), got)
+		}
+	}
+}
+
+// TestReservedRegisters checks that there are no control or debug
+// registers that raise #UD: CR1, CR5-CR7, CR9-CR15 and DR8-DR15.
+func TestReservedRegisters(t *testing.T) {
+	for _, r := range registers {
+		switch {
+		case r.Class == ClassCtrl && (r.Num == 1 || r.Num >= 5 && r.Num != 8),
+			r.Class == ClassDebug && r.Num >= 8:
+			t.Errorf("%s is reserved", r.Name)
+		}
+	}
+	// MOV RAX, CR5 and MOV RAX, DR8.
+	for _, code := range [][]byte{{0x0f, 0x20, 0xe8}, {0x44, 0x0f, 0x21, 0xc0}} {
+		if inst, err := Decode(code); err == nil {
+			t.Errorf("Decode(% x) = %v, want an error", code, inst)
+		}
+	}
+}
+
+func TestEncodeEVEX(t *testing.T) {
+	f := &Form{
+		Syntax: "VADDPS zmm1, zmmV, zmm2/m512",
+		Opcode: "EVEX.NDS.512.0F.W0 58 /r",
+		Encoding: Encoding{
+			VEX:    &VEX{EVEX: true, VVVV: "NDS", L: 2, MMMMM: 1},
+			Opcode: []byte{0x58},
+			ModRM:  true,
+			Digit:  -1,
+		},
+		Operands: []FormOperand{
+			{Syntax: "zmm1", Kind: "reg", Class: ClassVec, Width: 512, Size: 512, Slot: "reg"},
+			{Syntax: "zmmV", Kind: "reg", Class: ClassVec, Width: 512, Size: 512, Slot: "vvvv"},
+			{Syntax: "zmm2/m512", Kind: "reg/mem", Class: ClassVec, Width: 512, Size: 512, Mem: "m512", MemSize: 512, Slot: "r/m"},
+		},
+		Valid64: true,
+	}
+	tests := []struct {
+		ops  []Operand
+		want []byte
+	}{
+		{[]Operand{ZMM1, ZMM2, ZMM3}, []byte{0x62, 0xf1, 0x6c, 0x48, 0x58, 0xcb}},
+		{[]Operand{ZMM17, ZMM18, ZMM19}, []byte{0x62, 0xa1, 0x6c, 0x40, 0x58, 0xcb}},
+		{[]Operand{ZMM1, ZMM2, MustMem(RAX, nil, 0, 0)}, []byte{0x62, 0xf1, 0x6c, 0x48, 0x58, 0x08}},
+	}
+	for _, tt := range tests {
+		matches := matchForms(Mode64, []*Form{f}, tt.ops, false)
+		if len(matches) != 1 {
+			t.Errorf("%s: no match", formatOperands(tt.ops))
+			continue
+		}
+		got, err := matches[0].encode(Mode64, tt.ops)
+		if err != nil {
+			t.Errorf("%s: %v", formatOperands(tt.ops), err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s = % x, want % x", formatOperands(tt.ops), got, tt.want)
+		}
+	}
+}
+
+func TestDisp8Scale(t *testing.T) {
+	tests := []struct {
+		syntax string
+		bcst   bool
+		want   int
+	}{
+		{"VADDPS xmm1{k1}{z}, xmmV, xmm2/m128/m32bcst", false, 16},
+		{"VADDPS ymm1{k1}{z}, ymmV, ymm2/m256/m32bcst", false, 32},
+		{"VADDPS zmm1{k1}{z}, zmmV, zmm2/m512/m32bcst{er}", false, 64},
+		{"VADDPS zmm1{k1}{z}, zmmV, zmm2/m512/m32bcst{er}", true, 4},
+		{"VCVTPS2PD xmm1{k1}{z}, xmm2/m64/m32bcst", false, 8},
+		{"VCVTPS2PD zmm1{k1}{z}, ymm2/m256/m32bcst{sae}", false, 32},
+		{"VCVTPS2PD zmm1{k1}{z}, ymm2/m256/m32bcst{sae}", true, 4},
+		{"VPADDB xmm1{k1}{z}, xmmV, xmm2/m128", false, 16},
+		{"VPADDB ymm1{k1}{z}, ymmV, ymm2/m256", false, 32},
+		{"VPADDB zmm1{k1}{z}, zmmV, zmm2/m512", false, 64},
+		{"VPMOVSXBW xmm1{k1}{z}, xmm2/m64", false, 8},
+		{"VPMOVSXBW zmm1{k1}{z}, ymm2/m256", false, 32},
+		{"VPMOVSXBD ymm1{k1}{z}, xmm2/m64", false, 8},
+		{"VPMOVSXBD zmm1{k1}{z}, xmm2/m128", false, 16},
+		{"VPMOVSXBQ xmm1{k1}{z}, xmm2/m16", false, 2},
+		{"VPMOVSXBQ zmm1{k1}{z}, xmm2/m64", false, 8},
+		{"VADDSS xmm1{k1}{z}, xmmV, xmm2/m32{er}", false, 4},
+		{"VADDSD xmm1{k1}{z}, xmmV, xmm2/m64{er}", false, 8},
+		{"VBROADCASTF32X2 zmm1{k1}{z}, xmm2/m64", false, 8},
+		{"VBROADCASTF32X4 zmm1{k1}{z}, m128", false, 16},
+		{"VBROADCASTF32X8 zmm1{k1}{z}, m256", false, 32},
+		{"VPGATHERDD zmm1{k1}, vm32z", false, 4},
+		{"VPSLLD xmm1{k1}{z}, xmmV, xmm2/m128", false, 16},
+		{"VPSLLD zmm1{k1}{z}, zmmV, xmm2/m128", false, 16},
+		{"VMOVDDUP xmm1{k1}{z}, xmm2/m64", false, 8},
+		{"VMOVDDUP ymm1{k1}{z}, ymm2/m256", false, 32},
+		{"VMOVDDUP zmm1{k1}{z}, zmm2/m512", false, 64},
+	}
+	for _, tt := range tests {
+		var c *compiledForm
+		for i := range forms {
+			if f := &forms[i]; f.Syntax == tt.syntax && f.Encoding.evex() {
+				c = compile(f)
+				break
+			}
+		}
+		if c == nil {
+			t.Errorf("%s: no EVEX form", tt.syntax)
+			continue
+		}
+		if got := c.disp8Scale(tt.bcst); got != tt.want {
+			t.Errorf("%s (%s): disp8Scale(%v) = %d, want %d", tt.syntax, c.form.Tuple, tt.bcst, got, tt.want)
+		}
+	}
+}
+
+func TestEncodeSized(t *testing.T) {
+	mem := MustMem(RAX, nil, 0, 0)
+	tests := []struct {
+		bits int
+		want []byte
+	}{
+		{8, []byte{0x80, 0x00, 0x01}},
+		{16, []byte{0x66, 0x83, 0x00, 0x01}},
+		{32, []byte{0x83, 0x00, 0x01}},
+		{64, []byte{0x48, 0x83, 0x00, 0x01}},
+	}
+	for _, tt := range tests {
+		got, err := EncodeSized("ADD", tt.bits, mem, Imm(1))
+		if err != nil {
+			t.Errorf("EncodeSized(ADD, %d): %v", tt.bits, err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("EncodeSized(ADD, %d) = % x, want % x", tt.bits, got, tt.want)
+		}
+	}
+	if got, err := EncodeSized("ADD", 8, RAX, RBX); err == nil {
+		t.Errorf("EncodeSized(ADD, 8, RAX, RBX) = % x, want error", got)
+	}
+	if got, err := EncodeSized("ADD", 128, mem, Imm(1)); err == nil {
+		t.Errorf("EncodeSized(ADD, 128) = % x, want error", got)
+	}
+
+	code, err := Assemble(func(b *Builder) {
+		b.ADDQ_MI(mem, Imm(1))
+		b.ADDB_MI(mem, Imm(1))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x48, 0x83, 0x00, 0x01, 0x80, 0x00, 0x01}; !bytes.Equal(code, want) {
+		t.Errorf("Assemble = % x, want % x", code, want)
+	}
+}
+
+func TestAssemble(t *testing.T) {
+	code, err := Assemble(func(b *Builder) {
+		b.PUSH_O(RBP)
+		b.MOV_MR(RBP, RSP)
+		b.XOR_MR(EAX, EAX)
+		b.ADD_MI(RAX, Imm(42))
+		b.POP_O(RBP)
+		b.RET_NP()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x55, 0x48, 0x89, 0xe5, 0x31, 0xc0, 0x48, 0x83, 0xc0, 0x2a, 0x5d, 0xc3}
+	if !bytes.Equal(code, want) {
+		t.Errorf("Assemble = % x, want % x", code, want)
+	}
+
+	if _, err := Assemble(func(b *Builder) { b.ADD_MI(MustMem(RAX, nil, 0, 0), Imm(1)) }); err == nil {
+		t.Error("Assemble with an ambiguous operand size succeeded")
+	}
+}
@@ -0,0 +1,234 @@
+package x86
+
+import "fmt"
+
+// Masked is the destination of an AVX-512 instruction under an opmask:
+// elements whose bit in Mask is clear are left unchanged or, with Zero,
+// set to zero. Intel writes it as ZMM1{K1} or ZMM1{K1}{z}.
+//
+// Dest is a vector or opmask register, or memory, which can only be merged
+// into. K0 stands for no opmask in the encoding, so it can not be used as
+// Mask. Use Mask to build a Masked.
+type Masked struct {
+	Dest Operand
+	Mask MaskReg
+	Zero bool
+}
+
+// Mask returns the destination dest under the opmask k, with merging.
+func Mask(dest Operand, k MaskReg) Masked {
+	return Masked{Dest: dest, Mask: k}
+}
+
+// Z returns m with zeroing instead of merging.
+func (m Masked) Z() Masked {
+	m.Zero = true
+	return m
+}
+
+// Info returns the register information of the destination, or the zero
+// RegInfo if it is memory.
+func (m Masked) Info() RegInfo {
+	if r, ok := m.Dest.(Register); ok {
+		return r.Info()
+	}
+	return RegInfo{}
+}
+
+// Validate returns an error if m can not be encoded.
+func (m Masked) Validate() error {
+	switch d := m.Dest.(type) {
+	case nil:
+		return fmt.Errorf("x86: masked operand has no destination")
+	case Masked, Rounded:
+		return fmt.Errorf("x86: %s can not be masked", d)
+	case Mem:
+		if m.Zero {
+			return fmt.Errorf("x86: %s: a memory destination can not be zeroed", m)
+		}
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	case Register:
+	default:
+		return fmt.Errorf("x86: %s can not be masked", d)
+	}
+	if info := m.Mask.Info(); info.Class != ClassMask || info.Num == 0 {
+		return fmt.Errorf("x86: %s can not be used as an opmask", m.Mask)
+	}
+	return nil
+}
+
+func (m Masked) String() string {
+	s := fmt.Sprintf("%s{%s}", m.Dest, m.Mask)
+	if m.Zero {
+		s += "{z}"
+	}
+	return s
+}
+
+// Rounding is the static rounding mode of an AVX-512 instruction, which
+// also suppresses floating point exceptions, or SAE to suppress exceptions
+// without changing the rounding.
+type Rounding uint8
+
+const (
+	RNSAE Rounding = iota // {rn-sae}: round to nearest, ties to even
+	RDSAE                 // {rd-sae}: round down
+	RUSAE                 // {ru-sae}: round up
+	RZSAE                 // {rz-sae}: round toward zero
+	SAE                   // {sae}: suppress all exceptions
+)
+
+var roundings = [...]struct {
+	id   string // Go identifier
+	name string // manual syntax
+	goop string // Go assembler opcode suffix
+}{
+	RNSAE: {"RNSAE", "rn-sae", "RN_SAE"},
+	RDSAE: {"RDSAE", "rd-sae", "RD_SAE"},
+	RUSAE: {"RUSAE", "ru-sae", "RU_SAE"},
+	RZSAE: {"RZSAE", "rz-sae", "RZ_SAE"},
+	SAE:   {"SAE", "sae", "SAE"},
+}
+
+// String returns the manual's syntax for the mode, e.g. "rn-sae".
+func (r Rounding) String() string {
+	if int(r) < len(roundings) {
+		return roundings[r].name
+	}
+	return fmt.Sprintf("Rounding(%d)", uint8(r))
+}
+
+// Rounded is a register operand of an AVX-512 instruction that carries the
+// rounding mode of the whole instruction, which the manual attaches to the
+// last register source: ZMM3{rn-sae}. Forms written with {er} accept the
+// four rounding modes and forms written with {sae} accept only SAE. Use
+// Round to build a Rounded.
+type Rounded struct {
+	Reg  Register
+	Mode Rounding
+}
+
+// Round returns the register r with the rounding mode mode.
+func Round(r Register, mode Rounding) Rounded {
+	return Rounded{Reg: r, Mode: mode}
+}
+
+// Info returns the register information of the register.
+func (r Rounded) Info() RegInfo {
+	if r.Reg == nil {
+		return RegInfo{}
+	}
+	return r.Reg.Info()
+}
+
+// Validate returns an error if r can not be encoded.
+func (r Rounded) Validate() error {
+	switch reg := r.Reg.(type) {
+	case nil:
+		return fmt.Errorf("x86: rounded operand has no register")
+	case Masked, Rounded:
+		return fmt.Errorf("x86: %s can not be rounded", reg)
+	}
+	if int(r.Mode) >= len(roundings) {
+		return fmt.Errorf("x86: %s is not a rounding mode", r.Mode)
+	}
+	return nil
+}
+
+func (r Rounded) String() string {
+	return fmt.Sprintf("%s{%s}", r.Reg, r.Mode)
+}
+
+func (Masked) isOperand()  {}
+func (Rounded) isOperand() {}
+
+func (Masked) isRegister()  {}
+func (Rounded) isRegister() {}
+
+func (Masked) isRegMem()  {}
+func (Rounded) isRegMem() {}
+
+// evexFlags are the fields of an EVEX prefix set by decorated operands: the
+// opmask register (aaa), zeroing (z), and broadcast or rounding control
+// (b). With rounding control, the rounding mode takes the place of the
+// vector length in L'L.
+type evexFlags struct {
+	aaa, z, b byte
+	round     bool
+	rc        byte
+}
+
+// undecorate returns the operand without its EVEX decorations, which are
+// added to flags.
+func undecorate(op Operand, flags *evexFlags) Operand {
+	switch o := op.(type) {
+	case Masked:
+		flags.aaa = o.Mask.Info().Num
+		if o.Zero {
+			flags.z = 1
+		}
+		return o.Dest
+	case Rounded:
+		// SAE alone leaves L'L zero.
+		flags.b, flags.round, flags.rc = 1, true, byte(o.Mode)&3
+		return o.Reg
+	case Mem:
+		if o.Broadcast != 0 {
+			flags.b = 1
+		}
+	}
+	return op
+}
+
+// disp8Scale returns N, the factor by which EVEX scales the one byte
+// displacement of the form's memory operand (disp8*N), or 0 if the form
+// has no tuple type to tell. bcst says whether the operand is broadcast.
+// N is the size in bytes of the memory the tuple type says the
+// instruction accesses: a full, half, quarter or eighth vector, a tuple of
+// elements, or a single element when broadcasting.
+func (c *compiledForm) disp8Scale(bcst bool) int {
+	var a *arg
+	for _, x := range c.args {
+		if x.slot == slotRM && x.mem {
+			a = x
+		}
+	}
+	if a == nil || c.enc.VEX == nil {
+		return 0
+	}
+	vl := 128 << c.enc.VEX.L
+	switch c.form.Tuple {
+	case "FV", "HV":
+		if bcst {
+			return a.bcst / 8
+		}
+		if c.form.Tuple == "HV" {
+			return vl / 16
+		}
+		return vl / 8
+	case "FVM":
+		return vl / 8
+	case "HVM":
+		return vl / 16
+	case "QVM":
+		return vl / 32
+	case "OVM":
+		return vl / 64
+	case "T1S", "T1F", "T2", "T4", "T8":
+		if a.vsib != 0 {
+			// Gathers and scatters: one element, of the size set by W.
+			return 4 << c.enc.VEX.W
+		}
+		return a.memBits / 8
+	case "M128", "T1_4X":
+		return 16
+	case "DUP":
+		if vl == 128 {
+			return 8
+		}
+		return vl / 8
+	}
+	return 0
+}
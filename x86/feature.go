@@ -0,0 +1,228 @@
+package x86
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Feature is a processor feature that instructions require, as named in
+// the CPUID Feature Flag column of the manual. The processor generations
+// 486, Pentium and PentiumII stand for the instructions they introduced,
+// such as CPUID, CMPXCHG8B and SYSENTER.
+type Feature uint8
+
+const (
+	Feature486 Feature = iota + 1
+	FeaturePentium
+	FeaturePentiumII
+	FeatureMMX
+	FeatureSSE
+	FeatureSSE2
+	FeatureSSE3
+	FeatureSSSE3
+	FeatureSSE41
+	FeatureSSE42
+	FeatureAES
+	FeaturePCLMULQDQ
+	FeatureSHA
+	FeatureAVX
+	FeatureAVX2
+	FeatureF16C
+	FeatureFMA
+	FeatureBMI1
+	FeatureBMI2
+	FeatureLZCNT
+	FeatureADX
+	FeatureRDRAND
+	FeatureRDSEED
+	FeatureRDPID
+	FeatureFSGSBASE
+	FeatureINVPCID
+	FeatureXSAVEOPT
+	FeaturePRFCHW
+	FeaturePREFETCHWT1
+	FeatureHLE
+	FeatureRTM
+	FeatureMPX
+	FeatureOSPKE
+	FeatureGFNI
+	FeatureVAES
+	FeatureVPCLMULQDQ
+	FeatureAVX512F
+	FeatureAVX512BW
+	FeatureAVX512CD
+	FeatureAVX512DQ
+	FeatureAVX512ER
+	FeatureAVX512PF
+	FeatureAVX512VL
+	FeatureAVX512BITALG
+	FeatureAVX512IFMA
+	FeatureAVX512VBMI
+	FeatureAVX512VBMI2
+	FeatureAVX512VNNI
+	FeatureAVX512VPOPCNTDQ
+)
+
+// featureNames are the manual's names of the features.
+var featureNames = [...]string{
+	Feature486:             "486",
+	FeaturePentium:         "Pentium",
+	FeaturePentiumII:       "PentiumII",
+	FeatureMMX:             "MMX",
+	FeatureSSE:             "SSE",
+	FeatureSSE2:            "SSE2",
+	FeatureSSE3:            "SSE3",
+	FeatureSSSE3:           "SSSE3",
+	FeatureSSE41:           "SSE4_1",
+	FeatureSSE42:           "SSE4_2",
+	FeatureAES:             "AES",
+	FeaturePCLMULQDQ:       "PCLMULQDQ",
+	FeatureSHA:             "SHA",
+	FeatureAVX:             "AVX",
+	FeatureAVX2:            "AVX2",
+	FeatureF16C:            "F16C",
+	FeatureFMA:             "FMA",
+	FeatureBMI1:            "BMI1",
+	FeatureBMI2:            "BMI2",
+	FeatureLZCNT:           "LZCNT",
+	FeatureADX:             "ADX",
+	FeatureRDRAND:          "RDRAND",
+	FeatureRDSEED:          "RDSEED",
+	FeatureRDPID:           "RDPID",
+	FeatureFSGSBASE:        "FSGSBASE",
+	FeatureINVPCID:         "INVPCID",
+	FeatureXSAVEOPT:        "XSAVEOPT",
+	FeaturePRFCHW:          "PRFCHW",
+	FeaturePREFETCHWT1:     "PREFETCHWT1",
+	FeatureHLE:             "HLE",
+	FeatureRTM:             "RTM",
+	FeatureMPX:             "MPX",
+	FeatureOSPKE:           "OSPKE",
+	FeatureGFNI:            "GFNI",
+	FeatureVAES:            "VAES",
+	FeatureVPCLMULQDQ:      "VPCLMULQDQ",
+	FeatureAVX512F:         "AVX512F",
+	FeatureAVX512BW:        "AVX512BW",
+	FeatureAVX512CD:        "AVX512CD",
+	FeatureAVX512DQ:        "AVX512DQ",
+	FeatureAVX512ER:        "AVX512ER",
+	FeatureAVX512PF:        "AVX512PF",
+	FeatureAVX512VL:        "AVX512VL",
+	FeatureAVX512BITALG:    "AVX512_BITALG",
+	FeatureAVX512IFMA:      "AVX512_IFMA",
+	FeatureAVX512VBMI:      "AVX512_VBMI",
+	FeatureAVX512VBMI2:     "AVX512_VBMI2",
+	FeatureAVX512VNNI:      "AVX512_VNNI",
+	FeatureAVX512VPOPCNTDQ: "AVX512_VPOPCNTDQ",
+}
+
+// String returns the manual's name for the feature, e.g. "SSE4_1".
+func (f Feature) String() string {
+	if int(f) < len(featureNames) && featureNames[f] != "" {
+		return featureNames[f]
+	}
+	return fmt.Sprintf("Feature(%d)", uint8(f))
+}
+
+// featureNamed returns the feature with the manual's name s.
+func featureNamed(s string) (Feature, bool) {
+	for f, name := range featureNames {
+		if name == s && name != "" {
+			return Feature(f), true
+		}
+	}
+	return 0, false
+}
+
+// Features is a set of features, such as those of a target processor.
+type Features uint64
+
+// NewFeatures returns the set of the features fs.
+func NewFeatures(fs ...Feature) Features {
+	var s Features
+	return s.With(fs...)
+}
+
+// With returns s with the features fs added.
+func (s Features) With(fs ...Feature) Features {
+	for _, f := range fs {
+		s |= 1 << f
+	}
+	return s
+}
+
+// Has reports whether s includes f.
+func (s Features) Has(f Feature) bool {
+	return s&(1<<f) != 0
+}
+
+// Contains reports whether s includes every feature of t.
+func (s Features) Contains(t Features) bool {
+	return s&t == t
+}
+
+// List returns the features of s in the order of their constants.
+func (s Features) List() []Feature {
+	var fs []Feature
+	for f := Feature(1); int(f) < len(featureNames); f++ {
+		if s.Has(f) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// String returns the features joined by "+", as in the manual's
+// "AVX512VL+AVX512F", but in the order of their constants.
+func (s Features) String() string {
+	names := []string{}
+	for _, f := range s.List() {
+		names = append(names, f.String())
+	}
+	return strings.Join(names, "+")
+}
+
+// ParseFeatures parses features joined by "+", e.g. "AVX2+BMI2".
+func ParseFeatures(s string) (Features, error) {
+	var fs Features
+	for _, name := range strings.Split(s, "+") {
+		f, ok := featureNamed(strings.TrimSpace(name))
+		if !ok {
+			return 0, fmt.Errorf("x86: unknown feature %q", name)
+		}
+		fs = fs.With(f)
+	}
+	return fs, nil
+}
+
+// Requires returns the feature sets of which a processor needs one to run
+// the form: a single set for a Cpuid of "AVX512VL+AVX512F", the sets {HLE}
+// and {RTM} for "HLE or RTM", and none if the form needs no feature.
+func (f *Form) Requires() ([]Features, error) {
+	if f.Cpuid == "" {
+		return nil, nil
+	}
+	var sets []Features
+	for _, alt := range strings.Split(f.Cpuid, " or ") {
+		fs, err := ParseFeatures(alt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f.Syntax, err)
+		}
+		sets = append(sets, fs)
+	}
+	return sets, nil
+}
+
+// RunsOn reports whether a processor with the features can run the form.
+func (f *Form) RunsOn(target Features) bool {
+	sets, err := f.Requires()
+	if err != nil {
+		return false
+	}
+	for _, fs := range sets {
+		if target.Contains(fs) {
+			return true
+		}
+	}
+	return len(sets) == 0
+}
@@ -0,0 +1,98 @@
+package x86
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFeatures(t *testing.T) {
+	fs, err := ParseFeatures("AVX512VL+AVX512F")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := NewFeatures(FeatureAVX512F, FeatureAVX512VL); fs != want {
+		t.Errorf("ParseFeatures = %s, want %s", fs, want)
+	}
+	if got := fs.String(); got != "AVX512F+AVX512VL" {
+		t.Errorf("String = %q", got)
+	}
+	if !fs.With(FeatureAVX2).Contains(fs) || fs.Contains(fs.With(FeatureAVX2)) {
+		t.Error("Contains is wrong")
+	}
+	if _, err := ParseFeatures("AVX9"); err == nil {
+		t.Error("ParseFeatures(AVX9) succeeded")
+	}
+	if got := FeatureSSE41.String(); got != "SSE4_1" {
+		t.Errorf("FeatureSSE41 = %q", got)
+	}
+
+	// Every form's CPUID flags are known features.
+	for i := range forms {
+		if _, err := forms[i].Requires(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	xtest := Forms("XTEST")[0]
+	for _, tt := range []struct {
+		target Features
+		want   bool
+	}{
+		{NewFeatures(FeatureHLE), true},
+		{NewFeatures(FeatureRTM), true},
+		{NewFeatures(FeatureAVX), false},
+	} {
+		if got := xtest.RunsOn(tt.target); got != tt.want {
+			t.Errorf("XTEST RunsOn(%s) = %v, want %v", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestBuilderFeatures(t *testing.T) {
+	avx2 := NewFeatures(FeatureSSE, FeatureSSE2, FeatureAVX, FeatureAVX2)
+
+	// VPMAXSD of XMM registers has a VEX form, which AVX2 runs.
+	b := Builder{Features: avx2}
+	b.VPMAXSD_RVM(XMM1, XMM2, XMM3)
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0xc4, 0xe2, 0x69, 0x3d, 0xcb}; !bytes.Equal(code, want) {
+		t.Errorf("Encode = % x, want % x", code, want)
+	}
+
+	// The EVEX form of VPMAXSD is not a fallback for a target without
+	// the features of the VEX form.
+	b.Features = NewFeatures(FeatureAVX512F, FeatureAVX512VL)
+	if _, err := b.Encode(); err == nil || !strings.Contains(err.Error(), "needs AVX") {
+		t.Errorf("Encode of VPMAXSD for AVX-512 without AVX = %v, want an error naming AVX", err)
+	}
+
+	// Nor for a displacement that only EVEX compresses to 8 bits.
+	b = Builder{}
+	b.VADDPS_RVM(YMM1, YMM2, MustMem(RAX, nil, 0, 256))
+	code, err = b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0xc5, 0xec, 0x58, 0x88, 0x00, 0x01, 0x00, 0x00}; !bytes.Equal(code, want) {
+		t.Errorf("Encode = % x, want % x", code, want)
+	}
+
+	b = Builder{Features: avx2}
+	b.VPMAXSQ(XMM1, XMM2, XMM3)
+	if _, err := b.Encode(); err == nil || !strings.Contains(err.Error(), "AVX512VL+AVX512F") {
+		t.Errorf("Encode of VPMAXSQ for AVX2 = %v, want an error naming AVX512VL+AVX512F", err)
+	}
+	var buf bytes.Buffer
+	if err := (&GnuAsm{Funcs: []GnuFunc{{Name: "f", Code: &b}}}).WriteAsm(&buf); err == nil {
+		t.Error("WriteAsm of VPMAXSQ for AVX2 succeeded")
+	}
+
+	b.Features = avx2.With(FeatureAVX512F, FeatureAVX512VL)
+	if _, err := b.Encode(); err != nil {
+		t.Errorf("Encode of VPMAXSQ for AVX-512: %v", err)
+	}
+}
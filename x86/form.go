@@ -0,0 +1,179 @@
+package x86
+
+import (
+	"strings"
+	"sync"
+)
+
+// Form is a single instruction form from the manual's opcode tables, e.g.
+// "ADD r/m32, imm8" encoded as "83 /0 ib". The table of forms is generated
+// from the same x86spec data as the instruction functions.
+type Form struct {
+	Syntax    string        // Intel syntax, e.g. "ADD r/m32, imm8"
+	GoSyntax  string        // Go assembler syntax, e.g. "ADDL imm8, r/m32"
+	GnuSyntax string        // GNU assembler syntax, e.g. "addl imm8, r/m32"
+	Opcode    string        // encoding, e.g. "83 /0 ib"
+	Encoding  Encoding      // Opcode as x86spec parses it
+	Operands  []FormOperand // arguments as x86spec parses them, in Intel order
+	Valid32   bool          // valid in 32-bit (legacy and compatibility) mode
+	Valid64   bool          // valid in 64-bit mode
+	Cpuid     string        // required CPUID feature flags, e.g. "AVX2" or "PCLMULQDQ+AVX"
+	Tags      []string      // x86spec hints: operand32, modrm_memonly, pseudo, ...
+	Action    string        // read/write actions on the arguments, e.g. "rw,r"
+	Multisize bool          // has forms distinguished only by operand size
+	Datasize  int           // data size of the operation in bits, or 0
+	Tuple     string        // EVEX tuple type, e.g. "FV" or "T1S", which scales 8-bit displacements
+	Func      string        // generated function taking the form's arguments, e.g. "ADD_MI"
+	Sized     string        // generated function for the form's operand size, e.g. "ADDQ_MI"
+}
+
+// Encoding is the parsed Opcode of a form, such as "REX.W 81 /0 id" or
+// "VEX.NDS.LIG.F2.0F.W0 2A /r". It mirrors x86spec.Encoding.
+type Encoding struct {
+	Prefixes []byte   // legacy prefixes that are part of the opcode: 9B, 66, F2 or F3
+	REX      bool     // a REX prefix is required, even with no bits set
+	REXW     bool     // REX.W
+	REXR     bool     // REX.R, which selects CR8
+	VEX      *VEX     // the VEX or EVEX prefix, or nil
+	Opcode   []byte   // opcode bytes, including the 0F escapes of legacy opcodes
+	Plus     string   // register number added to the last opcode byte: "rb", "rw", "rd", "ro" or "i", or ""
+	ModRM    bool     // a ModRM byte follows the opcode, for /r or /digit
+	Digit    int      // ModRM reg field for /digit, or -1
+	Imm      []string // immediates and code offsets in order: "ib", "iw", "id", "io", "cb", "cw", "cd", "cp" or "cm"
+	Is4      bool     // /is4: a register in the top four bits of an immediate byte
+	Suffix   []byte   // opcode bytes after the immediates, as in ENTER imm16, 0
+}
+
+// VEX holds the fields of a VEX or EVEX prefix, as in
+// "EVEX.NDS.512.66.0F38.W1".
+type VEX struct {
+	EVEX  bool
+	VVVV  string // role of the vvvv register: "NDS", "NDD" or "DDS", or "" if unused
+	L     byte   // vector length: 0 for 128 bits or LZ, 1 for 256 and 2 for 512
+	LIG   bool   // the vector length is ignored
+	PP    byte   // implied prefix: 0 for none, 1 for 66, 2 for F3 and 3 for F2
+	MMMMM byte   // opcode map: 1 for 0F, 2 for 0F38 and 3 for 0F3A
+	W     byte
+	WIG   bool // W is ignored
+}
+
+// FormOperand describes an argument of a form, as x86spec parses it from
+// the syntax: the operands it accepts and where they are encoded. It
+// mirrors x86spec.Operand.
+type FormOperand struct {
+	Syntax   string   // argument syntax without decorations, e.g. "xmm2/m128"
+	Kind     string   // "reg", "mem", "reg/mem", "imm", "rel", "vsib" or "implicit"
+	Class    RegClass // class of a register or VSIB index, or ClassNone
+	Width    int      // width of a register or VSIB index in bits
+	Fixed    string   // register or constant an implicit operand names, e.g. "AL" or "1"
+	Size     int      // size in bits of the register, immediate, offset or VSIB index
+	Mem      string   // memory syntax, e.g. "m128" or "m32fp", or "" for any memory
+	MemSize  int      // size in bits of a memory operand, or 0 if it varies
+	Slot     string   // "reg", "r/m", "vvvv", "is4", "opcode" or "imm", or "" if not encoded
+	Mask     bool     // accepts an opmask, {k1}
+	Zero     bool     // accepts zeroing, {z}
+	Bcst     int      // element size in bits of a broadcast, m32bcst or m64bcst, or 0
+	Rounding string   // "er" for static rounding or "sae" to suppress exceptions, or ""
+}
+
+// wait reports whether an FWAIT (9B) goes before the instruction and its
+// prefixes.
+func (e *Encoding) wait() bool {
+	return len(e.Prefixes) > 0 && e.Prefixes[0] == 0x9B
+}
+
+// evex reports whether the encoding has an EVEX prefix.
+func (e *Encoding) evex() bool {
+	return e.VEX != nil && e.VEX.EVEX
+}
+
+// mandatory returns the prefixes that are part of the opcode, 66, F2 or
+// F3, and go right before a REX prefix.
+func (e *Encoding) mandatory() []byte {
+	if e.wait() {
+		return e.Prefixes[1:]
+	}
+	return e.Prefixes
+}
+
+// immSize returns the size in bytes of the i'th immediate, given the size
+// in bits of addresses for a cm offset.
+func (e *Encoding) immSize(i, address int) int {
+	switch e.Imm[i] {
+	case "ib", "cb":
+		return 1
+	case "iw", "cw":
+		return 2
+	case "id", "cd":
+		return 4
+	case "io":
+		return 8
+	case "cp":
+		return 6
+	}
+	return address / 8
+}
+
+// Mnemonic returns the Intel mnemonic of the form, e.g. "ADD".
+func (f *Form) Mnemonic() string {
+	if i := strings.IndexByte(f.Syntax, ' '); i >= 0 {
+		return f.Syntax[:i]
+	}
+	return f.Syntax
+}
+
+// Args returns the Intel argument syntaxes of the form, e.g.
+// ["r/m32", "imm8"].
+func (f *Form) Args() []string {
+	i := strings.IndexByte(f.Syntax, ' ')
+	if i < 0 {
+		return nil
+	}
+	return strings.Split(f.Syntax[i+1:], ", ")
+}
+
+// HasTag reports whether the form carries the x86spec tag t.
+func (f *Form) HasTag(t string) bool {
+	for _, tag := range f.Tags {
+		if tag == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Forms returns every form of the instruction with the given Intel
+// mnemonic, in table order.
+func Forms(mnemonic string) []*Form {
+	formsOnce.Do(indexForms)
+	return formsByMnemonic[mnemonic]
+}
+
+// sizedFunc returns the Intel mnemonic and operand size of the forms of
+// the operand-size specific function fn, e.g. "ADD" and 64 for "ADDQ_MI".
+func sizedFunc(fn string) (string, int, bool) {
+	formsOnce.Do(indexForms)
+	f, ok := formsBySized[fn]
+	if !ok {
+		return "", 0, false
+	}
+	return f.Mnemonic(), f.Datasize, true
+}
+
+var (
+	formsOnce       sync.Once
+	formsByMnemonic map[string][]*Form
+	formsBySized    map[string]*Form // first form of each operand-size specific function
+)
+
+func indexForms() {
+	formsByMnemonic = map[string][]*Form{}
+	formsBySized = map[string]*Form{}
+	for i := range forms {
+		f := &forms[i]
+		formsByMnemonic[f.Mnemonic()] = append(formsByMnemonic[f.Mnemonic()], f)
+		if _, ok := formsBySized[f.Sized]; f.Sized != "" && !ok {
+			formsBySized[f.Sized] = f
+		}
+	}
+}
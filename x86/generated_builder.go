@@ -0,0 +1,11806 @@
+package x86
+
+// AAA appends AAA to the program. See the function AAA.
+func (b *Builder) AAA() {
+	b.add("AAA", "AAA")
+}
+
+// AAD appends AAD to the program. See the function AAD.
+func (b *Builder) AAD() {
+	b.add("AAD", "AAD")
+}
+
+// AAD_I appends AAD to the program. See the function AAD_I.
+func (b *Builder) AAD_I(imm Imm) {
+	b.add("AAD", "AAD_I", imm)
+}
+
+// AAM appends AAM to the program. See the function AAM.
+func (b *Builder) AAM() {
+	b.add("AAM", "AAM")
+}
+
+// AAM_I appends AAM to the program. See the function AAM_I.
+func (b *Builder) AAM_I(imm Imm) {
+	b.add("AAM", "AAM_I", imm)
+}
+
+// AAS appends AAS to the program. See the function AAS.
+func (b *Builder) AAS() {
+	b.add("AAS", "AAS")
+}
+
+// ADCX appends ADCX to the program. See the function ADCX.
+func (b *Builder) ADCX(reg Reg, rm RegMem) {
+	b.add("ADCX", "ADCX", reg, rm)
+}
+
+// ADC_I appends ADC to the program. See the function ADC_I.
+func (b *Builder) ADC_I(al Reg, imm Imm) {
+	b.add("ADC", "ADC_I", al, imm)
+}
+
+// ADC_MI appends ADC to the program. See the function ADC_MI.
+func (b *Builder) ADC_MI(rm RegMem, imm Imm) {
+	b.add("ADC", "ADC_MI", rm, imm)
+}
+
+// ADC_MR appends ADC to the program. See the function ADC_MR.
+func (b *Builder) ADC_MR(rm RegMem, reg Reg) {
+	b.add("ADC", "ADC_MR", rm, reg)
+}
+
+// ADC_RM appends ADC to the program. See the function ADC_RM.
+func (b *Builder) ADC_RM(reg Reg, rm RegMem) {
+	b.add("ADC", "ADC_RM", reg, rm)
+}
+
+// ADDPD appends ADDPD to the program. See the function ADDPD.
+func (b *Builder) ADDPD(reg VecReg, rm RegMem) {
+	b.add("ADDPD", "ADDPD", reg, rm)
+}
+
+// ADDPS appends ADDPS to the program. See the function ADDPS.
+func (b *Builder) ADDPS(reg VecReg, rm RegMem) {
+	b.add("ADDPS", "ADDPS", reg, rm)
+}
+
+// ADDSD appends ADDSD to the program. See the function ADDSD.
+func (b *Builder) ADDSD(reg VecReg, rm RegMem) {
+	b.add("ADDSD", "ADDSD", reg, rm)
+}
+
+// ADDSS appends ADDSS to the program. See the function ADDSS.
+func (b *Builder) ADDSS(reg VecReg, rm RegMem) {
+	b.add("ADDSS", "ADDSS", reg, rm)
+}
+
+// ADDSUBPD appends ADDSUBPD to the program. See the function ADDSUBPD.
+func (b *Builder) ADDSUBPD(reg VecReg, rm RegMem) {
+	b.add("ADDSUBPD", "ADDSUBPD", reg, rm)
+}
+
+// ADDSUBPS appends ADDSUBPS to the program. See the function ADDSUBPS.
+func (b *Builder) ADDSUBPS(reg VecReg, rm RegMem) {
+	b.add("ADDSUBPS", "ADDSUBPS", reg, rm)
+}
+
+// ADD_I appends ADD to the program. See the function ADD_I.
+func (b *Builder) ADD_I(al Reg, imm Imm) {
+	b.add("ADD", "ADD_I", al, imm)
+}
+
+// ADD_MI appends ADD to the program. See the function ADD_MI.
+func (b *Builder) ADD_MI(rm RegMem, imm Imm) {
+	b.add("ADD", "ADD_MI", rm, imm)
+}
+
+// ADD_MR appends ADD to the program. See the function ADD_MR.
+func (b *Builder) ADD_MR(rm RegMem, reg Reg) {
+	b.add("ADD", "ADD_MR", rm, reg)
+}
+
+// ADD_RM appends ADD to the program. See the function ADD_RM.
+func (b *Builder) ADD_RM(reg Reg, rm RegMem) {
+	b.add("ADD", "ADD_RM", reg, rm)
+}
+
+// ADOX appends ADOX to the program. See the function ADOX.
+func (b *Builder) ADOX(reg Reg, rm RegMem) {
+	b.add("ADOX", "ADOX", reg, rm)
+}
+
+// AESDEC appends AESDEC to the program. See the function AESDEC.
+func (b *Builder) AESDEC(reg VecReg, rm RegMem) {
+	b.add("AESDEC", "AESDEC", reg, rm)
+}
+
+// AESDECLAST appends AESDECLAST to the program. See the function AESDECLAST.
+func (b *Builder) AESDECLAST(reg VecReg, rm RegMem) {
+	b.add("AESDECLAST", "AESDECLAST", reg, rm)
+}
+
+// AESENC appends AESENC to the program. See the function AESENC.
+func (b *Builder) AESENC(reg VecReg, rm RegMem) {
+	b.add("AESENC", "AESENC", reg, rm)
+}
+
+// AESENCLAST appends AESENCLAST to the program. See the function AESENCLAST.
+func (b *Builder) AESENCLAST(reg VecReg, rm RegMem) {
+	b.add("AESENCLAST", "AESENCLAST", reg, rm)
+}
+
+// AESIMC appends AESIMC to the program. See the function AESIMC.
+func (b *Builder) AESIMC(reg VecReg, rm RegMem) {
+	b.add("AESIMC", "AESIMC", reg, rm)
+}
+
+// AESKEYGENASSIST appends AESKEYGENASSIST to the program. See the function AESKEYGENASSIST.
+func (b *Builder) AESKEYGENASSIST(reg VecReg, rm RegMem, imm Imm) {
+	b.add("AESKEYGENASSIST", "AESKEYGENASSIST", reg, rm, imm)
+}
+
+// ANDN appends ANDN to the program. See the function ANDN.
+func (b *Builder) ANDN(reg, vvvv Reg, rm RegMem) {
+	b.add("ANDN", "ANDN", reg, vvvv, rm)
+}
+
+// ANDNPD appends ANDNPD to the program. See the function ANDNPD.
+func (b *Builder) ANDNPD(reg VecReg, rm RegMem) {
+	b.add("ANDNPD", "ANDNPD", reg, rm)
+}
+
+// ANDNPS appends ANDNPS to the program. See the function ANDNPS.
+func (b *Builder) ANDNPS(reg VecReg, rm RegMem) {
+	b.add("ANDNPS", "ANDNPS", reg, rm)
+}
+
+// ANDPD appends ANDPD to the program. See the function ANDPD.
+func (b *Builder) ANDPD(reg VecReg, rm RegMem) {
+	b.add("ANDPD", "ANDPD", reg, rm)
+}
+
+// ANDPS appends ANDPS to the program. See the function ANDPS.
+func (b *Builder) ANDPS(reg VecReg, rm RegMem) {
+	b.add("ANDPS", "ANDPS", reg, rm)
+}
+
+// AND_I appends AND to the program. See the function AND_I.
+func (b *Builder) AND_I(al Reg, imm Imm) {
+	b.add("AND", "AND_I", al, imm)
+}
+
+// AND_MI appends AND to the program. See the function AND_MI.
+func (b *Builder) AND_MI(rm RegMem, imm Imm) {
+	b.add("AND", "AND_MI", rm, imm)
+}
+
+// AND_MR appends AND to the program. See the function AND_MR.
+func (b *Builder) AND_MR(rm RegMem, reg Reg) {
+	b.add("AND", "AND_MR", rm, reg)
+}
+
+// AND_RM appends AND to the program. See the function AND_RM.
+func (b *Builder) AND_RM(reg Reg, rm RegMem) {
+	b.add("AND", "AND_RM", reg, rm)
+}
+
+// ARPL appends ARPL to the program. See the function ARPL.
+func (b *Builder) ARPL(rm RegMem, reg Reg) {
+	b.add("ARPL", "ARPL", rm, reg)
+}
+
+// BEXTR appends BEXTR to the program. See the function BEXTR.
+func (b *Builder) BEXTR(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("BEXTR", "BEXTR", reg, rm, vvvv)
+}
+
+// BLENDPD appends BLENDPD to the program. See the function BLENDPD.
+func (b *Builder) BLENDPD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("BLENDPD", "BLENDPD", reg, rm, imm)
+}
+
+// BLENDPS appends BLENDPS to the program. See the function BLENDPS.
+func (b *Builder) BLENDPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("BLENDPS", "BLENDPS", reg, rm, imm)
+}
+
+// BLENDVPD appends BLENDVPD to the program. See the function BLENDVPD.
+func (b *Builder) BLENDVPD(reg VecReg, rm RegMem, xmm0 VecReg) {
+	b.add("BLENDVPD", "BLENDVPD", reg, rm, xmm0)
+}
+
+// BLENDVPS appends BLENDVPS to the program. See the function BLENDVPS.
+func (b *Builder) BLENDVPS(reg VecReg, rm RegMem, xmm0 VecReg) {
+	b.add("BLENDVPS", "BLENDVPS", reg, rm, xmm0)
+}
+
+// BLSI appends BLSI to the program. See the function BLSI.
+func (b *Builder) BLSI(vvvv Reg, rm RegMem) {
+	b.add("BLSI", "BLSI", vvvv, rm)
+}
+
+// BLSMSK appends BLSMSK to the program. See the function BLSMSK.
+func (b *Builder) BLSMSK(vvvv Reg, rm RegMem) {
+	b.add("BLSMSK", "BLSMSK", vvvv, rm)
+}
+
+// BLSR appends BLSR to the program. See the function BLSR.
+func (b *Builder) BLSR(vvvv Reg, rm RegMem) {
+	b.add("BLSR", "BLSR", vvvv, rm)
+}
+
+// BNDCL appends BNDCL to the program. See the function BNDCL.
+func (b *Builder) BNDCL(reg BndReg, rm RegMem) {
+	b.add("BNDCL", "BNDCL", reg, rm)
+}
+
+// BNDCN appends BNDCN to the program. See the function BNDCN.
+func (b *Builder) BNDCN(reg BndReg, rm RegMem) {
+	b.add("BNDCN", "BNDCN", reg, rm)
+}
+
+// BNDCU appends BNDCU to the program. See the function BNDCU.
+func (b *Builder) BNDCU(reg BndReg, rm RegMem) {
+	b.add("BNDCU", "BNDCU", reg, rm)
+}
+
+// BNDLDX appends BNDLDX to the program. See the function BNDLDX.
+func (b *Builder) BNDLDX(reg BndReg, rm Mem) {
+	b.add("BNDLDX", "BNDLDX", reg, rm)
+}
+
+// BNDMK appends BNDMK to the program. See the function BNDMK.
+func (b *Builder) BNDMK(reg BndReg, rm Mem) {
+	b.add("BNDMK", "BNDMK", reg, rm)
+}
+
+// BNDMOV_MR appends BNDMOV to the program. See the function BNDMOV_MR.
+func (b *Builder) BNDMOV_MR(rm RegMem, reg BndReg) {
+	b.add("BNDMOV", "BNDMOV_MR", rm, reg)
+}
+
+// BNDMOV_RM appends BNDMOV to the program. See the function BNDMOV_RM.
+func (b *Builder) BNDMOV_RM(reg BndReg, rm RegMem) {
+	b.add("BNDMOV", "BNDMOV_RM", reg, rm)
+}
+
+// BNDSTX appends BNDSTX to the program. See the function BNDSTX.
+func (b *Builder) BNDSTX(rm Mem, reg BndReg) {
+	b.add("BNDSTX", "BNDSTX", rm, reg)
+}
+
+// BOUND appends BOUND to the program. See the function BOUND.
+func (b *Builder) BOUND(reg Reg, rm Mem) {
+	b.add("BOUND", "BOUND", reg, rm)
+}
+
+// BSF appends BSF to the program. See the function BSF.
+func (b *Builder) BSF(reg Reg, rm RegMem) {
+	b.add("BSF", "BSF", reg, rm)
+}
+
+// BSR appends BSR to the program. See the function BSR.
+func (b *Builder) BSR(reg Reg, rm RegMem) {
+	b.add("BSR", "BSR", reg, rm)
+}
+
+// BSWAP appends BSWAP to the program. See the function BSWAP.
+func (b *Builder) BSWAP(opcode Reg) {
+	b.add("BSWAP", "BSWAP", opcode)
+}
+
+// BTC_MI appends BTC to the program. See the function BTC_MI.
+func (b *Builder) BTC_MI(rm RegMem, imm Imm) {
+	b.add("BTC", "BTC_MI", rm, imm)
+}
+
+// BTC_MR appends BTC to the program. See the function BTC_MR.
+func (b *Builder) BTC_MR(rm RegMem, reg Reg) {
+	b.add("BTC", "BTC_MR", rm, reg)
+}
+
+// BTR_MI appends BTR to the program. See the function BTR_MI.
+func (b *Builder) BTR_MI(rm RegMem, imm Imm) {
+	b.add("BTR", "BTR_MI", rm, imm)
+}
+
+// BTR_MR appends BTR to the program. See the function BTR_MR.
+func (b *Builder) BTR_MR(rm RegMem, reg Reg) {
+	b.add("BTR", "BTR_MR", rm, reg)
+}
+
+// BTS_MI appends BTS to the program. See the function BTS_MI.
+func (b *Builder) BTS_MI(rm RegMem, imm Imm) {
+	b.add("BTS", "BTS_MI", rm, imm)
+}
+
+// BTS_MR appends BTS to the program. See the function BTS_MR.
+func (b *Builder) BTS_MR(rm RegMem, reg Reg) {
+	b.add("BTS", "BTS_MR", rm, reg)
+}
+
+// BT_MI appends BT to the program. See the function BT_MI.
+func (b *Builder) BT_MI(rm RegMem, imm Imm) {
+	b.add("BT", "BT_MI", rm, imm)
+}
+
+// BT_MR appends BT to the program. See the function BT_MR.
+func (b *Builder) BT_MR(rm RegMem, reg Reg) {
+	b.add("BT", "BT_MR", rm, reg)
+}
+
+// BZHI appends BZHI to the program. See the function BZHI.
+func (b *Builder) BZHI(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("BZHI", "BZHI", reg, rm, vvvv)
+}
+
+// CALL_D appends CALL to the program. See the function CALL_D.
+func (b *Builder) CALL_D(rel Target) {
+	b.add("CALL", "CALL_D", rel)
+}
+
+// CALL_FAR_I appends CALL_FAR to the program. See the function CALL_FAR_I.
+func (b *Builder) CALL_FAR_I(imm Imm) {
+	b.add("CALL_FAR", "CALL_FAR_I", imm)
+}
+
+// CALL_FAR_M appends CALL_FAR to the program. See the function CALL_FAR_M.
+func (b *Builder) CALL_FAR_M(rm Mem) {
+	b.add("CALL_FAR", "CALL_FAR_M", rm)
+}
+
+// CALL_M appends CALL to the program. See the function CALL_M.
+func (b *Builder) CALL_M(rm RegMem) {
+	b.add("CALL", "CALL_M", rm)
+}
+
+// CBW appends CBW to the program. See the function CBW.
+func (b *Builder) CBW() {
+	b.add("CBW", "CBW")
+}
+
+// CDQ appends CDQ to the program. See the function CDQ.
+func (b *Builder) CDQ() {
+	b.add("CDQ", "CDQ")
+}
+
+// CDQE appends CDQE to the program. See the function CDQE.
+func (b *Builder) CDQE() {
+	b.add("CDQE", "CDQE")
+}
+
+// CLAC appends CLAC to the program. See the function CLAC.
+func (b *Builder) CLAC() {
+	b.add("CLAC", "CLAC")
+}
+
+// CLC appends CLC to the program. See the function CLC.
+func (b *Builder) CLC() {
+	b.add("CLC", "CLC")
+}
+
+// CLD appends CLD to the program. See the function CLD.
+func (b *Builder) CLD() {
+	b.add("CLD", "CLD")
+}
+
+// CLFLUSH appends CLFLUSH to the program. See the function CLFLUSH.
+func (b *Builder) CLFLUSH(rm Mem) {
+	b.add("CLFLUSH", "CLFLUSH", rm)
+}
+
+// CLFLUSHOPT appends CLFLUSHOPT to the program. See the function CLFLUSHOPT.
+func (b *Builder) CLFLUSHOPT(rm Mem) {
+	b.add("CLFLUSHOPT", "CLFLUSHOPT", rm)
+}
+
+// CLI appends CLI to the program. See the function CLI.
+func (b *Builder) CLI() {
+	b.add("CLI", "CLI")
+}
+
+// CLTS appends CLTS to the program. See the function CLTS.
+func (b *Builder) CLTS() {
+	b.add("CLTS", "CLTS")
+}
+
+// CMC appends CMC to the program. See the function CMC.
+func (b *Builder) CMC() {
+	b.add("CMC", "CMC")
+}
+
+// CMOVA appends CMOVA to the program. See the function CMOVA.
+func (b *Builder) CMOVA(reg Reg, rm RegMem) {
+	b.add("CMOVA", "CMOVA", reg, rm)
+}
+
+// CMOVAE appends CMOVAE to the program. See the function CMOVAE.
+func (b *Builder) CMOVAE(reg Reg, rm RegMem) {
+	b.add("CMOVAE", "CMOVAE", reg, rm)
+}
+
+// CMOVB appends CMOVB to the program. See the function CMOVB.
+func (b *Builder) CMOVB(reg Reg, rm RegMem) {
+	b.add("CMOVB", "CMOVB", reg, rm)
+}
+
+// CMOVBE appends CMOVBE to the program. See the function CMOVBE.
+func (b *Builder) CMOVBE(reg Reg, rm RegMem) {
+	b.add("CMOVBE", "CMOVBE", reg, rm)
+}
+
+// CMOVC appends CMOVC to the program. See the function CMOVC.
+func (b *Builder) CMOVC(reg Reg, rm RegMem) {
+	b.add("CMOVC", "CMOVC", reg, rm)
+}
+
+// CMOVE appends CMOVE to the program. See the function CMOVE.
+func (b *Builder) CMOVE(reg Reg, rm RegMem) {
+	b.add("CMOVE", "CMOVE", reg, rm)
+}
+
+// CMOVG appends CMOVG to the program. See the function CMOVG.
+func (b *Builder) CMOVG(reg Reg, rm RegMem) {
+	b.add("CMOVG", "CMOVG", reg, rm)
+}
+
+// CMOVGE appends CMOVGE to the program. See the function CMOVGE.
+func (b *Builder) CMOVGE(reg Reg, rm RegMem) {
+	b.add("CMOVGE", "CMOVGE", reg, rm)
+}
+
+// CMOVL appends CMOVL to the program. See the function CMOVL.
+func (b *Builder) CMOVL(reg Reg, rm RegMem) {
+	b.add("CMOVL", "CMOVL", reg, rm)
+}
+
+// CMOVLE appends CMOVLE to the program. See the function CMOVLE.
+func (b *Builder) CMOVLE(reg Reg, rm RegMem) {
+	b.add("CMOVLE", "CMOVLE", reg, rm)
+}
+
+// CMOVNA appends CMOVNA to the program. See the function CMOVNA.
+func (b *Builder) CMOVNA(reg Reg, rm RegMem) {
+	b.add("CMOVNA", "CMOVNA", reg, rm)
+}
+
+// CMOVNAE appends CMOVNAE to the program. See the function CMOVNAE.
+func (b *Builder) CMOVNAE(reg Reg, rm RegMem) {
+	b.add("CMOVNAE", "CMOVNAE", reg, rm)
+}
+
+// CMOVNB appends CMOVNB to the program. See the function CMOVNB.
+func (b *Builder) CMOVNB(reg Reg, rm RegMem) {
+	b.add("CMOVNB", "CMOVNB", reg, rm)
+}
+
+// CMOVNBE appends CMOVNBE to the program. See the function CMOVNBE.
+func (b *Builder) CMOVNBE(reg Reg, rm RegMem) {
+	b.add("CMOVNBE", "CMOVNBE", reg, rm)
+}
+
+// CMOVNC appends CMOVNC to the program. See the function CMOVNC.
+func (b *Builder) CMOVNC(reg Reg, rm RegMem) {
+	b.add("CMOVNC", "CMOVNC", reg, rm)
+}
+
+// CMOVNE appends CMOVNE to the program. See the function CMOVNE.
+func (b *Builder) CMOVNE(reg Reg, rm RegMem) {
+	b.add("CMOVNE", "CMOVNE", reg, rm)
+}
+
+// CMOVNG appends CMOVNG to the program. See the function CMOVNG.
+func (b *Builder) CMOVNG(reg Reg, rm RegMem) {
+	b.add("CMOVNG", "CMOVNG", reg, rm)
+}
+
+// CMOVNGE appends CMOVNGE to the program. See the function CMOVNGE.
+func (b *Builder) CMOVNGE(reg Reg, rm RegMem) {
+	b.add("CMOVNGE", "CMOVNGE", reg, rm)
+}
+
+// CMOVNL appends CMOVNL to the program. See the function CMOVNL.
+func (b *Builder) CMOVNL(reg Reg, rm RegMem) {
+	b.add("CMOVNL", "CMOVNL", reg, rm)
+}
+
+// CMOVNLE appends CMOVNLE to the program. See the function CMOVNLE.
+func (b *Builder) CMOVNLE(reg Reg, rm RegMem) {
+	b.add("CMOVNLE", "CMOVNLE", reg, rm)
+}
+
+// CMOVNO appends CMOVNO to the program. See the function CMOVNO.
+func (b *Builder) CMOVNO(reg Reg, rm RegMem) {
+	b.add("CMOVNO", "CMOVNO", reg, rm)
+}
+
+// CMOVNP appends CMOVNP to the program. See the function CMOVNP.
+func (b *Builder) CMOVNP(reg Reg, rm RegMem) {
+	b.add("CMOVNP", "CMOVNP", reg, rm)
+}
+
+// CMOVNS appends CMOVNS to the program. See the function CMOVNS.
+func (b *Builder) CMOVNS(reg Reg, rm RegMem) {
+	b.add("CMOVNS", "CMOVNS", reg, rm)
+}
+
+// CMOVNZ appends CMOVNZ to the program. See the function CMOVNZ.
+func (b *Builder) CMOVNZ(reg Reg, rm RegMem) {
+	b.add("CMOVNZ", "CMOVNZ", reg, rm)
+}
+
+// CMOVO appends CMOVO to the program. See the function CMOVO.
+func (b *Builder) CMOVO(reg Reg, rm RegMem) {
+	b.add("CMOVO", "CMOVO", reg, rm)
+}
+
+// CMOVP appends CMOVP to the program. See the function CMOVP.
+func (b *Builder) CMOVP(reg Reg, rm RegMem) {
+	b.add("CMOVP", "CMOVP", reg, rm)
+}
+
+// CMOVPE appends CMOVPE to the program. See the function CMOVPE.
+func (b *Builder) CMOVPE(reg Reg, rm RegMem) {
+	b.add("CMOVPE", "CMOVPE", reg, rm)
+}
+
+// CMOVPO appends CMOVPO to the program. See the function CMOVPO.
+func (b *Builder) CMOVPO(reg Reg, rm RegMem) {
+	b.add("CMOVPO", "CMOVPO", reg, rm)
+}
+
+// CMOVS appends CMOVS to the program. See the function CMOVS.
+func (b *Builder) CMOVS(reg Reg, rm RegMem) {
+	b.add("CMOVS", "CMOVS", reg, rm)
+}
+
+// CMOVZ appends CMOVZ to the program. See the function CMOVZ.
+func (b *Builder) CMOVZ(reg Reg, rm RegMem) {
+	b.add("CMOVZ", "CMOVZ", reg, rm)
+}
+
+// CMPPD appends CMPPD to the program. See the function CMPPD.
+func (b *Builder) CMPPD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("CMPPD", "CMPPD", reg, rm, imm)
+}
+
+// CMPPS appends CMPPS to the program. See the function CMPPS.
+func (b *Builder) CMPPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("CMPPS", "CMPPS", reg, rm, imm)
+}
+
+// CMPSB appends CMPSB to the program. See the function CMPSB.
+func (b *Builder) CMPSB() {
+	b.add("CMPSB", "CMPSB")
+}
+
+// CMPSD_NP appends CMPSD to the program. See the function CMPSD_NP.
+func (b *Builder) CMPSD_NP() {
+	b.add("CMPSD", "CMPSD_NP")
+}
+
+// CMPSD_RMI appends CMPSD to the program. See the function CMPSD_RMI.
+func (b *Builder) CMPSD_RMI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("CMPSD", "CMPSD_RMI", reg, rm, imm)
+}
+
+// CMPSQ appends CMPSQ to the program. See the function CMPSQ.
+func (b *Builder) CMPSQ() {
+	b.add("CMPSQ", "CMPSQ")
+}
+
+// CMPSS appends CMPSS to the program. See the function CMPSS.
+func (b *Builder) CMPSS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("CMPSS", "CMPSS", reg, rm, imm)
+}
+
+// CMPSW appends CMPSW to the program. See the function CMPSW.
+func (b *Builder) CMPSW() {
+	b.add("CMPSW", "CMPSW")
+}
+
+// CMPXCHG appends CMPXCHG to the program. See the function CMPXCHG.
+func (b *Builder) CMPXCHG(rm RegMem, reg Reg) {
+	b.add("CMPXCHG", "CMPXCHG", rm, reg)
+}
+
+// CMPXCHG16B appends CMPXCHG16B to the program. See the function CMPXCHG16B.
+func (b *Builder) CMPXCHG16B(rm Mem) {
+	b.add("CMPXCHG16B", "CMPXCHG16B", rm)
+}
+
+// CMPXCHG8B appends CMPXCHG8B to the program. See the function CMPXCHG8B.
+func (b *Builder) CMPXCHG8B(rm Mem) {
+	b.add("CMPXCHG8B", "CMPXCHG8B", rm)
+}
+
+// CMP_I appends CMP to the program. See the function CMP_I.
+func (b *Builder) CMP_I(al Reg, imm Imm) {
+	b.add("CMP", "CMP_I", al, imm)
+}
+
+// CMP_MI appends CMP to the program. See the function CMP_MI.
+func (b *Builder) CMP_MI(rm RegMem, imm Imm) {
+	b.add("CMP", "CMP_MI", rm, imm)
+}
+
+// CMP_MR appends CMP to the program. See the function CMP_MR.
+func (b *Builder) CMP_MR(rm RegMem, reg Reg) {
+	b.add("CMP", "CMP_MR", rm, reg)
+}
+
+// CMP_RM appends CMP to the program. See the function CMP_RM.
+func (b *Builder) CMP_RM(reg Reg, rm RegMem) {
+	b.add("CMP", "CMP_RM", reg, rm)
+}
+
+// COMISD appends COMISD to the program. See the function COMISD.
+func (b *Builder) COMISD(reg VecReg, rm RegMem) {
+	b.add("COMISD", "COMISD", reg, rm)
+}
+
+// COMISS appends COMISS to the program. See the function COMISS.
+func (b *Builder) COMISS(reg VecReg, rm RegMem) {
+	b.add("COMISS", "COMISS", reg, rm)
+}
+
+// CPUID appends CPUID to the program. See the function CPUID.
+func (b *Builder) CPUID() {
+	b.add("CPUID", "CPUID")
+}
+
+// CQO appends CQO to the program. See the function CQO.
+func (b *Builder) CQO() {
+	b.add("CQO", "CQO")
+}
+
+// CRC32 appends CRC32 to the program. See the function CRC32.
+func (b *Builder) CRC32(reg Reg, rm RegMem) {
+	b.add("CRC32", "CRC32", reg, rm)
+}
+
+// CVTDQ2PD appends CVTDQ2PD to the program. See the function CVTDQ2PD.
+func (b *Builder) CVTDQ2PD(reg VecReg, rm RegMem) {
+	b.add("CVTDQ2PD", "CVTDQ2PD", reg, rm)
+}
+
+// CVTDQ2PS appends CVTDQ2PS to the program. See the function CVTDQ2PS.
+func (b *Builder) CVTDQ2PS(reg VecReg, rm RegMem) {
+	b.add("CVTDQ2PS", "CVTDQ2PS", reg, rm)
+}
+
+// CVTPD2DQ appends CVTPD2DQ to the program. See the function CVTPD2DQ.
+func (b *Builder) CVTPD2DQ(reg VecReg, rm RegMem) {
+	b.add("CVTPD2DQ", "CVTPD2DQ", reg, rm)
+}
+
+// CVTPD2PI appends CVTPD2PI to the program. See the function CVTPD2PI.
+func (b *Builder) CVTPD2PI(reg MMXReg, rm RegMem) {
+	b.add("CVTPD2PI", "CVTPD2PI", reg, rm)
+}
+
+// CVTPD2PS appends CVTPD2PS to the program. See the function CVTPD2PS.
+func (b *Builder) CVTPD2PS(reg VecReg, rm RegMem) {
+	b.add("CVTPD2PS", "CVTPD2PS", reg, rm)
+}
+
+// CVTPI2PD appends CVTPI2PD to the program. See the function CVTPI2PD.
+func (b *Builder) CVTPI2PD(reg VecReg, rm RegMem) {
+	b.add("CVTPI2PD", "CVTPI2PD", reg, rm)
+}
+
+// CVTPI2PS appends CVTPI2PS to the program. See the function CVTPI2PS.
+func (b *Builder) CVTPI2PS(reg VecReg, rm RegMem) {
+	b.add("CVTPI2PS", "CVTPI2PS", reg, rm)
+}
+
+// CVTPS2DQ appends CVTPS2DQ to the program. See the function CVTPS2DQ.
+func (b *Builder) CVTPS2DQ(reg VecReg, rm RegMem) {
+	b.add("CVTPS2DQ", "CVTPS2DQ", reg, rm)
+}
+
+// CVTPS2PD appends CVTPS2PD to the program. See the function CVTPS2PD.
+func (b *Builder) CVTPS2PD(reg VecReg, rm RegMem) {
+	b.add("CVTPS2PD", "CVTPS2PD", reg, rm)
+}
+
+// CVTPS2PI appends CVTPS2PI to the program. See the function CVTPS2PI.
+func (b *Builder) CVTPS2PI(reg MMXReg, rm RegMem) {
+	b.add("CVTPS2PI", "CVTPS2PI", reg, rm)
+}
+
+// CVTSD2SI appends CVTSD2SI to the program. See the function CVTSD2SI.
+func (b *Builder) CVTSD2SI(reg Reg, rm RegMem) {
+	b.add("CVTSD2SI", "CVTSD2SI", reg, rm)
+}
+
+// CVTSD2SS appends CVTSD2SS to the program. See the function CVTSD2SS.
+func (b *Builder) CVTSD2SS(reg VecReg, rm RegMem) {
+	b.add("CVTSD2SS", "CVTSD2SS", reg, rm)
+}
+
+// CVTSI2SD appends CVTSI2SD to the program. See the function CVTSI2SD.
+func (b *Builder) CVTSI2SD(reg VecReg, rm RegMem) {
+	b.add("CVTSI2SD", "CVTSI2SD", reg, rm)
+}
+
+// CVTSI2SS appends CVTSI2SS to the program. See the function CVTSI2SS.
+func (b *Builder) CVTSI2SS(reg VecReg, rm RegMem) {
+	b.add("CVTSI2SS", "CVTSI2SS", reg, rm)
+}
+
+// CVTSS2SD appends CVTSS2SD to the program. See the function CVTSS2SD.
+func (b *Builder) CVTSS2SD(reg VecReg, rm RegMem) {
+	b.add("CVTSS2SD", "CVTSS2SD", reg, rm)
+}
+
+// CVTSS2SI appends CVTSS2SI to the program. See the function CVTSS2SI.
+func (b *Builder) CVTSS2SI(reg Reg, rm RegMem) {
+	b.add("CVTSS2SI", "CVTSS2SI", reg, rm)
+}
+
+// CVTTPD2DQ appends CVTTPD2DQ to the program. See the function CVTTPD2DQ.
+func (b *Builder) CVTTPD2DQ(reg VecReg, rm RegMem) {
+	b.add("CVTTPD2DQ", "CVTTPD2DQ", reg, rm)
+}
+
+// CVTTPD2PI appends CVTTPD2PI to the program. See the function CVTTPD2PI.
+func (b *Builder) CVTTPD2PI(reg MMXReg, rm RegMem) {
+	b.add("CVTTPD2PI", "CVTTPD2PI", reg, rm)
+}
+
+// CVTTPS2DQ appends CVTTPS2DQ to the program. See the function CVTTPS2DQ.
+func (b *Builder) CVTTPS2DQ(reg VecReg, rm RegMem) {
+	b.add("CVTTPS2DQ", "CVTTPS2DQ", reg, rm)
+}
+
+// CVTTPS2PI appends CVTTPS2PI to the program. See the function CVTTPS2PI.
+func (b *Builder) CVTTPS2PI(reg MMXReg, rm RegMem) {
+	b.add("CVTTPS2PI", "CVTTPS2PI", reg, rm)
+}
+
+// CVTTSD2SI appends CVTTSD2SI to the program. See the function CVTTSD2SI.
+func (b *Builder) CVTTSD2SI(reg Reg, rm RegMem) {
+	b.add("CVTTSD2SI", "CVTTSD2SI", reg, rm)
+}
+
+// CVTTSS2SI appends CVTTSS2SI to the program. See the function CVTTSS2SI.
+func (b *Builder) CVTTSS2SI(reg Reg, rm RegMem) {
+	b.add("CVTTSS2SI", "CVTTSS2SI", reg, rm)
+}
+
+// CWD appends CWD to the program. See the function CWD.
+func (b *Builder) CWD() {
+	b.add("CWD", "CWD")
+}
+
+// CWDE appends CWDE to the program. See the function CWDE.
+func (b *Builder) CWDE() {
+	b.add("CWDE", "CWDE")
+}
+
+// DAA appends DAA to the program. See the function DAA.
+func (b *Builder) DAA() {
+	b.add("DAA", "DAA")
+}
+
+// DAS appends DAS to the program. See the function DAS.
+func (b *Builder) DAS() {
+	b.add("DAS", "DAS")
+}
+
+// DEC_M appends DEC to the program. See the function DEC_M.
+func (b *Builder) DEC_M(rm RegMem) {
+	b.add("DEC", "DEC_M", rm)
+}
+
+// DEC_O appends DEC to the program. See the function DEC_O.
+func (b *Builder) DEC_O(opcode Reg) {
+	b.add("DEC", "DEC_O", opcode)
+}
+
+// DIV appends DIV to the program. See the function DIV.
+func (b *Builder) DIV(rm RegMem) {
+	b.add("DIV", "DIV", rm)
+}
+
+// DIVPD appends DIVPD to the program. See the function DIVPD.
+func (b *Builder) DIVPD(reg VecReg, rm RegMem) {
+	b.add("DIVPD", "DIVPD", reg, rm)
+}
+
+// DIVPS appends DIVPS to the program. See the function DIVPS.
+func (b *Builder) DIVPS(reg VecReg, rm RegMem) {
+	b.add("DIVPS", "DIVPS", reg, rm)
+}
+
+// DIVSD appends DIVSD to the program. See the function DIVSD.
+func (b *Builder) DIVSD(reg VecReg, rm RegMem) {
+	b.add("DIVSD", "DIVSD", reg, rm)
+}
+
+// DIVSS appends DIVSS to the program. See the function DIVSS.
+func (b *Builder) DIVSS(reg VecReg, rm RegMem) {
+	b.add("DIVSS", "DIVSS", reg, rm)
+}
+
+// DPPD appends DPPD to the program. See the function DPPD.
+func (b *Builder) DPPD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("DPPD", "DPPD", reg, rm, imm)
+}
+
+// DPPS appends DPPS to the program. See the function DPPS.
+func (b *Builder) DPPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("DPPS", "DPPS", reg, rm, imm)
+}
+
+// EMMS appends EMMS to the program. See the function EMMS.
+func (b *Builder) EMMS() {
+	b.add("EMMS", "EMMS")
+}
+
+// ENTER appends ENTER to the program. See the function ENTER.
+func (b *Builder) ENTER(imm, arg Imm) {
+	b.add("ENTER", "ENTER", imm, arg)
+}
+
+// EXTRACTPS appends EXTRACTPS to the program. See the function EXTRACTPS.
+func (b *Builder) EXTRACTPS(rm RegMem, reg VecReg, imm Imm) {
+	b.add("EXTRACTPS", "EXTRACTPS", rm, reg, imm)
+}
+
+// F2XM1 appends F2XM1 to the program. See the function F2XM1.
+func (b *Builder) F2XM1() {
+	b.add("F2XM1", "F2XM1")
+}
+
+// FABS appends FABS to the program. See the function FABS.
+func (b *Builder) FABS() {
+	b.add("FABS", "FABS")
+}
+
+// FADD appends FADD to the program. See the function FADD.
+func (b *Builder) FADD(arg, arg2 X87Reg) {
+	b.add("FADD", "FADD", arg, arg2)
+}
+
+// FADDP appends FADDP to the program. See the function FADDP.
+func (b *Builder) FADDP() {
+	b.add("FADDP", "FADDP")
+}
+
+// FADDP_O appends FADDP to the program. See the function FADDP_O.
+func (b *Builder) FADDP_O(opcode, st0 X87Reg) {
+	b.add("FADDP", "FADDP_O", opcode, st0)
+}
+
+// FADD_M appends FADD to the program. See the function FADD_M.
+func (b *Builder) FADD_M(rm Mem) {
+	b.add("FADD", "FADD_M", rm)
+}
+
+// FBLD appends FBLD to the program. See the function FBLD.
+func (b *Builder) FBLD(rm Mem) {
+	b.add("FBLD", "FBLD", rm)
+}
+
+// FBSTP appends FBSTP to the program. See the function FBSTP.
+func (b *Builder) FBSTP(rm Mem) {
+	b.add("FBSTP", "FBSTP", rm)
+}
+
+// FCHS appends FCHS to the program. See the function FCHS.
+func (b *Builder) FCHS() {
+	b.add("FCHS", "FCHS")
+}
+
+// FCLEX appends FCLEX to the program. See the function FCLEX.
+func (b *Builder) FCLEX() {
+	b.add("FCLEX", "FCLEX")
+}
+
+// FCMOVB appends FCMOVB to the program. See the function FCMOVB.
+func (b *Builder) FCMOVB(st0, opcode X87Reg) {
+	b.add("FCMOVB", "FCMOVB", st0, opcode)
+}
+
+// FCMOVBE appends FCMOVBE to the program. See the function FCMOVBE.
+func (b *Builder) FCMOVBE(st0, opcode X87Reg) {
+	b.add("FCMOVBE", "FCMOVBE", st0, opcode)
+}
+
+// FCMOVE appends FCMOVE to the program. See the function FCMOVE.
+func (b *Builder) FCMOVE(st0, opcode X87Reg) {
+	b.add("FCMOVE", "FCMOVE", st0, opcode)
+}
+
+// FCMOVNB appends FCMOVNB to the program. See the function FCMOVNB.
+func (b *Builder) FCMOVNB(st0, opcode X87Reg) {
+	b.add("FCMOVNB", "FCMOVNB", st0, opcode)
+}
+
+// FCMOVNBE appends FCMOVNBE to the program. See the function FCMOVNBE.
+func (b *Builder) FCMOVNBE(st0, opcode X87Reg) {
+	b.add("FCMOVNBE", "FCMOVNBE", st0, opcode)
+}
+
+// FCMOVNE appends FCMOVNE to the program. See the function FCMOVNE.
+func (b *Builder) FCMOVNE(st0, opcode X87Reg) {
+	b.add("FCMOVNE", "FCMOVNE", st0, opcode)
+}
+
+// FCMOVNU appends FCMOVNU to the program. See the function FCMOVNU.
+func (b *Builder) FCMOVNU(st0, opcode X87Reg) {
+	b.add("FCMOVNU", "FCMOVNU", st0, opcode)
+}
+
+// FCMOVU appends FCMOVU to the program. See the function FCMOVU.
+func (b *Builder) FCMOVU(st0, opcode X87Reg) {
+	b.add("FCMOVU", "FCMOVU", st0, opcode)
+}
+
+// FCOM appends FCOM to the program. See the function FCOM.
+func (b *Builder) FCOM() {
+	b.add("FCOM", "FCOM")
+}
+
+// FCOMI appends FCOMI to the program. See the function FCOMI.
+func (b *Builder) FCOMI(st0, opcode X87Reg) {
+	b.add("FCOMI", "FCOMI", st0, opcode)
+}
+
+// FCOMIP appends FCOMIP to the program. See the function FCOMIP.
+func (b *Builder) FCOMIP(st0, opcode X87Reg) {
+	b.add("FCOMIP", "FCOMIP", st0, opcode)
+}
+
+// FCOMP appends FCOMP to the program. See the function FCOMP.
+func (b *Builder) FCOMP() {
+	b.add("FCOMP", "FCOMP")
+}
+
+// FCOMPP appends FCOMPP to the program. See the function FCOMPP.
+func (b *Builder) FCOMPP() {
+	b.add("FCOMPP", "FCOMPP")
+}
+
+// FCOMP_M appends FCOMP to the program. See the function FCOMP_M.
+func (b *Builder) FCOMP_M(rm Mem) {
+	b.add("FCOMP", "FCOMP_M", rm)
+}
+
+// FCOMP_O appends FCOMP to the program. See the function FCOMP_O.
+func (b *Builder) FCOMP_O(opcode X87Reg) {
+	b.add("FCOMP", "FCOMP_O", opcode)
+}
+
+// FCOM_M appends FCOM to the program. See the function FCOM_M.
+func (b *Builder) FCOM_M(rm Mem) {
+	b.add("FCOM", "FCOM_M", rm)
+}
+
+// FCOM_O appends FCOM to the program. See the function FCOM_O.
+func (b *Builder) FCOM_O(opcode X87Reg) {
+	b.add("FCOM", "FCOM_O", opcode)
+}
+
+// FCOS appends FCOS to the program. See the function FCOS.
+func (b *Builder) FCOS() {
+	b.add("FCOS", "FCOS")
+}
+
+// FDECSTP appends FDECSTP to the program. See the function FDECSTP.
+func (b *Builder) FDECSTP() {
+	b.add("FDECSTP", "FDECSTP")
+}
+
+// FDIV appends FDIV to the program. See the function FDIV.
+func (b *Builder) FDIV(arg, arg2 X87Reg) {
+	b.add("FDIV", "FDIV", arg, arg2)
+}
+
+// FDIVP appends FDIVP to the program. See the function FDIVP.
+func (b *Builder) FDIVP() {
+	b.add("FDIVP", "FDIVP")
+}
+
+// FDIVP_O appends FDIVP to the program. See the function FDIVP_O.
+func (b *Builder) FDIVP_O(opcode, st0 X87Reg) {
+	b.add("FDIVP", "FDIVP_O", opcode, st0)
+}
+
+// FDIVR appends FDIVR to the program. See the function FDIVR.
+func (b *Builder) FDIVR(arg, arg2 X87Reg) {
+	b.add("FDIVR", "FDIVR", arg, arg2)
+}
+
+// FDIVRP appends FDIVRP to the program. See the function FDIVRP.
+func (b *Builder) FDIVRP() {
+	b.add("FDIVRP", "FDIVRP")
+}
+
+// FDIVRP_O appends FDIVRP to the program. See the function FDIVRP_O.
+func (b *Builder) FDIVRP_O(opcode, st0 X87Reg) {
+	b.add("FDIVRP", "FDIVRP_O", opcode, st0)
+}
+
+// FDIVR_M appends FDIVR to the program. See the function FDIVR_M.
+func (b *Builder) FDIVR_M(rm Mem) {
+	b.add("FDIVR", "FDIVR_M", rm)
+}
+
+// FDIV_M appends FDIV to the program. See the function FDIV_M.
+func (b *Builder) FDIV_M(rm Mem) {
+	b.add("FDIV", "FDIV_M", rm)
+}
+
+// FFREE appends FFREE to the program. See the function FFREE.
+func (b *Builder) FFREE(opcode X87Reg) {
+	b.add("FFREE", "FFREE", opcode)
+}
+
+// FFREEP appends FFREEP to the program. See the function FFREEP.
+func (b *Builder) FFREEP(opcode X87Reg) {
+	b.add("FFREEP", "FFREEP", opcode)
+}
+
+// FIADD appends FIADD to the program. See the function FIADD.
+func (b *Builder) FIADD(rm Mem) {
+	b.add("FIADD", "FIADD", rm)
+}
+
+// FICOM appends FICOM to the program. See the function FICOM.
+func (b *Builder) FICOM(rm Mem) {
+	b.add("FICOM", "FICOM", rm)
+}
+
+// FICOMP appends FICOMP to the program. See the function FICOMP.
+func (b *Builder) FICOMP(rm Mem) {
+	b.add("FICOMP", "FICOMP", rm)
+}
+
+// FIDIV appends FIDIV to the program. See the function FIDIV.
+func (b *Builder) FIDIV(rm Mem) {
+	b.add("FIDIV", "FIDIV", rm)
+}
+
+// FIDIVR appends FIDIVR to the program. See the function FIDIVR.
+func (b *Builder) FIDIVR(rm Mem) {
+	b.add("FIDIVR", "FIDIVR", rm)
+}
+
+// FILD appends FILD to the program. See the function FILD.
+func (b *Builder) FILD(rm Mem) {
+	b.add("FILD", "FILD", rm)
+}
+
+// FIMUL appends FIMUL to the program. See the function FIMUL.
+func (b *Builder) FIMUL(rm Mem) {
+	b.add("FIMUL", "FIMUL", rm)
+}
+
+// FINCSTP appends FINCSTP to the program. See the function FINCSTP.
+func (b *Builder) FINCSTP() {
+	b.add("FINCSTP", "FINCSTP")
+}
+
+// FINIT appends FINIT to the program. See the function FINIT.
+func (b *Builder) FINIT() {
+	b.add("FINIT", "FINIT")
+}
+
+// FIST appends FIST to the program. See the function FIST.
+func (b *Builder) FIST(rm Mem) {
+	b.add("FIST", "FIST", rm)
+}
+
+// FISTP appends FISTP to the program. See the function FISTP.
+func (b *Builder) FISTP(rm Mem) {
+	b.add("FISTP", "FISTP", rm)
+}
+
+// FISTTP appends FISTTP to the program. See the function FISTTP.
+func (b *Builder) FISTTP(rm Mem) {
+	b.add("FISTTP", "FISTTP", rm)
+}
+
+// FISUB appends FISUB to the program. See the function FISUB.
+func (b *Builder) FISUB(rm Mem) {
+	b.add("FISUB", "FISUB", rm)
+}
+
+// FISUBR appends FISUBR to the program. See the function FISUBR.
+func (b *Builder) FISUBR(rm Mem) {
+	b.add("FISUBR", "FISUBR", rm)
+}
+
+// FLD appends FLD to the program. See the function FLD.
+func (b *Builder) FLD(arg Operand) {
+	b.add("FLD", "FLD", arg)
+}
+
+// FLD1 appends FLD1 to the program. See the function FLD1.
+func (b *Builder) FLD1() {
+	b.add("FLD1", "FLD1")
+}
+
+// FLDCW appends FLDCW to the program. See the function FLDCW.
+func (b *Builder) FLDCW(rm Mem) {
+	b.add("FLDCW", "FLDCW", rm)
+}
+
+// FLDENV appends FLDENV to the program. See the function FLDENV.
+func (b *Builder) FLDENV(rm Mem) {
+	b.add("FLDENV", "FLDENV", rm)
+}
+
+// FLDL2E appends FLDL2E to the program. See the function FLDL2E.
+func (b *Builder) FLDL2E() {
+	b.add("FLDL2E", "FLDL2E")
+}
+
+// FLDL2T appends FLDL2T to the program. See the function FLDL2T.
+func (b *Builder) FLDL2T() {
+	b.add("FLDL2T", "FLDL2T")
+}
+
+// FLDLG2 appends FLDLG2 to the program. See the function FLDLG2.
+func (b *Builder) FLDLG2() {
+	b.add("FLDLG2", "FLDLG2")
+}
+
+// FLDPI appends FLDPI to the program. See the function FLDPI.
+func (b *Builder) FLDPI() {
+	b.add("FLDPI", "FLDPI")
+}
+
+// FMUL appends FMUL to the program. See the function FMUL.
+func (b *Builder) FMUL(arg, arg2 X87Reg) {
+	b.add("FMUL", "FMUL", arg, arg2)
+}
+
+// FMULP appends FMULP to the program. See the function FMULP.
+func (b *Builder) FMULP() {
+	b.add("FMULP", "FMULP")
+}
+
+// FMULP_O appends FMULP to the program. See the function FMULP_O.
+func (b *Builder) FMULP_O(opcode, st0 X87Reg) {
+	b.add("FMULP", "FMULP_O", opcode, st0)
+}
+
+// FMUL_M appends FMUL to the program. See the function FMUL_M.
+func (b *Builder) FMUL_M(rm Mem) {
+	b.add("FMUL", "FMUL_M", rm)
+}
+
+// FNCLEX appends FNCLEX to the program. See the function FNCLEX.
+func (b *Builder) FNCLEX() {
+	b.add("FNCLEX", "FNCLEX")
+}
+
+// FNINIT appends FNINIT to the program. See the function FNINIT.
+func (b *Builder) FNINIT() {
+	b.add("FNINIT", "FNINIT")
+}
+
+// FNOP appends FNOP to the program. See the function FNOP.
+func (b *Builder) FNOP() {
+	b.add("FNOP", "FNOP")
+}
+
+// FNSAVE appends FNSAVE to the program. See the function FNSAVE.
+func (b *Builder) FNSAVE(rm Mem) {
+	b.add("FNSAVE", "FNSAVE", rm)
+}
+
+// FNSTCW appends FNSTCW to the program. See the function FNSTCW.
+func (b *Builder) FNSTCW(rm Mem) {
+	b.add("FNSTCW", "FNSTCW", rm)
+}
+
+// FNSTENV appends FNSTENV to the program. See the function FNSTENV.
+func (b *Builder) FNSTENV(rm Mem) {
+	b.add("FNSTENV", "FNSTENV", rm)
+}
+
+// FNSTSW appends FNSTSW to the program. See the function FNSTSW.
+func (b *Builder) FNSTSW(arg RegMem) {
+	b.add("FNSTSW", "FNSTSW", arg)
+}
+
+// FPATAN appends FPATAN to the program. See the function FPATAN.
+func (b *Builder) FPATAN() {
+	b.add("FPATAN", "FPATAN")
+}
+
+// FPREM appends FPREM to the program. See the function FPREM.
+func (b *Builder) FPREM() {
+	b.add("FPREM", "FPREM")
+}
+
+// FPREM1 appends FPREM1 to the program. See the function FPREM1.
+func (b *Builder) FPREM1() {
+	b.add("FPREM1", "FPREM1")
+}
+
+// FPTAN appends FPTAN to the program. See the function FPTAN.
+func (b *Builder) FPTAN() {
+	b.add("FPTAN", "FPTAN")
+}
+
+// FRNDINT appends FRNDINT to the program. See the function FRNDINT.
+func (b *Builder) FRNDINT() {
+	b.add("FRNDINT", "FRNDINT")
+}
+
+// FRSTOR appends FRSTOR to the program. See the function FRSTOR.
+func (b *Builder) FRSTOR(rm Mem) {
+	b.add("FRSTOR", "FRSTOR", rm)
+}
+
+// FSAVE appends FSAVE to the program. See the function FSAVE.
+func (b *Builder) FSAVE(rm Mem) {
+	b.add("FSAVE", "FSAVE", rm)
+}
+
+// FSCALE appends FSCALE to the program. See the function FSCALE.
+func (b *Builder) FSCALE() {
+	b.add("FSCALE", "FSCALE")
+}
+
+// FSIN appends FSIN to the program. See the function FSIN.
+func (b *Builder) FSIN() {
+	b.add("FSIN", "FSIN")
+}
+
+// FSINCOS appends FSINCOS to the program. See the function FSINCOS.
+func (b *Builder) FSINCOS() {
+	b.add("FSINCOS", "FSINCOS")
+}
+
+// FSQRT appends FSQRT to the program. See the function FSQRT.
+func (b *Builder) FSQRT() {
+	b.add("FSQRT", "FSQRT")
+}
+
+// FST appends FST to the program. See the function FST.
+func (b *Builder) FST(arg Operand) {
+	b.add("FST", "FST", arg)
+}
+
+// FSTCW appends FSTCW to the program. See the function FSTCW.
+func (b *Builder) FSTCW(rm Mem) {
+	b.add("FSTCW", "FSTCW", rm)
+}
+
+// FSTENV appends FSTENV to the program. See the function FSTENV.
+func (b *Builder) FSTENV(rm Mem) {
+	b.add("FSTENV", "FSTENV", rm)
+}
+
+// FSTP appends FSTP to the program. See the function FSTP.
+func (b *Builder) FSTP(arg Operand) {
+	b.add("FSTP", "FSTP", arg)
+}
+
+// FSTSW appends FSTSW to the program. See the function FSTSW.
+func (b *Builder) FSTSW(arg RegMem) {
+	b.add("FSTSW", "FSTSW", arg)
+}
+
+// FSUB appends FSUB to the program. See the function FSUB.
+func (b *Builder) FSUB(arg, arg2 X87Reg) {
+	b.add("FSUB", "FSUB", arg, arg2)
+}
+
+// FSUBP appends FSUBP to the program. See the function FSUBP.
+func (b *Builder) FSUBP() {
+	b.add("FSUBP", "FSUBP")
+}
+
+// FSUBP_O appends FSUBP to the program. See the function FSUBP_O.
+func (b *Builder) FSUBP_O(opcode, st0 X87Reg) {
+	b.add("FSUBP", "FSUBP_O", opcode, st0)
+}
+
+// FSUBR appends FSUBR to the program. See the function FSUBR.
+func (b *Builder) FSUBR(arg, arg2 X87Reg) {
+	b.add("FSUBR", "FSUBR", arg, arg2)
+}
+
+// FSUBRP appends FSUBRP to the program. See the function FSUBRP.
+func (b *Builder) FSUBRP() {
+	b.add("FSUBRP", "FSUBRP")
+}
+
+// FSUBRP_O appends FSUBRP to the program. See the function FSUBRP_O.
+func (b *Builder) FSUBRP_O(opcode, st0 X87Reg) {
+	b.add("FSUBRP", "FSUBRP_O", opcode, st0)
+}
+
+// FSUBR_M appends FSUBR to the program. See the function FSUBR_M.
+func (b *Builder) FSUBR_M(rm Mem) {
+	b.add("FSUBR", "FSUBR_M", rm)
+}
+
+// FSUB_M appends FSUB to the program. See the function FSUB_M.
+func (b *Builder) FSUB_M(rm Mem) {
+	b.add("FSUB", "FSUB_M", rm)
+}
+
+// FTST appends FTST to the program. See the function FTST.
+func (b *Builder) FTST() {
+	b.add("FTST", "FTST")
+}
+
+// FUCOM appends FUCOM to the program. See the function FUCOM.
+func (b *Builder) FUCOM() {
+	b.add("FUCOM", "FUCOM")
+}
+
+// FUCOMI appends FUCOMI to the program. See the function FUCOMI.
+func (b *Builder) FUCOMI(st0, opcode X87Reg) {
+	b.add("FUCOMI", "FUCOMI", st0, opcode)
+}
+
+// FUCOMIP appends FUCOMIP to the program. See the function FUCOMIP.
+func (b *Builder) FUCOMIP(st0, opcode X87Reg) {
+	b.add("FUCOMIP", "FUCOMIP", st0, opcode)
+}
+
+// FUCOMP appends FUCOMP to the program. See the function FUCOMP.
+func (b *Builder) FUCOMP() {
+	b.add("FUCOMP", "FUCOMP")
+}
+
+// FUCOMPP appends FUCOMPP to the program. See the function FUCOMPP.
+func (b *Builder) FUCOMPP() {
+	b.add("FUCOMPP", "FUCOMPP")
+}
+
+// FUCOMP_O appends FUCOMP to the program. See the function FUCOMP_O.
+func (b *Builder) FUCOMP_O(opcode X87Reg) {
+	b.add("FUCOMP", "FUCOMP_O", opcode)
+}
+
+// FUCOM_O appends FUCOM to the program. See the function FUCOM_O.
+func (b *Builder) FUCOM_O(opcode X87Reg) {
+	b.add("FUCOM", "FUCOM_O", opcode)
+}
+
+// FWAIT appends FWAIT to the program. See the function FWAIT.
+func (b *Builder) FWAIT() {
+	b.add("FWAIT", "FWAIT")
+}
+
+// FXAM appends FXAM to the program. See the function FXAM.
+func (b *Builder) FXAM() {
+	b.add("FXAM", "FXAM")
+}
+
+// FXCH appends FXCH to the program. See the function FXCH.
+func (b *Builder) FXCH() {
+	b.add("FXCH", "FXCH")
+}
+
+// FXCH_O appends FXCH to the program. See the function FXCH_O.
+func (b *Builder) FXCH_O(opcode X87Reg) {
+	b.add("FXCH", "FXCH_O", opcode)
+}
+
+// FXRSTOR appends FXRSTOR to the program. See the function FXRSTOR.
+func (b *Builder) FXRSTOR(rm Mem) {
+	b.add("FXRSTOR", "FXRSTOR", rm)
+}
+
+// FXRSTOR64 appends FXRSTOR64 to the program. See the function FXRSTOR64.
+func (b *Builder) FXRSTOR64(rm Mem) {
+	b.add("FXRSTOR64", "FXRSTOR64", rm)
+}
+
+// FXSAVE appends FXSAVE to the program. See the function FXSAVE.
+func (b *Builder) FXSAVE(rm Mem) {
+	b.add("FXSAVE", "FXSAVE", rm)
+}
+
+// FXSAVE64 appends FXSAVE64 to the program. See the function FXSAVE64.
+func (b *Builder) FXSAVE64(rm Mem) {
+	b.add("FXSAVE64", "FXSAVE64", rm)
+}
+
+// FXTRACT appends FXTRACT to the program. See the function FXTRACT.
+func (b *Builder) FXTRACT() {
+	b.add("FXTRACT", "FXTRACT")
+}
+
+// FYL2X appends FYL2X to the program. See the function FYL2X.
+func (b *Builder) FYL2X() {
+	b.add("FYL2X", "FYL2X")
+}
+
+// FYL2XP1 appends FYL2XP1 to the program. See the function FYL2XP1.
+func (b *Builder) FYL2XP1() {
+	b.add("FYL2XP1", "FYL2XP1")
+}
+
+// HADDPD appends HADDPD to the program. See the function HADDPD.
+func (b *Builder) HADDPD(reg VecReg, rm RegMem) {
+	b.add("HADDPD", "HADDPD", reg, rm)
+}
+
+// HADDPS appends HADDPS to the program. See the function HADDPS.
+func (b *Builder) HADDPS(reg VecReg, rm RegMem) {
+	b.add("HADDPS", "HADDPS", reg, rm)
+}
+
+// HLT appends HLT to the program. See the function HLT.
+func (b *Builder) HLT() {
+	b.add("HLT", "HLT")
+}
+
+// HSUBPD appends HSUBPD to the program. See the function HSUBPD.
+func (b *Builder) HSUBPD(reg VecReg, rm RegMem) {
+	b.add("HSUBPD", "HSUBPD", reg, rm)
+}
+
+// HSUBPS appends HSUBPS to the program. See the function HSUBPS.
+func (b *Builder) HSUBPS(reg VecReg, rm RegMem) {
+	b.add("HSUBPS", "HSUBPS", reg, rm)
+}
+
+// ICEBP appends ICEBP to the program. See the function ICEBP.
+func (b *Builder) ICEBP() {
+	b.add("ICEBP", "ICEBP")
+}
+
+// IDIV appends IDIV to the program. See the function IDIV.
+func (b *Builder) IDIV(rm RegMem) {
+	b.add("IDIV", "IDIV", rm)
+}
+
+// IMUL_M appends IMUL to the program. See the function IMUL_M.
+func (b *Builder) IMUL_M(rm RegMem) {
+	b.add("IMUL", "IMUL_M", rm)
+}
+
+// IMUL_RM appends IMUL to the program. See the function IMUL_RM.
+func (b *Builder) IMUL_RM(reg Reg, rm RegMem) {
+	b.add("IMUL", "IMUL_RM", reg, rm)
+}
+
+// IMUL_RMI appends IMUL to the program. See the function IMUL_RMI.
+func (b *Builder) IMUL_RMI(reg Reg, rm RegMem, imm Imm) {
+	b.add("IMUL", "IMUL_RMI", reg, rm, imm)
+}
+
+// INC_M appends INC to the program. See the function INC_M.
+func (b *Builder) INC_M(rm RegMem) {
+	b.add("INC", "INC_M", rm)
+}
+
+// INC_O appends INC to the program. See the function INC_O.
+func (b *Builder) INC_O(opcode Reg) {
+	b.add("INC", "INC_O", opcode)
+}
+
+// INSB appends INSB to the program. See the function INSB.
+func (b *Builder) INSB() {
+	b.add("INSB", "INSB")
+}
+
+// INSD appends INSD to the program. See the function INSD.
+func (b *Builder) INSD() {
+	b.add("INSD", "INSD")
+}
+
+// INSERTPS appends INSERTPS to the program. See the function INSERTPS.
+func (b *Builder) INSERTPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("INSERTPS", "INSERTPS", reg, rm, imm)
+}
+
+// INSW appends INSW to the program. See the function INSW.
+func (b *Builder) INSW() {
+	b.add("INSW", "INSW")
+}
+
+// INTO appends INTO to the program. See the function INTO.
+func (b *Builder) INTO() {
+	b.add("INTO", "INTO")
+}
+
+// INT_I appends INT to the program. See the function INT_I.
+func (b *Builder) INT_I(imm Imm) {
+	b.add("INT", "INT_I", imm)
+}
+
+// INT_NP appends INT to the program. See the function INT_NP.
+func (b *Builder) INT_NP(v3 Imm) {
+	b.add("INT", "INT_NP", v3)
+}
+
+// INVD appends INVD to the program. See the function INVD.
+func (b *Builder) INVD() {
+	b.add("INVD", "INVD")
+}
+
+// INVLPG appends INVLPG to the program. See the function INVLPG.
+func (b *Builder) INVLPG(rm Mem) {
+	b.add("INVLPG", "INVLPG", rm)
+}
+
+// INVPCID appends INVPCID to the program. See the function INVPCID.
+func (b *Builder) INVPCID(reg Reg, rm Mem) {
+	b.add("INVPCID", "INVPCID", reg, rm)
+}
+
+// IN_I appends IN to the program. See the function IN_I.
+func (b *Builder) IN_I(al Reg, imm Imm) {
+	b.add("IN", "IN_I", al, imm)
+}
+
+// IN_NP appends IN to the program. See the function IN_NP.
+func (b *Builder) IN_NP(al, dx Reg) {
+	b.add("IN", "IN_NP", al, dx)
+}
+
+// IRET appends IRET to the program. See the function IRET.
+func (b *Builder) IRET() {
+	b.add("IRET", "IRET")
+}
+
+// IRETD appends IRETD to the program. See the function IRETD.
+func (b *Builder) IRETD() {
+	b.add("IRETD", "IRETD")
+}
+
+// IRETQ appends IRETQ to the program. See the function IRETQ.
+func (b *Builder) IRETQ() {
+	b.add("IRETQ", "IRETQ")
+}
+
+// JA appends JA to the program. See the function JA.
+func (b *Builder) JA(rel Target) {
+	b.add("JA", "JA", rel)
+}
+
+// JAE appends JAE to the program. See the function JAE.
+func (b *Builder) JAE(rel Target) {
+	b.add("JAE", "JAE", rel)
+}
+
+// JB appends JB to the program. See the function JB.
+func (b *Builder) JB(rel Target) {
+	b.add("JB", "JB", rel)
+}
+
+// JBE appends JBE to the program. See the function JBE.
+func (b *Builder) JBE(rel Target) {
+	b.add("JBE", "JBE", rel)
+}
+
+// JC appends JC to the program. See the function JC.
+func (b *Builder) JC(rel Target) {
+	b.add("JC", "JC", rel)
+}
+
+// JCXZ appends JCXZ to the program. See the function JCXZ.
+func (b *Builder) JCXZ(rel Target) {
+	b.add("JCXZ", "JCXZ", rel)
+}
+
+// JE appends JE to the program. See the function JE.
+func (b *Builder) JE(rel Target) {
+	b.add("JE", "JE", rel)
+}
+
+// JECXZ appends JECXZ to the program. See the function JECXZ.
+func (b *Builder) JECXZ(rel Target) {
+	b.add("JECXZ", "JECXZ", rel)
+}
+
+// JG appends JG to the program. See the function JG.
+func (b *Builder) JG(rel Target) {
+	b.add("JG", "JG", rel)
+}
+
+// JGE appends JGE to the program. See the function JGE.
+func (b *Builder) JGE(rel Target) {
+	b.add("JGE", "JGE", rel)
+}
+
+// JL appends JL to the program. See the function JL.
+func (b *Builder) JL(rel Target) {
+	b.add("JL", "JL", rel)
+}
+
+// JLE appends JLE to the program. See the function JLE.
+func (b *Builder) JLE(rel Target) {
+	b.add("JLE", "JLE", rel)
+}
+
+// JMP_D appends JMP to the program. See the function JMP_D.
+func (b *Builder) JMP_D(rel Target) {
+	b.add("JMP", "JMP_D", rel)
+}
+
+// JMP_FAR_I appends JMP_FAR to the program. See the function JMP_FAR_I.
+func (b *Builder) JMP_FAR_I(imm Imm) {
+	b.add("JMP_FAR", "JMP_FAR_I", imm)
+}
+
+// JMP_FAR_M appends JMP_FAR to the program. See the function JMP_FAR_M.
+func (b *Builder) JMP_FAR_M(rm Mem) {
+	b.add("JMP_FAR", "JMP_FAR_M", rm)
+}
+
+// JMP_M appends JMP to the program. See the function JMP_M.
+func (b *Builder) JMP_M(rm RegMem) {
+	b.add("JMP", "JMP_M", rm)
+}
+
+// JNA appends JNA to the program. See the function JNA.
+func (b *Builder) JNA(rel Target) {
+	b.add("JNA", "JNA", rel)
+}
+
+// JNAE appends JNAE to the program. See the function JNAE.
+func (b *Builder) JNAE(rel Target) {
+	b.add("JNAE", "JNAE", rel)
+}
+
+// JNB appends JNB to the program. See the function JNB.
+func (b *Builder) JNB(rel Target) {
+	b.add("JNB", "JNB", rel)
+}
+
+// JNBE appends JNBE to the program. See the function JNBE.
+func (b *Builder) JNBE(rel Target) {
+	b.add("JNBE", "JNBE", rel)
+}
+
+// JNC appends JNC to the program. See the function JNC.
+func (b *Builder) JNC(rel Target) {
+	b.add("JNC", "JNC", rel)
+}
+
+// JNE appends JNE to the program. See the function JNE.
+func (b *Builder) JNE(rel Target) {
+	b.add("JNE", "JNE", rel)
+}
+
+// JNG appends JNG to the program. See the function JNG.
+func (b *Builder) JNG(rel Target) {
+	b.add("JNG", "JNG", rel)
+}
+
+// JNGE appends JNGE to the program. See the function JNGE.
+func (b *Builder) JNGE(rel Target) {
+	b.add("JNGE", "JNGE", rel)
+}
+
+// JNL appends JNL to the program. See the function JNL.
+func (b *Builder) JNL(rel Target) {
+	b.add("JNL", "JNL", rel)
+}
+
+// JNLE appends JNLE to the program. See the function JNLE.
+func (b *Builder) JNLE(rel Target) {
+	b.add("JNLE", "JNLE", rel)
+}
+
+// JNO appends JNO to the program. See the function JNO.
+func (b *Builder) JNO(rel Target) {
+	b.add("JNO", "JNO", rel)
+}
+
+// JNP appends JNP to the program. See the function JNP.
+func (b *Builder) JNP(rel Target) {
+	b.add("JNP", "JNP", rel)
+}
+
+// JNS appends JNS to the program. See the function JNS.
+func (b *Builder) JNS(rel Target) {
+	b.add("JNS", "JNS", rel)
+}
+
+// JNZ appends JNZ to the program. See the function JNZ.
+func (b *Builder) JNZ(rel Target) {
+	b.add("JNZ", "JNZ", rel)
+}
+
+// JO appends JO to the program. See the function JO.
+func (b *Builder) JO(rel Target) {
+	b.add("JO", "JO", rel)
+}
+
+// JP appends JP to the program. See the function JP.
+func (b *Builder) JP(rel Target) {
+	b.add("JP", "JP", rel)
+}
+
+// JPE appends JPE to the program. See the function JPE.
+func (b *Builder) JPE(rel Target) {
+	b.add("JPE", "JPE", rel)
+}
+
+// JPO appends JPO to the program. See the function JPO.
+func (b *Builder) JPO(rel Target) {
+	b.add("JPO", "JPO", rel)
+}
+
+// JRCXZ appends JRCXZ to the program. See the function JRCXZ.
+func (b *Builder) JRCXZ(rel Target) {
+	b.add("JRCXZ", "JRCXZ", rel)
+}
+
+// JS appends JS to the program. See the function JS.
+func (b *Builder) JS(rel Target) {
+	b.add("JS", "JS", rel)
+}
+
+// JZ appends JZ to the program. See the function JZ.
+func (b *Builder) JZ(rel Target) {
+	b.add("JZ", "JZ", rel)
+}
+
+// KADDB appends KADDB to the program. See the function KADDB.
+func (b *Builder) KADDB(reg, vvvv, rm MaskReg) {
+	b.add("KADDB", "KADDB", reg, vvvv, rm)
+}
+
+// KADDD appends KADDD to the program. See the function KADDD.
+func (b *Builder) KADDD(reg, vvvv, rm MaskReg) {
+	b.add("KADDD", "KADDD", reg, vvvv, rm)
+}
+
+// KADDQ appends KADDQ to the program. See the function KADDQ.
+func (b *Builder) KADDQ(reg, vvvv, rm MaskReg) {
+	b.add("KADDQ", "KADDQ", reg, vvvv, rm)
+}
+
+// KADDW appends KADDW to the program. See the function KADDW.
+func (b *Builder) KADDW(reg, vvvv, rm MaskReg) {
+	b.add("KADDW", "KADDW", reg, vvvv, rm)
+}
+
+// KANDB appends KANDB to the program. See the function KANDB.
+func (b *Builder) KANDB(reg, vvvv, rm MaskReg) {
+	b.add("KANDB", "KANDB", reg, vvvv, rm)
+}
+
+// KANDD appends KANDD to the program. See the function KANDD.
+func (b *Builder) KANDD(reg, vvvv, rm MaskReg) {
+	b.add("KANDD", "KANDD", reg, vvvv, rm)
+}
+
+// KANDNB appends KANDNB to the program. See the function KANDNB.
+func (b *Builder) KANDNB(reg, vvvv, rm MaskReg) {
+	b.add("KANDNB", "KANDNB", reg, vvvv, rm)
+}
+
+// KANDND appends KANDND to the program. See the function KANDND.
+func (b *Builder) KANDND(reg, vvvv, rm MaskReg) {
+	b.add("KANDND", "KANDND", reg, vvvv, rm)
+}
+
+// KANDNQ appends KANDNQ to the program. See the function KANDNQ.
+func (b *Builder) KANDNQ(reg, vvvv, rm MaskReg) {
+	b.add("KANDNQ", "KANDNQ", reg, vvvv, rm)
+}
+
+// KANDNW appends KANDNW to the program. See the function KANDNW.
+func (b *Builder) KANDNW(reg, vvvv, rm MaskReg) {
+	b.add("KANDNW", "KANDNW", reg, vvvv, rm)
+}
+
+// KANDQ appends KANDQ to the program. See the function KANDQ.
+func (b *Builder) KANDQ(reg, vvvv, rm MaskReg) {
+	b.add("KANDQ", "KANDQ", reg, vvvv, rm)
+}
+
+// KANDW appends KANDW to the program. See the function KANDW.
+func (b *Builder) KANDW(reg, vvvv, rm MaskReg) {
+	b.add("KANDW", "KANDW", reg, vvvv, rm)
+}
+
+// KMOVB_MR appends KMOVB to the program. See the function KMOVB_MR.
+func (b *Builder) KMOVB_MR(rm Mem, reg MaskReg) {
+	b.add("KMOVB", "KMOVB_MR", rm, reg)
+}
+
+// KMOVB_RM appends KMOVB to the program. See the function KMOVB_RM.
+func (b *Builder) KMOVB_RM(reg MaskReg, rm RegMem) {
+	b.add("KMOVB", "KMOVB_RM", reg, rm)
+}
+
+// KMOVB_RR appends KMOVB to the program. See the function KMOVB_RR.
+func (b *Builder) KMOVB_RR(reg MaskReg, rm Reg) {
+	b.add("KMOVB", "KMOVB_RR", reg, rm)
+}
+
+// KMOVD_MR appends KMOVD to the program. See the function KMOVD_MR.
+func (b *Builder) KMOVD_MR(rm Mem, reg MaskReg) {
+	b.add("KMOVD", "KMOVD_MR", rm, reg)
+}
+
+// KMOVD_RM appends KMOVD to the program. See the function KMOVD_RM.
+func (b *Builder) KMOVD_RM(reg MaskReg, rm RegMem) {
+	b.add("KMOVD", "KMOVD_RM", reg, rm)
+}
+
+// KMOVD_RR appends KMOVD to the program. See the function KMOVD_RR.
+func (b *Builder) KMOVD_RR(reg MaskReg, rm Reg) {
+	b.add("KMOVD", "KMOVD_RR", reg, rm)
+}
+
+// KMOVQ_MR appends KMOVQ to the program. See the function KMOVQ_MR.
+func (b *Builder) KMOVQ_MR(rm Mem, reg MaskReg) {
+	b.add("KMOVQ", "KMOVQ_MR", rm, reg)
+}
+
+// KMOVQ_RM appends KMOVQ to the program. See the function KMOVQ_RM.
+func (b *Builder) KMOVQ_RM(reg MaskReg, rm RegMem) {
+	b.add("KMOVQ", "KMOVQ_RM", reg, rm)
+}
+
+// KMOVQ_RR appends KMOVQ to the program. See the function KMOVQ_RR.
+func (b *Builder) KMOVQ_RR(reg MaskReg, rm Reg) {
+	b.add("KMOVQ", "KMOVQ_RR", reg, rm)
+}
+
+// KMOVW_MR appends KMOVW to the program. See the function KMOVW_MR.
+func (b *Builder) KMOVW_MR(rm Mem, reg MaskReg) {
+	b.add("KMOVW", "KMOVW_MR", rm, reg)
+}
+
+// KMOVW_RM appends KMOVW to the program. See the function KMOVW_RM.
+func (b *Builder) KMOVW_RM(reg MaskReg, rm RegMem) {
+	b.add("KMOVW", "KMOVW_RM", reg, rm)
+}
+
+// KMOVW_RR appends KMOVW to the program. See the function KMOVW_RR.
+func (b *Builder) KMOVW_RR(reg MaskReg, rm Reg) {
+	b.add("KMOVW", "KMOVW_RR", reg, rm)
+}
+
+// KNOTB appends KNOTB to the program. See the function KNOTB.
+func (b *Builder) KNOTB(reg, rm MaskReg) {
+	b.add("KNOTB", "KNOTB", reg, rm)
+}
+
+// KNOTD appends KNOTD to the program. See the function KNOTD.
+func (b *Builder) KNOTD(reg, rm MaskReg) {
+	b.add("KNOTD", "KNOTD", reg, rm)
+}
+
+// KNOTQ appends KNOTQ to the program. See the function KNOTQ.
+func (b *Builder) KNOTQ(reg, rm MaskReg) {
+	b.add("KNOTQ", "KNOTQ", reg, rm)
+}
+
+// KNOTW appends KNOTW to the program. See the function KNOTW.
+func (b *Builder) KNOTW(reg, rm MaskReg) {
+	b.add("KNOTW", "KNOTW", reg, rm)
+}
+
+// KORB appends KORB to the program. See the function KORB.
+func (b *Builder) KORB(reg, vvvv, rm MaskReg) {
+	b.add("KORB", "KORB", reg, vvvv, rm)
+}
+
+// KORD appends KORD to the program. See the function KORD.
+func (b *Builder) KORD(reg, vvvv, rm MaskReg) {
+	b.add("KORD", "KORD", reg, vvvv, rm)
+}
+
+// KORQ appends KORQ to the program. See the function KORQ.
+func (b *Builder) KORQ(reg, vvvv, rm MaskReg) {
+	b.add("KORQ", "KORQ", reg, vvvv, rm)
+}
+
+// KORTESTB appends KORTESTB to the program. See the function KORTESTB.
+func (b *Builder) KORTESTB(reg, rm MaskReg) {
+	b.add("KORTESTB", "KORTESTB", reg, rm)
+}
+
+// KORTESTD appends KORTESTD to the program. See the function KORTESTD.
+func (b *Builder) KORTESTD(reg, rm MaskReg) {
+	b.add("KORTESTD", "KORTESTD", reg, rm)
+}
+
+// KORTESTQ appends KORTESTQ to the program. See the function KORTESTQ.
+func (b *Builder) KORTESTQ(reg, rm MaskReg) {
+	b.add("KORTESTQ", "KORTESTQ", reg, rm)
+}
+
+// KORTESTW appends KORTESTW to the program. See the function KORTESTW.
+func (b *Builder) KORTESTW(reg, rm MaskReg) {
+	b.add("KORTESTW", "KORTESTW", reg, rm)
+}
+
+// KORW appends KORW to the program. See the function KORW.
+func (b *Builder) KORW(reg, vvvv, rm MaskReg) {
+	b.add("KORW", "KORW", reg, vvvv, rm)
+}
+
+// KSHIFTLB appends KSHIFTLB to the program. See the function KSHIFTLB.
+func (b *Builder) KSHIFTLB(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTLB", "KSHIFTLB", reg, rm, imm)
+}
+
+// KSHIFTLD appends KSHIFTLD to the program. See the function KSHIFTLD.
+func (b *Builder) KSHIFTLD(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTLD", "KSHIFTLD", reg, rm, imm)
+}
+
+// KSHIFTLQ appends KSHIFTLQ to the program. See the function KSHIFTLQ.
+func (b *Builder) KSHIFTLQ(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTLQ", "KSHIFTLQ", reg, rm, imm)
+}
+
+// KSHIFTLW appends KSHIFTLW to the program. See the function KSHIFTLW.
+func (b *Builder) KSHIFTLW(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTLW", "KSHIFTLW", reg, rm, imm)
+}
+
+// KSHIFTRB appends KSHIFTRB to the program. See the function KSHIFTRB.
+func (b *Builder) KSHIFTRB(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTRB", "KSHIFTRB", reg, rm, imm)
+}
+
+// KSHIFTRD appends KSHIFTRD to the program. See the function KSHIFTRD.
+func (b *Builder) KSHIFTRD(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTRD", "KSHIFTRD", reg, rm, imm)
+}
+
+// KSHIFTRQ appends KSHIFTRQ to the program. See the function KSHIFTRQ.
+func (b *Builder) KSHIFTRQ(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTRQ", "KSHIFTRQ", reg, rm, imm)
+}
+
+// KSHIFTRW appends KSHIFTRW to the program. See the function KSHIFTRW.
+func (b *Builder) KSHIFTRW(reg, rm MaskReg, imm Imm) {
+	b.add("KSHIFTRW", "KSHIFTRW", reg, rm, imm)
+}
+
+// KUNPCKBW appends KUNPCKBW to the program. See the function KUNPCKBW.
+func (b *Builder) KUNPCKBW(reg, vvvv, rm MaskReg) {
+	b.add("KUNPCKBW", "KUNPCKBW", reg, vvvv, rm)
+}
+
+// KUNPCKDQ appends KUNPCKDQ to the program. See the function KUNPCKDQ.
+func (b *Builder) KUNPCKDQ(reg, vvvv, rm MaskReg) {
+	b.add("KUNPCKDQ", "KUNPCKDQ", reg, vvvv, rm)
+}
+
+// KUNPCKWD appends KUNPCKWD to the program. See the function KUNPCKWD.
+func (b *Builder) KUNPCKWD(reg, vvvv, rm MaskReg) {
+	b.add("KUNPCKWD", "KUNPCKWD", reg, vvvv, rm)
+}
+
+// KXNORB appends KXNORB to the program. See the function KXNORB.
+func (b *Builder) KXNORB(reg, vvvv, rm MaskReg) {
+	b.add("KXNORB", "KXNORB", reg, vvvv, rm)
+}
+
+// KXNORD appends KXNORD to the program. See the function KXNORD.
+func (b *Builder) KXNORD(reg, vvvv, rm MaskReg) {
+	b.add("KXNORD", "KXNORD", reg, vvvv, rm)
+}
+
+// KXNORQ appends KXNORQ to the program. See the function KXNORQ.
+func (b *Builder) KXNORQ(reg, vvvv, rm MaskReg) {
+	b.add("KXNORQ", "KXNORQ", reg, vvvv, rm)
+}
+
+// KXNORW appends KXNORW to the program. See the function KXNORW.
+func (b *Builder) KXNORW(reg, vvvv, rm MaskReg) {
+	b.add("KXNORW", "KXNORW", reg, vvvv, rm)
+}
+
+// KXORB appends KXORB to the program. See the function KXORB.
+func (b *Builder) KXORB(reg, vvvv, rm MaskReg) {
+	b.add("KXORB", "KXORB", reg, vvvv, rm)
+}
+
+// KXORD appends KXORD to the program. See the function KXORD.
+func (b *Builder) KXORD(reg, vvvv, rm MaskReg) {
+	b.add("KXORD", "KXORD", reg, vvvv, rm)
+}
+
+// KXORQ appends KXORQ to the program. See the function KXORQ.
+func (b *Builder) KXORQ(reg, vvvv, rm MaskReg) {
+	b.add("KXORQ", "KXORQ", reg, vvvv, rm)
+}
+
+// KXORW appends KXORW to the program. See the function KXORW.
+func (b *Builder) KXORW(reg, vvvv, rm MaskReg) {
+	b.add("KXORW", "KXORW", reg, vvvv, rm)
+}
+
+// LAHF appends LAHF to the program. See the function LAHF.
+func (b *Builder) LAHF() {
+	b.add("LAHF", "LAHF")
+}
+
+// LAR appends LAR to the program. See the function LAR.
+func (b *Builder) LAR(reg Reg, rm RegMem) {
+	b.add("LAR", "LAR", reg, rm)
+}
+
+// LDDQU appends LDDQU to the program. See the function LDDQU.
+func (b *Builder) LDDQU(reg VecReg, rm Mem) {
+	b.add("LDDQU", "LDDQU", reg, rm)
+}
+
+// LDMXCSR appends LDMXCSR to the program. See the function LDMXCSR.
+func (b *Builder) LDMXCSR(rm Mem) {
+	b.add("LDMXCSR", "LDMXCSR", rm)
+}
+
+// LDS appends LDS to the program. See the function LDS.
+func (b *Builder) LDS(reg Reg, rm Mem) {
+	b.add("LDS", "LDS", reg, rm)
+}
+
+// LEA appends LEA to the program. See the function LEA.
+func (b *Builder) LEA(reg Reg, rm Mem) {
+	b.add("LEA", "LEA", reg, rm)
+}
+
+// LEAVE appends LEAVE to the program. See the function LEAVE.
+func (b *Builder) LEAVE() {
+	b.add("LEAVE", "LEAVE")
+}
+
+// LES appends LES to the program. See the function LES.
+func (b *Builder) LES(reg Reg, rm Mem) {
+	b.add("LES", "LES", reg, rm)
+}
+
+// LFENCE appends LFENCE to the program. See the function LFENCE.
+func (b *Builder) LFENCE() {
+	b.add("LFENCE", "LFENCE")
+}
+
+// LFS appends LFS to the program. See the function LFS.
+func (b *Builder) LFS(reg Reg, rm Mem) {
+	b.add("LFS", "LFS", reg, rm)
+}
+
+// LGDT appends LGDT to the program. See the function LGDT.
+func (b *Builder) LGDT(rm Mem) {
+	b.add("LGDT", "LGDT", rm)
+}
+
+// LGS appends LGS to the program. See the function LGS.
+func (b *Builder) LGS(reg Reg, rm Mem) {
+	b.add("LGS", "LGS", reg, rm)
+}
+
+// LIDT appends LIDT to the program. See the function LIDT.
+func (b *Builder) LIDT(rm Mem) {
+	b.add("LIDT", "LIDT", rm)
+}
+
+// LLDT appends LLDT to the program. See the function LLDT.
+func (b *Builder) LLDT(rm RegMem) {
+	b.add("LLDT", "LLDT", rm)
+}
+
+// LMSW appends LMSW to the program. See the function LMSW.
+func (b *Builder) LMSW(rm RegMem) {
+	b.add("LMSW", "LMSW", rm)
+}
+
+// LOCK appends LOCK to the program. See the function LOCK.
+func (b *Builder) LOCK() {
+	b.add("LOCK", "LOCK")
+}
+
+// LODSB appends LODSB to the program. See the function LODSB.
+func (b *Builder) LODSB() {
+	b.add("LODSB", "LODSB")
+}
+
+// LODSD appends LODSD to the program. See the function LODSD.
+func (b *Builder) LODSD() {
+	b.add("LODSD", "LODSD")
+}
+
+// LODSQ appends LODSQ to the program. See the function LODSQ.
+func (b *Builder) LODSQ() {
+	b.add("LODSQ", "LODSQ")
+}
+
+// LODSW appends LODSW to the program. See the function LODSW.
+func (b *Builder) LODSW() {
+	b.add("LODSW", "LODSW")
+}
+
+// LOOP appends LOOP to the program. See the function LOOP.
+func (b *Builder) LOOP(rel Target) {
+	b.add("LOOP", "LOOP", rel)
+}
+
+// LOOPE appends LOOPE to the program. See the function LOOPE.
+func (b *Builder) LOOPE(rel Target) {
+	b.add("LOOPE", "LOOPE", rel)
+}
+
+// LOOPNE appends LOOPNE to the program. See the function LOOPNE.
+func (b *Builder) LOOPNE(rel Target) {
+	b.add("LOOPNE", "LOOPNE", rel)
+}
+
+// LSL appends LSL to the program. See the function LSL.
+func (b *Builder) LSL(reg Reg, rm RegMem) {
+	b.add("LSL", "LSL", reg, rm)
+}
+
+// LSS appends LSS to the program. See the function LSS.
+func (b *Builder) LSS(reg Reg, rm Mem) {
+	b.add("LSS", "LSS", reg, rm)
+}
+
+// LTR appends LTR to the program. See the function LTR.
+func (b *Builder) LTR(rm RegMem) {
+	b.add("LTR", "LTR", rm)
+}
+
+// LZCNT appends LZCNT to the program. See the function LZCNT.
+func (b *Builder) LZCNT(reg Reg, rm RegMem) {
+	b.add("LZCNT", "LZCNT", reg, rm)
+}
+
+// MASKMOVDQU appends MASKMOVDQU to the program. See the function MASKMOVDQU.
+func (b *Builder) MASKMOVDQU(reg, rm VecReg) {
+	b.add("MASKMOVDQU", "MASKMOVDQU", reg, rm)
+}
+
+// MASKMOVQ appends MASKMOVQ to the program. See the function MASKMOVQ.
+func (b *Builder) MASKMOVQ(reg, rm MMXReg) {
+	b.add("MASKMOVQ", "MASKMOVQ", reg, rm)
+}
+
+// MAXPD appends MAXPD to the program. See the function MAXPD.
+func (b *Builder) MAXPD(reg VecReg, rm RegMem) {
+	b.add("MAXPD", "MAXPD", reg, rm)
+}
+
+// MAXPS appends MAXPS to the program. See the function MAXPS.
+func (b *Builder) MAXPS(reg VecReg, rm RegMem) {
+	b.add("MAXPS", "MAXPS", reg, rm)
+}
+
+// MAXSD appends MAXSD to the program. See the function MAXSD.
+func (b *Builder) MAXSD(reg VecReg, rm RegMem) {
+	b.add("MAXSD", "MAXSD", reg, rm)
+}
+
+// MAXSS appends MAXSS to the program. See the function MAXSS.
+func (b *Builder) MAXSS(reg VecReg, rm RegMem) {
+	b.add("MAXSS", "MAXSS", reg, rm)
+}
+
+// MFENCE appends MFENCE to the program. See the function MFENCE.
+func (b *Builder) MFENCE() {
+	b.add("MFENCE", "MFENCE")
+}
+
+// MINPD appends MINPD to the program. See the function MINPD.
+func (b *Builder) MINPD(reg VecReg, rm RegMem) {
+	b.add("MINPD", "MINPD", reg, rm)
+}
+
+// MINPS appends MINPS to the program. See the function MINPS.
+func (b *Builder) MINPS(reg VecReg, rm RegMem) {
+	b.add("MINPS", "MINPS", reg, rm)
+}
+
+// MINSD appends MINSD to the program. See the function MINSD.
+func (b *Builder) MINSD(reg VecReg, rm RegMem) {
+	b.add("MINSD", "MINSD", reg, rm)
+}
+
+// MINSS appends MINSS to the program. See the function MINSS.
+func (b *Builder) MINSS(reg VecReg, rm RegMem) {
+	b.add("MINSS", "MINSS", reg, rm)
+}
+
+// MONITOR appends MONITOR to the program. See the function MONITOR.
+func (b *Builder) MONITOR() {
+	b.add("MONITOR", "MONITOR")
+}
+
+// MOVAPD_MR appends MOVAPD to the program. See the function MOVAPD_MR.
+func (b *Builder) MOVAPD_MR(rm RegMem, reg VecReg) {
+	b.add("MOVAPD", "MOVAPD_MR", rm, reg)
+}
+
+// MOVAPD_RM appends MOVAPD to the program. See the function MOVAPD_RM.
+func (b *Builder) MOVAPD_RM(reg VecReg, rm RegMem) {
+	b.add("MOVAPD", "MOVAPD_RM", reg, rm)
+}
+
+// MOVAPS_MR appends MOVAPS to the program. See the function MOVAPS_MR.
+func (b *Builder) MOVAPS_MR(rm RegMem, reg VecReg) {
+	b.add("MOVAPS", "MOVAPS_MR", rm, reg)
+}
+
+// MOVAPS_RM appends MOVAPS to the program. See the function MOVAPS_RM.
+func (b *Builder) MOVAPS_RM(reg VecReg, rm RegMem) {
+	b.add("MOVAPS", "MOVAPS_RM", reg, rm)
+}
+
+// MOVBE_MR appends MOVBE to the program. See the function MOVBE_MR.
+func (b *Builder) MOVBE_MR(rm Mem, reg Reg) {
+	b.add("MOVBE", "MOVBE_MR", rm, reg)
+}
+
+// MOVBE_RM appends MOVBE to the program. See the function MOVBE_RM.
+func (b *Builder) MOVBE_RM(reg Reg, rm Mem) {
+	b.add("MOVBE", "MOVBE_RM", reg, rm)
+}
+
+// MOVDDUP appends MOVDDUP to the program. See the function MOVDDUP.
+func (b *Builder) MOVDDUP(reg VecReg, rm RegMem) {
+	b.add("MOVDDUP", "MOVDDUP", reg, rm)
+}
+
+// MOVDQ2Q appends MOVDQ2Q to the program. See the function MOVDQ2Q.
+func (b *Builder) MOVDQ2Q(reg MMXReg, rm VecReg) {
+	b.add("MOVDQ2Q", "MOVDQ2Q", reg, rm)
+}
+
+// MOVDQA_MR appends MOVDQA to the program. See the function MOVDQA_MR.
+func (b *Builder) MOVDQA_MR(rm RegMem, reg VecReg) {
+	b.add("MOVDQA", "MOVDQA_MR", rm, reg)
+}
+
+// MOVDQA_RM appends MOVDQA to the program. See the function MOVDQA_RM.
+func (b *Builder) MOVDQA_RM(reg VecReg, rm RegMem) {
+	b.add("MOVDQA", "MOVDQA_RM", reg, rm)
+}
+
+// MOVDQU_MR appends MOVDQU to the program. See the function MOVDQU_MR.
+func (b *Builder) MOVDQU_MR(rm RegMem, reg VecReg) {
+	b.add("MOVDQU", "MOVDQU_MR", rm, reg)
+}
+
+// MOVDQU_RM appends MOVDQU to the program. See the function MOVDQU_RM.
+func (b *Builder) MOVDQU_RM(reg VecReg, rm RegMem) {
+	b.add("MOVDQU", "MOVDQU_RM", reg, rm)
+}
+
+// MOVD_MR appends MOVD to the program. See the function MOVD_MR.
+func (b *Builder) MOVD_MR(rm RegMem, reg Register) {
+	b.add("MOVD", "MOVD_MR", rm, reg)
+}
+
+// MOVD_RM appends MOVD to the program. See the function MOVD_RM.
+func (b *Builder) MOVD_RM(reg Register, rm RegMem) {
+	b.add("MOVD", "MOVD_RM", reg, rm)
+}
+
+// MOVD_RVM appends MOVD to the program. See the function MOVD_RVM.
+func (b *Builder) MOVD_RVM(reg, vvvv, rm Operand) {
+	b.add("MOVD", "MOVD_RVM", reg, vvvv, rm)
+}
+
+// MOVHLPS appends MOVHLPS to the program. See the function MOVHLPS.
+func (b *Builder) MOVHLPS(reg, rm VecReg) {
+	b.add("MOVHLPS", "MOVHLPS", reg, rm)
+}
+
+// MOVHPD_MR appends MOVHPD to the program. See the function MOVHPD_MR.
+func (b *Builder) MOVHPD_MR(rm Mem, reg VecReg) {
+	b.add("MOVHPD", "MOVHPD_MR", rm, reg)
+}
+
+// MOVHPD_RM appends MOVHPD to the program. See the function MOVHPD_RM.
+func (b *Builder) MOVHPD_RM(reg VecReg, rm Mem) {
+	b.add("MOVHPD", "MOVHPD_RM", reg, rm)
+}
+
+// MOVHPS_MR appends MOVHPS to the program. See the function MOVHPS_MR.
+func (b *Builder) MOVHPS_MR(rm Mem, reg VecReg) {
+	b.add("MOVHPS", "MOVHPS_MR", rm, reg)
+}
+
+// MOVHPS_RM appends MOVHPS to the program. See the function MOVHPS_RM.
+func (b *Builder) MOVHPS_RM(reg VecReg, rm Mem) {
+	b.add("MOVHPS", "MOVHPS_RM", reg, rm)
+}
+
+// MOVLHPS appends MOVLHPS to the program. See the function MOVLHPS.
+func (b *Builder) MOVLHPS(reg, rm VecReg) {
+	b.add("MOVLHPS", "MOVLHPS", reg, rm)
+}
+
+// MOVLPD_MR appends MOVLPD to the program. See the function MOVLPD_MR.
+func (b *Builder) MOVLPD_MR(rm Mem, reg VecReg) {
+	b.add("MOVLPD", "MOVLPD_MR", rm, reg)
+}
+
+// MOVLPD_RM appends MOVLPD to the program. See the function MOVLPD_RM.
+func (b *Builder) MOVLPD_RM(reg VecReg, rm Mem) {
+	b.add("MOVLPD", "MOVLPD_RM", reg, rm)
+}
+
+// MOVLPS_MR appends MOVLPS to the program. See the function MOVLPS_MR.
+func (b *Builder) MOVLPS_MR(rm Mem, reg VecReg) {
+	b.add("MOVLPS", "MOVLPS_MR", rm, reg)
+}
+
+// MOVLPS_RM appends MOVLPS to the program. See the function MOVLPS_RM.
+func (b *Builder) MOVLPS_RM(reg VecReg, rm Mem) {
+	b.add("MOVLPS", "MOVLPS_RM", reg, rm)
+}
+
+// MOVMSKPD appends MOVMSKPD to the program. See the function MOVMSKPD.
+func (b *Builder) MOVMSKPD(reg Reg, rm VecReg) {
+	b.add("MOVMSKPD", "MOVMSKPD", reg, rm)
+}
+
+// MOVMSKPS appends MOVMSKPS to the program. See the function MOVMSKPS.
+func (b *Builder) MOVMSKPS(reg Reg, rm VecReg) {
+	b.add("MOVMSKPS", "MOVMSKPS", reg, rm)
+}
+
+// MOVNTDQ appends MOVNTDQ to the program. See the function MOVNTDQ.
+func (b *Builder) MOVNTDQ(rm Mem, reg VecReg) {
+	b.add("MOVNTDQ", "MOVNTDQ", rm, reg)
+}
+
+// MOVNTDQA appends MOVNTDQA to the program. See the function MOVNTDQA.
+func (b *Builder) MOVNTDQA(reg VecReg, rm Mem) {
+	b.add("MOVNTDQA", "MOVNTDQA", reg, rm)
+}
+
+// MOVNTI appends MOVNTI to the program. See the function MOVNTI.
+func (b *Builder) MOVNTI(rm Mem, reg Reg) {
+	b.add("MOVNTI", "MOVNTI", rm, reg)
+}
+
+// MOVNTPD appends MOVNTPD to the program. See the function MOVNTPD.
+func (b *Builder) MOVNTPD(rm Mem, reg VecReg) {
+	b.add("MOVNTPD", "MOVNTPD", rm, reg)
+}
+
+// MOVNTPS appends MOVNTPS to the program. See the function MOVNTPS.
+func (b *Builder) MOVNTPS(rm Mem, reg VecReg) {
+	b.add("MOVNTPS", "MOVNTPS", rm, reg)
+}
+
+// MOVNTQ appends MOVNTQ to the program. See the function MOVNTQ.
+func (b *Builder) MOVNTQ(rm Mem, reg MMXReg) {
+	b.add("MOVNTQ", "MOVNTQ", rm, reg)
+}
+
+// MOVNTSD appends MOVNTSD to the program. See the function MOVNTSD.
+func (b *Builder) MOVNTSD(rm Mem, reg VecReg) {
+	b.add("MOVNTSD", "MOVNTSD", rm, reg)
+}
+
+// MOVNTSS appends MOVNTSS to the program. See the function MOVNTSS.
+func (b *Builder) MOVNTSS(rm Mem, reg VecReg) {
+	b.add("MOVNTSS", "MOVNTSS", rm, reg)
+}
+
+// MOVQ2DQ appends MOVQ2DQ to the program. See the function MOVQ2DQ.
+func (b *Builder) MOVQ2DQ(reg VecReg, rm MMXReg) {
+	b.add("MOVQ2DQ", "MOVQ2DQ", reg, rm)
+}
+
+// MOVQ_MR appends MOVQ to the program. See the function MOVQ_MR.
+func (b *Builder) MOVQ_MR(rm RegMem, reg Register) {
+	b.add("MOVQ", "MOVQ_MR", rm, reg)
+}
+
+// MOVQ_RM appends MOVQ to the program. See the function MOVQ_RM.
+func (b *Builder) MOVQ_RM(reg Register, rm RegMem) {
+	b.add("MOVQ", "MOVQ_RM", reg, rm)
+}
+
+// MOVSB appends MOVSB to the program. See the function MOVSB.
+func (b *Builder) MOVSB() {
+	b.add("MOVSB", "MOVSB")
+}
+
+// MOVSD appends MOVSD to the program. See the function MOVSD.
+func (b *Builder) MOVSD() {
+	b.add("MOVSD", "MOVSD")
+}
+
+// MOVSD_MR appends MOVSD to the program. See the function MOVSD_MR.
+func (b *Builder) MOVSD_MR(rm RegMem, reg VecReg) {
+	b.add("MOVSD", "MOVSD_MR", rm, reg)
+}
+
+// MOVSD_RM appends MOVSD to the program. See the function MOVSD_RM.
+func (b *Builder) MOVSD_RM(reg VecReg, rm RegMem) {
+	b.add("MOVSD", "MOVSD_RM", reg, rm)
+}
+
+// MOVSHDUP appends MOVSHDUP to the program. See the function MOVSHDUP.
+func (b *Builder) MOVSHDUP(reg VecReg, rm RegMem) {
+	b.add("MOVSHDUP", "MOVSHDUP", reg, rm)
+}
+
+// MOVSLDUP appends MOVSLDUP to the program. See the function MOVSLDUP.
+func (b *Builder) MOVSLDUP(reg VecReg, rm RegMem) {
+	b.add("MOVSLDUP", "MOVSLDUP", reg, rm)
+}
+
+// MOVSQ appends MOVSQ to the program. See the function MOVSQ.
+func (b *Builder) MOVSQ() {
+	b.add("MOVSQ", "MOVSQ")
+}
+
+// MOVSS_MR appends MOVSS to the program. See the function MOVSS_MR.
+func (b *Builder) MOVSS_MR(rm RegMem, reg VecReg) {
+	b.add("MOVSS", "MOVSS_MR", rm, reg)
+}
+
+// MOVSS_RM appends MOVSS to the program. See the function MOVSS_RM.
+func (b *Builder) MOVSS_RM(reg VecReg, rm RegMem) {
+	b.add("MOVSS", "MOVSS_RM", reg, rm)
+}
+
+// MOVSW appends MOVSW to the program. See the function MOVSW.
+func (b *Builder) MOVSW() {
+	b.add("MOVSW", "MOVSW")
+}
+
+// MOVSX appends MOVSX to the program. See the function MOVSX.
+func (b *Builder) MOVSX(reg Reg, rm RegMem) {
+	b.add("MOVSX", "MOVSX", reg, rm)
+}
+
+// MOVSXD appends MOVSXD to the program. See the function MOVSXD.
+func (b *Builder) MOVSXD(reg Reg, rm RegMem) {
+	b.add("MOVSXD", "MOVSXD", reg, rm)
+}
+
+// MOVUPD_MR appends MOVUPD to the program. See the function MOVUPD_MR.
+func (b *Builder) MOVUPD_MR(rm RegMem, reg VecReg) {
+	b.add("MOVUPD", "MOVUPD_MR", rm, reg)
+}
+
+// MOVUPD_RM appends MOVUPD to the program. See the function MOVUPD_RM.
+func (b *Builder) MOVUPD_RM(reg VecReg, rm RegMem) {
+	b.add("MOVUPD", "MOVUPD_RM", reg, rm)
+}
+
+// MOVUPS_MR appends MOVUPS to the program. See the function MOVUPS_MR.
+func (b *Builder) MOVUPS_MR(rm RegMem, reg VecReg) {
+	b.add("MOVUPS", "MOVUPS_MR", rm, reg)
+}
+
+// MOVUPS_RM appends MOVUPS to the program. See the function MOVUPS_RM.
+func (b *Builder) MOVUPS_RM(reg VecReg, rm RegMem) {
+	b.add("MOVUPS", "MOVUPS_RM", reg, rm)
+}
+
+// MOVZX appends MOVZX to the program. See the function MOVZX.
+func (b *Builder) MOVZX(reg Reg, rm RegMem) {
+	b.add("MOVZX", "MOVZX", reg, rm)
+}
+
+// MOV_FD appends MOV to the program. See the function MOV_FD.
+func (b *Builder) MOV_FD(arg Reg, arg2 Operand) {
+	b.add("MOV", "MOV_FD", arg, arg2)
+}
+
+// MOV_MI appends MOV to the program. See the function MOV_MI.
+func (b *Builder) MOV_MI(rm RegMem, imm Imm) {
+	b.add("MOV", "MOV_MI", rm, imm)
+}
+
+// MOV_MR appends MOV to the program. See the function MOV_MR.
+func (b *Builder) MOV_MR(rm RegMem, reg Register) {
+	b.add("MOV", "MOV_MR", rm, reg)
+}
+
+// MOV_OI appends MOV to the program. See the function MOV_OI.
+func (b *Builder) MOV_OI(opcode Reg, imm Imm) {
+	b.add("MOV", "MOV_OI", opcode, imm)
+}
+
+// MOV_RM appends MOV to the program. See the function MOV_RM.
+func (b *Builder) MOV_RM(arg Register, rm RegMem) {
+	b.add("MOV", "MOV_RM", arg, rm)
+}
+
+// MOV_TD appends MOV to the program. See the function MOV_TD.
+func (b *Builder) MOV_TD(moffs Mem, ax Reg) {
+	b.add("MOV", "MOV_TD", moffs, ax)
+}
+
+// MPSADBW appends MPSADBW to the program. See the function MPSADBW.
+func (b *Builder) MPSADBW(reg VecReg, rm RegMem, imm Imm) {
+	b.add("MPSADBW", "MPSADBW", reg, rm, imm)
+}
+
+// MUL appends MUL to the program. See the function MUL.
+func (b *Builder) MUL(rm RegMem) {
+	b.add("MUL", "MUL", rm)
+}
+
+// MULPD appends MULPD to the program. See the function MULPD.
+func (b *Builder) MULPD(reg VecReg, rm RegMem) {
+	b.add("MULPD", "MULPD", reg, rm)
+}
+
+// MULPS appends MULPS to the program. See the function MULPS.
+func (b *Builder) MULPS(reg VecReg, rm RegMem) {
+	b.add("MULPS", "MULPS", reg, rm)
+}
+
+// MULSD appends MULSD to the program. See the function MULSD.
+func (b *Builder) MULSD(reg VecReg, rm RegMem) {
+	b.add("MULSD", "MULSD", reg, rm)
+}
+
+// MULSS appends MULSS to the program. See the function MULSS.
+func (b *Builder) MULSS(reg VecReg, rm RegMem) {
+	b.add("MULSS", "MULSS", reg, rm)
+}
+
+// MULX appends MULX to the program. See the function MULX.
+func (b *Builder) MULX(reg, vvvv Reg, rm RegMem) {
+	b.add("MULX", "MULX", reg, vvvv, rm)
+}
+
+// MWAIT appends MWAIT to the program. See the function MWAIT.
+func (b *Builder) MWAIT() {
+	b.add("MWAIT", "MWAIT")
+}
+
+// NEG appends NEG to the program. See the function NEG.
+func (b *Builder) NEG(rm RegMem) {
+	b.add("NEG", "NEG", rm)
+}
+
+// NOP_M appends NOP to the program. See the function NOP_M.
+func (b *Builder) NOP_M(rm RegMem) {
+	b.add("NOP", "NOP_M", rm)
+}
+
+// NOP_NP appends NOP to the program. See the function NOP_NP.
+func (b *Builder) NOP_NP() {
+	b.add("NOP", "NOP_NP")
+}
+
+// NOT appends NOT to the program. See the function NOT.
+func (b *Builder) NOT(rm RegMem) {
+	b.add("NOT", "NOT", rm)
+}
+
+// ORPD appends ORPD to the program. See the function ORPD.
+func (b *Builder) ORPD(reg VecReg, rm RegMem) {
+	b.add("ORPD", "ORPD", reg, rm)
+}
+
+// ORPS appends ORPS to the program. See the function ORPS.
+func (b *Builder) ORPS(reg VecReg, rm RegMem) {
+	b.add("ORPS", "ORPS", reg, rm)
+}
+
+// OR_I appends OR to the program. See the function OR_I.
+func (b *Builder) OR_I(al Reg, imm Imm) {
+	b.add("OR", "OR_I", al, imm)
+}
+
+// OR_MI appends OR to the program. See the function OR_MI.
+func (b *Builder) OR_MI(rm RegMem, imm Imm) {
+	b.add("OR", "OR_MI", rm, imm)
+}
+
+// OR_MR appends OR to the program. See the function OR_MR.
+func (b *Builder) OR_MR(rm RegMem, reg Reg) {
+	b.add("OR", "OR_MR", rm, reg)
+}
+
+// OR_RM appends OR to the program. See the function OR_RM.
+func (b *Builder) OR_RM(reg Reg, rm RegMem) {
+	b.add("OR", "OR_RM", reg, rm)
+}
+
+// OUTSB appends OUTSB to the program. See the function OUTSB.
+func (b *Builder) OUTSB() {
+	b.add("OUTSB", "OUTSB")
+}
+
+// OUTSD appends OUTSD to the program. See the function OUTSD.
+func (b *Builder) OUTSD() {
+	b.add("OUTSD", "OUTSD")
+}
+
+// OUTSW appends OUTSW to the program. See the function OUTSW.
+func (b *Builder) OUTSW() {
+	b.add("OUTSW", "OUTSW")
+}
+
+// OUT_I appends OUT to the program. See the function OUT_I.
+func (b *Builder) OUT_I(imm Imm, al Reg) {
+	b.add("OUT", "OUT_I", imm, al)
+}
+
+// OUT_NP appends OUT to the program. See the function OUT_NP.
+func (b *Builder) OUT_NP(dx, al Reg) {
+	b.add("OUT", "OUT_NP", dx, al)
+}
+
+// PABSB appends PABSB to the program. See the function PABSB.
+func (b *Builder) PABSB(reg Register, rm RegMem) {
+	b.add("PABSB", "PABSB", reg, rm)
+}
+
+// PABSD appends PABSD to the program. See the function PABSD.
+func (b *Builder) PABSD(reg Register, rm RegMem) {
+	b.add("PABSD", "PABSD", reg, rm)
+}
+
+// PABSW appends PABSW to the program. See the function PABSW.
+func (b *Builder) PABSW(reg Register, rm RegMem) {
+	b.add("PABSW", "PABSW", reg, rm)
+}
+
+// PACKSSDW appends PACKSSDW to the program. See the function PACKSSDW.
+func (b *Builder) PACKSSDW(reg Register, rm RegMem) {
+	b.add("PACKSSDW", "PACKSSDW", reg, rm)
+}
+
+// PACKSSWB appends PACKSSWB to the program. See the function PACKSSWB.
+func (b *Builder) PACKSSWB(reg Register, rm RegMem) {
+	b.add("PACKSSWB", "PACKSSWB", reg, rm)
+}
+
+// PACKUSDW appends PACKUSDW to the program. See the function PACKUSDW.
+func (b *Builder) PACKUSDW(reg VecReg, rm RegMem) {
+	b.add("PACKUSDW", "PACKUSDW", reg, rm)
+}
+
+// PACKUSWB appends PACKUSWB to the program. See the function PACKUSWB.
+func (b *Builder) PACKUSWB(reg Register, rm RegMem) {
+	b.add("PACKUSWB", "PACKUSWB", reg, rm)
+}
+
+// PADDB appends PADDB to the program. See the function PADDB.
+func (b *Builder) PADDB(reg Register, rm RegMem) {
+	b.add("PADDB", "PADDB", reg, rm)
+}
+
+// PADDD appends PADDD to the program. See the function PADDD.
+func (b *Builder) PADDD(reg Register, rm RegMem) {
+	b.add("PADDD", "PADDD", reg, rm)
+}
+
+// PADDQ appends PADDQ to the program. See the function PADDQ.
+func (b *Builder) PADDQ(reg Register, rm RegMem) {
+	b.add("PADDQ", "PADDQ", reg, rm)
+}
+
+// PADDSB appends PADDSB to the program. See the function PADDSB.
+func (b *Builder) PADDSB(reg Register, rm RegMem) {
+	b.add("PADDSB", "PADDSB", reg, rm)
+}
+
+// PADDSW appends PADDSW to the program. See the function PADDSW.
+func (b *Builder) PADDSW(reg Register, rm RegMem) {
+	b.add("PADDSW", "PADDSW", reg, rm)
+}
+
+// PADDUSB appends PADDUSB to the program. See the function PADDUSB.
+func (b *Builder) PADDUSB(reg Register, rm RegMem) {
+	b.add("PADDUSB", "PADDUSB", reg, rm)
+}
+
+// PADDUSW appends PADDUSW to the program. See the function PADDUSW.
+func (b *Builder) PADDUSW(reg Register, rm RegMem) {
+	b.add("PADDUSW", "PADDUSW", reg, rm)
+}
+
+// PADDW appends PADDW to the program. See the function PADDW.
+func (b *Builder) PADDW(reg Register, rm RegMem) {
+	b.add("PADDW", "PADDW", reg, rm)
+}
+
+// PALIGNR appends PALIGNR to the program. See the function PALIGNR.
+func (b *Builder) PALIGNR(reg Register, rm RegMem, imm Imm) {
+	b.add("PALIGNR", "PALIGNR", reg, rm, imm)
+}
+
+// PAND appends PAND to the program. See the function PAND.
+func (b *Builder) PAND(reg Register, rm RegMem) {
+	b.add("PAND", "PAND", reg, rm)
+}
+
+// PANDN appends PANDN to the program. See the function PANDN.
+func (b *Builder) PANDN(reg Register, rm RegMem) {
+	b.add("PANDN", "PANDN", reg, rm)
+}
+
+// PAUSE appends PAUSE to the program. See the function PAUSE.
+func (b *Builder) PAUSE() {
+	b.add("PAUSE", "PAUSE")
+}
+
+// PAVGB appends PAVGB to the program. See the function PAVGB.
+func (b *Builder) PAVGB(reg Register, rm RegMem) {
+	b.add("PAVGB", "PAVGB", reg, rm)
+}
+
+// PAVGW appends PAVGW to the program. See the function PAVGW.
+func (b *Builder) PAVGW(reg Register, rm RegMem) {
+	b.add("PAVGW", "PAVGW", reg, rm)
+}
+
+// PBLENDVB appends PBLENDVB to the program. See the function PBLENDVB.
+func (b *Builder) PBLENDVB(reg VecReg, rm RegMem, xmm0 VecReg) {
+	b.add("PBLENDVB", "PBLENDVB", reg, rm, xmm0)
+}
+
+// PBLENDW appends PBLENDW to the program. See the function PBLENDW.
+func (b *Builder) PBLENDW(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PBLENDW", "PBLENDW", reg, rm, imm)
+}
+
+// PCLMULQDQ appends PCLMULQDQ to the program. See the function PCLMULQDQ.
+func (b *Builder) PCLMULQDQ(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PCLMULQDQ", "PCLMULQDQ", reg, rm, imm)
+}
+
+// PCMPEQB appends PCMPEQB to the program. See the function PCMPEQB.
+func (b *Builder) PCMPEQB(reg Register, rm RegMem) {
+	b.add("PCMPEQB", "PCMPEQB", reg, rm)
+}
+
+// PCMPEQD appends PCMPEQD to the program. See the function PCMPEQD.
+func (b *Builder) PCMPEQD(reg Register, rm RegMem) {
+	b.add("PCMPEQD", "PCMPEQD", reg, rm)
+}
+
+// PCMPEQQ appends PCMPEQQ to the program. See the function PCMPEQQ.
+func (b *Builder) PCMPEQQ(reg VecReg, rm RegMem) {
+	b.add("PCMPEQQ", "PCMPEQQ", reg, rm)
+}
+
+// PCMPEQW appends PCMPEQW to the program. See the function PCMPEQW.
+func (b *Builder) PCMPEQW(reg Register, rm RegMem) {
+	b.add("PCMPEQW", "PCMPEQW", reg, rm)
+}
+
+// PCMPESTRI appends PCMPESTRI to the program. See the function PCMPESTRI.
+func (b *Builder) PCMPESTRI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PCMPESTRI", "PCMPESTRI", reg, rm, imm)
+}
+
+// PCMPESTRM appends PCMPESTRM to the program. See the function PCMPESTRM.
+func (b *Builder) PCMPESTRM(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PCMPESTRM", "PCMPESTRM", reg, rm, imm)
+}
+
+// PCMPGTB appends PCMPGTB to the program. See the function PCMPGTB.
+func (b *Builder) PCMPGTB(reg Register, rm RegMem) {
+	b.add("PCMPGTB", "PCMPGTB", reg, rm)
+}
+
+// PCMPGTD appends PCMPGTD to the program. See the function PCMPGTD.
+func (b *Builder) PCMPGTD(reg Register, rm RegMem) {
+	b.add("PCMPGTD", "PCMPGTD", reg, rm)
+}
+
+// PCMPGTQ appends PCMPGTQ to the program. See the function PCMPGTQ.
+func (b *Builder) PCMPGTQ(reg VecReg, rm RegMem) {
+	b.add("PCMPGTQ", "PCMPGTQ", reg, rm)
+}
+
+// PCMPGTW appends PCMPGTW to the program. See the function PCMPGTW.
+func (b *Builder) PCMPGTW(reg Register, rm RegMem) {
+	b.add("PCMPGTW", "PCMPGTW", reg, rm)
+}
+
+// PCMPISTRI appends PCMPISTRI to the program. See the function PCMPISTRI.
+func (b *Builder) PCMPISTRI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PCMPISTRI", "PCMPISTRI", reg, rm, imm)
+}
+
+// PCMPISTRM appends PCMPISTRM to the program. See the function PCMPISTRM.
+func (b *Builder) PCMPISTRM(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PCMPISTRM", "PCMPISTRM", reg, rm, imm)
+}
+
+// PDEP appends PDEP to the program. See the function PDEP.
+func (b *Builder) PDEP(reg, vvvv Reg, rm RegMem) {
+	b.add("PDEP", "PDEP", reg, vvvv, rm)
+}
+
+// PEXT appends PEXT to the program. See the function PEXT.
+func (b *Builder) PEXT(reg, vvvv Reg, rm RegMem) {
+	b.add("PEXT", "PEXT", reg, vvvv, rm)
+}
+
+// PEXTRB appends PEXTRB to the program. See the function PEXTRB.
+func (b *Builder) PEXTRB(rm RegMem, reg VecReg, imm Imm) {
+	b.add("PEXTRB", "PEXTRB", rm, reg, imm)
+}
+
+// PEXTRD appends PEXTRD to the program. See the function PEXTRD.
+func (b *Builder) PEXTRD(rm RegMem, reg VecReg, imm Imm) {
+	b.add("PEXTRD", "PEXTRD", rm, reg, imm)
+}
+
+// PEXTRQ appends PEXTRQ to the program. See the function PEXTRQ.
+func (b *Builder) PEXTRQ(rm RegMem, reg VecReg, imm Imm) {
+	b.add("PEXTRQ", "PEXTRQ", rm, reg, imm)
+}
+
+// PEXTRW_MRI appends PEXTRW to the program. See the function PEXTRW_MRI.
+func (b *Builder) PEXTRW_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("PEXTRW", "PEXTRW_MRI", rm, reg, imm)
+}
+
+// PEXTRW_RMI appends PEXTRW to the program. See the function PEXTRW_RMI.
+func (b *Builder) PEXTRW_RMI(reg Reg, rm Register, imm Imm) {
+	b.add("PEXTRW", "PEXTRW_RMI", reg, rm, imm)
+}
+
+// PHADDD appends PHADDD to the program. See the function PHADDD.
+func (b *Builder) PHADDD(reg Register, rm RegMem) {
+	b.add("PHADDD", "PHADDD", reg, rm)
+}
+
+// PHADDSW appends PHADDSW to the program. See the function PHADDSW.
+func (b *Builder) PHADDSW(reg Register, rm RegMem) {
+	b.add("PHADDSW", "PHADDSW", reg, rm)
+}
+
+// PHADDW appends PHADDW to the program. See the function PHADDW.
+func (b *Builder) PHADDW(reg Register, rm RegMem) {
+	b.add("PHADDW", "PHADDW", reg, rm)
+}
+
+// PHMINPOSUW appends PHMINPOSUW to the program. See the function PHMINPOSUW.
+func (b *Builder) PHMINPOSUW(reg VecReg, rm RegMem) {
+	b.add("PHMINPOSUW", "PHMINPOSUW", reg, rm)
+}
+
+// PHSUBD appends PHSUBD to the program. See the function PHSUBD.
+func (b *Builder) PHSUBD(reg Register, rm RegMem) {
+	b.add("PHSUBD", "PHSUBD", reg, rm)
+}
+
+// PHSUBSW appends PHSUBSW to the program. See the function PHSUBSW.
+func (b *Builder) PHSUBSW(reg Register, rm RegMem) {
+	b.add("PHSUBSW", "PHSUBSW", reg, rm)
+}
+
+// PHSUBW appends PHSUBW to the program. See the function PHSUBW.
+func (b *Builder) PHSUBW(reg Register, rm RegMem) {
+	b.add("PHSUBW", "PHSUBW", reg, rm)
+}
+
+// PINSRB appends PINSRB to the program. See the function PINSRB.
+func (b *Builder) PINSRB(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PINSRB", "PINSRB", reg, rm, imm)
+}
+
+// PINSRD appends PINSRD to the program. See the function PINSRD.
+func (b *Builder) PINSRD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PINSRD", "PINSRD", reg, rm, imm)
+}
+
+// PINSRQ appends PINSRQ to the program. See the function PINSRQ.
+func (b *Builder) PINSRQ(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PINSRQ", "PINSRQ", reg, rm, imm)
+}
+
+// PINSRW appends PINSRW to the program. See the function PINSRW.
+func (b *Builder) PINSRW(reg Register, rm RegMem, imm Imm) {
+	b.add("PINSRW", "PINSRW", reg, rm, imm)
+}
+
+// PMADDUBSW appends PMADDUBSW to the program. See the function PMADDUBSW.
+func (b *Builder) PMADDUBSW(reg Register, rm RegMem) {
+	b.add("PMADDUBSW", "PMADDUBSW", reg, rm)
+}
+
+// PMADDWD appends PMADDWD to the program. See the function PMADDWD.
+func (b *Builder) PMADDWD(reg Register, rm RegMem) {
+	b.add("PMADDWD", "PMADDWD", reg, rm)
+}
+
+// PMAXSB appends PMAXSB to the program. See the function PMAXSB.
+func (b *Builder) PMAXSB(reg VecReg, rm RegMem) {
+	b.add("PMAXSB", "PMAXSB", reg, rm)
+}
+
+// PMAXSD appends PMAXSD to the program. See the function PMAXSD.
+func (b *Builder) PMAXSD(reg VecReg, rm RegMem) {
+	b.add("PMAXSD", "PMAXSD", reg, rm)
+}
+
+// PMAXSW appends PMAXSW to the program. See the function PMAXSW.
+func (b *Builder) PMAXSW(reg Register, rm RegMem) {
+	b.add("PMAXSW", "PMAXSW", reg, rm)
+}
+
+// PMAXUB appends PMAXUB to the program. See the function PMAXUB.
+func (b *Builder) PMAXUB(reg Register, rm RegMem) {
+	b.add("PMAXUB", "PMAXUB", reg, rm)
+}
+
+// PMAXUD appends PMAXUD to the program. See the function PMAXUD.
+func (b *Builder) PMAXUD(reg VecReg, rm RegMem) {
+	b.add("PMAXUD", "PMAXUD", reg, rm)
+}
+
+// PMAXUW appends PMAXUW to the program. See the function PMAXUW.
+func (b *Builder) PMAXUW(reg VecReg, rm RegMem) {
+	b.add("PMAXUW", "PMAXUW", reg, rm)
+}
+
+// PMINSB appends PMINSB to the program. See the function PMINSB.
+func (b *Builder) PMINSB(reg VecReg, rm RegMem) {
+	b.add("PMINSB", "PMINSB", reg, rm)
+}
+
+// PMINSD appends PMINSD to the program. See the function PMINSD.
+func (b *Builder) PMINSD(reg VecReg, rm RegMem) {
+	b.add("PMINSD", "PMINSD", reg, rm)
+}
+
+// PMINSW appends PMINSW to the program. See the function PMINSW.
+func (b *Builder) PMINSW(reg Register, rm RegMem) {
+	b.add("PMINSW", "PMINSW", reg, rm)
+}
+
+// PMINUB appends PMINUB to the program. See the function PMINUB.
+func (b *Builder) PMINUB(reg Register, rm RegMem) {
+	b.add("PMINUB", "PMINUB", reg, rm)
+}
+
+// PMINUD appends PMINUD to the program. See the function PMINUD.
+func (b *Builder) PMINUD(reg VecReg, rm RegMem) {
+	b.add("PMINUD", "PMINUD", reg, rm)
+}
+
+// PMINUW appends PMINUW to the program. See the function PMINUW.
+func (b *Builder) PMINUW(reg VecReg, rm RegMem) {
+	b.add("PMINUW", "PMINUW", reg, rm)
+}
+
+// PMOVMSKB appends PMOVMSKB to the program. See the function PMOVMSKB.
+func (b *Builder) PMOVMSKB(reg Reg, rm Register) {
+	b.add("PMOVMSKB", "PMOVMSKB", reg, rm)
+}
+
+// PMOVSXBD appends PMOVSXBD to the program. See the function PMOVSXBD.
+func (b *Builder) PMOVSXBD(reg VecReg, rm RegMem) {
+	b.add("PMOVSXBD", "PMOVSXBD", reg, rm)
+}
+
+// PMOVSXBQ appends PMOVSXBQ to the program. See the function PMOVSXBQ.
+func (b *Builder) PMOVSXBQ(reg VecReg, rm RegMem) {
+	b.add("PMOVSXBQ", "PMOVSXBQ", reg, rm)
+}
+
+// PMOVSXBW appends PMOVSXBW to the program. See the function PMOVSXBW.
+func (b *Builder) PMOVSXBW(reg VecReg, rm RegMem) {
+	b.add("PMOVSXBW", "PMOVSXBW", reg, rm)
+}
+
+// PMOVSXDQ appends PMOVSXDQ to the program. See the function PMOVSXDQ.
+func (b *Builder) PMOVSXDQ(reg VecReg, rm RegMem) {
+	b.add("PMOVSXDQ", "PMOVSXDQ", reg, rm)
+}
+
+// PMOVSXWD appends PMOVSXWD to the program. See the function PMOVSXWD.
+func (b *Builder) PMOVSXWD(reg VecReg, rm RegMem) {
+	b.add("PMOVSXWD", "PMOVSXWD", reg, rm)
+}
+
+// PMOVSXWQ appends PMOVSXWQ to the program. See the function PMOVSXWQ.
+func (b *Builder) PMOVSXWQ(reg VecReg, rm RegMem) {
+	b.add("PMOVSXWQ", "PMOVSXWQ", reg, rm)
+}
+
+// PMOVZXBD appends PMOVZXBD to the program. See the function PMOVZXBD.
+func (b *Builder) PMOVZXBD(reg VecReg, rm RegMem) {
+	b.add("PMOVZXBD", "PMOVZXBD", reg, rm)
+}
+
+// PMOVZXBQ appends PMOVZXBQ to the program. See the function PMOVZXBQ.
+func (b *Builder) PMOVZXBQ(reg VecReg, rm RegMem) {
+	b.add("PMOVZXBQ", "PMOVZXBQ", reg, rm)
+}
+
+// PMOVZXBW appends PMOVZXBW to the program. See the function PMOVZXBW.
+func (b *Builder) PMOVZXBW(reg VecReg, rm RegMem) {
+	b.add("PMOVZXBW", "PMOVZXBW", reg, rm)
+}
+
+// PMOVZXDQ appends PMOVZXDQ to the program. See the function PMOVZXDQ.
+func (b *Builder) PMOVZXDQ(reg VecReg, rm RegMem) {
+	b.add("PMOVZXDQ", "PMOVZXDQ", reg, rm)
+}
+
+// PMOVZXWD appends PMOVZXWD to the program. See the function PMOVZXWD.
+func (b *Builder) PMOVZXWD(reg VecReg, rm RegMem) {
+	b.add("PMOVZXWD", "PMOVZXWD", reg, rm)
+}
+
+// PMOVZXWQ appends PMOVZXWQ to the program. See the function PMOVZXWQ.
+func (b *Builder) PMOVZXWQ(reg VecReg, rm RegMem) {
+	b.add("PMOVZXWQ", "PMOVZXWQ", reg, rm)
+}
+
+// PMULDQ appends PMULDQ to the program. See the function PMULDQ.
+func (b *Builder) PMULDQ(reg VecReg, rm RegMem) {
+	b.add("PMULDQ", "PMULDQ", reg, rm)
+}
+
+// PMULHRSW appends PMULHRSW to the program. See the function PMULHRSW.
+func (b *Builder) PMULHRSW(reg Register, rm RegMem) {
+	b.add("PMULHRSW", "PMULHRSW", reg, rm)
+}
+
+// PMULHUW appends PMULHUW to the program. See the function PMULHUW.
+func (b *Builder) PMULHUW(reg Register, rm RegMem) {
+	b.add("PMULHUW", "PMULHUW", reg, rm)
+}
+
+// PMULHW appends PMULHW to the program. See the function PMULHW.
+func (b *Builder) PMULHW(reg Register, rm RegMem) {
+	b.add("PMULHW", "PMULHW", reg, rm)
+}
+
+// PMULLD appends PMULLD to the program. See the function PMULLD.
+func (b *Builder) PMULLD(reg VecReg, rm RegMem) {
+	b.add("PMULLD", "PMULLD", reg, rm)
+}
+
+// PMULLW appends PMULLW to the program. See the function PMULLW.
+func (b *Builder) PMULLW(reg Register, rm RegMem) {
+	b.add("PMULLW", "PMULLW", reg, rm)
+}
+
+// PMULUDQ appends PMULUDQ to the program. See the function PMULUDQ.
+func (b *Builder) PMULUDQ(reg Register, rm RegMem) {
+	b.add("PMULUDQ", "PMULUDQ", reg, rm)
+}
+
+// POPA appends POPA to the program. See the function POPA.
+func (b *Builder) POPA() {
+	b.add("POPA", "POPA")
+}
+
+// POPAD appends POPAD to the program. See the function POPAD.
+func (b *Builder) POPAD() {
+	b.add("POPAD", "POPAD")
+}
+
+// POPCNT appends POPCNT to the program. See the function POPCNT.
+func (b *Builder) POPCNT(reg Reg, rm RegMem) {
+	b.add("POPCNT", "POPCNT", reg, rm)
+}
+
+// POPF appends POPF to the program. See the function POPF.
+func (b *Builder) POPF() {
+	b.add("POPF", "POPF")
+}
+
+// POPFD appends POPFD to the program. See the function POPFD.
+func (b *Builder) POPFD() {
+	b.add("POPFD", "POPFD")
+}
+
+// POPFQ appends POPFQ to the program. See the function POPFQ.
+func (b *Builder) POPFQ() {
+	b.add("POPFQ", "POPFQ")
+}
+
+// POP_M appends POP to the program. See the function POP_M.
+func (b *Builder) POP_M(rm RegMem) {
+	b.add("POP", "POP_M", rm)
+}
+
+// POP_NP appends POP to the program. See the function POP_NP.
+func (b *Builder) POP_NP(ds SegReg) {
+	b.add("POP", "POP_NP", ds)
+}
+
+// POP_O appends POP to the program. See the function POP_O.
+func (b *Builder) POP_O(opcode Reg) {
+	b.add("POP", "POP_O", opcode)
+}
+
+// POR appends POR to the program. See the function POR.
+func (b *Builder) POR(reg Register, rm RegMem) {
+	b.add("POR", "POR", reg, rm)
+}
+
+// PREFETCHNTA appends PREFETCHNTA to the program. See the function PREFETCHNTA.
+func (b *Builder) PREFETCHNTA(rm Mem) {
+	b.add("PREFETCHNTA", "PREFETCHNTA", rm)
+}
+
+// PREFETCHT0 appends PREFETCHT0 to the program. See the function PREFETCHT0.
+func (b *Builder) PREFETCHT0(rm Mem) {
+	b.add("PREFETCHT0", "PREFETCHT0", rm)
+}
+
+// PREFETCHT1 appends PREFETCHT1 to the program. See the function PREFETCHT1.
+func (b *Builder) PREFETCHT1(rm Mem) {
+	b.add("PREFETCHT1", "PREFETCHT1", rm)
+}
+
+// PREFETCHT2 appends PREFETCHT2 to the program. See the function PREFETCHT2.
+func (b *Builder) PREFETCHT2(rm Mem) {
+	b.add("PREFETCHT2", "PREFETCHT2", rm)
+}
+
+// PREFETCHW appends PREFETCHW to the program. See the function PREFETCHW.
+func (b *Builder) PREFETCHW(rm Mem) {
+	b.add("PREFETCHW", "PREFETCHW", rm)
+}
+
+// PREFETCHWT1 appends PREFETCHWT1 to the program. See the function PREFETCHWT1.
+func (b *Builder) PREFETCHWT1(rm Mem) {
+	b.add("PREFETCHWT1", "PREFETCHWT1", rm)
+}
+
+// PSADBW appends PSADBW to the program. See the function PSADBW.
+func (b *Builder) PSADBW(reg Register, rm RegMem) {
+	b.add("PSADBW", "PSADBW", reg, rm)
+}
+
+// PSHUFB appends PSHUFB to the program. See the function PSHUFB.
+func (b *Builder) PSHUFB(reg Register, rm RegMem) {
+	b.add("PSHUFB", "PSHUFB", reg, rm)
+}
+
+// PSHUFD appends PSHUFD to the program. See the function PSHUFD.
+func (b *Builder) PSHUFD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PSHUFD", "PSHUFD", reg, rm, imm)
+}
+
+// PSHUFHW appends PSHUFHW to the program. See the function PSHUFHW.
+func (b *Builder) PSHUFHW(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PSHUFHW", "PSHUFHW", reg, rm, imm)
+}
+
+// PSHUFLW appends PSHUFLW to the program. See the function PSHUFLW.
+func (b *Builder) PSHUFLW(reg VecReg, rm RegMem, imm Imm) {
+	b.add("PSHUFLW", "PSHUFLW", reg, rm, imm)
+}
+
+// PSHUFW appends PSHUFW to the program. See the function PSHUFW.
+func (b *Builder) PSHUFW(reg MMXReg, rm RegMem, imm Imm) {
+	b.add("PSHUFW", "PSHUFW", reg, rm, imm)
+}
+
+// PSIGNB appends PSIGNB to the program. See the function PSIGNB.
+func (b *Builder) PSIGNB(reg Register, rm RegMem) {
+	b.add("PSIGNB", "PSIGNB", reg, rm)
+}
+
+// PSIGND appends PSIGND to the program. See the function PSIGND.
+func (b *Builder) PSIGND(reg Register, rm RegMem) {
+	b.add("PSIGND", "PSIGND", reg, rm)
+}
+
+// PSIGNW appends PSIGNW to the program. See the function PSIGNW.
+func (b *Builder) PSIGNW(reg Register, rm RegMem) {
+	b.add("PSIGNW", "PSIGNW", reg, rm)
+}
+
+// PSLLDQ appends PSLLDQ to the program. See the function PSLLDQ.
+func (b *Builder) PSLLDQ(rm VecReg, imm Imm) {
+	b.add("PSLLDQ", "PSLLDQ", rm, imm)
+}
+
+// PSLLD_MI appends PSLLD to the program. See the function PSLLD_MI.
+func (b *Builder) PSLLD_MI(rm Register, imm Imm) {
+	b.add("PSLLD", "PSLLD_MI", rm, imm)
+}
+
+// PSLLD_RM appends PSLLD to the program. See the function PSLLD_RM.
+func (b *Builder) PSLLD_RM(reg Register, rm RegMem) {
+	b.add("PSLLD", "PSLLD_RM", reg, rm)
+}
+
+// PSLLQ_MI appends PSLLQ to the program. See the function PSLLQ_MI.
+func (b *Builder) PSLLQ_MI(rm Register, imm Imm) {
+	b.add("PSLLQ", "PSLLQ_MI", rm, imm)
+}
+
+// PSLLQ_RM appends PSLLQ to the program. See the function PSLLQ_RM.
+func (b *Builder) PSLLQ_RM(reg Register, rm RegMem) {
+	b.add("PSLLQ", "PSLLQ_RM", reg, rm)
+}
+
+// PSLLW_MI appends PSLLW to the program. See the function PSLLW_MI.
+func (b *Builder) PSLLW_MI(rm Register, imm Imm) {
+	b.add("PSLLW", "PSLLW_MI", rm, imm)
+}
+
+// PSLLW_RM appends PSLLW to the program. See the function PSLLW_RM.
+func (b *Builder) PSLLW_RM(reg Register, rm RegMem) {
+	b.add("PSLLW", "PSLLW_RM", reg, rm)
+}
+
+// PSRAD_MI appends PSRAD to the program. See the function PSRAD_MI.
+func (b *Builder) PSRAD_MI(rm Register, imm Imm) {
+	b.add("PSRAD", "PSRAD_MI", rm, imm)
+}
+
+// PSRAD_RM appends PSRAD to the program. See the function PSRAD_RM.
+func (b *Builder) PSRAD_RM(reg Register, rm RegMem) {
+	b.add("PSRAD", "PSRAD_RM", reg, rm)
+}
+
+// PSRAW_MI appends PSRAW to the program. See the function PSRAW_MI.
+func (b *Builder) PSRAW_MI(rm Register, imm Imm) {
+	b.add("PSRAW", "PSRAW_MI", rm, imm)
+}
+
+// PSRAW_RM appends PSRAW to the program. See the function PSRAW_RM.
+func (b *Builder) PSRAW_RM(reg Register, rm RegMem) {
+	b.add("PSRAW", "PSRAW_RM", reg, rm)
+}
+
+// PSRLDQ appends PSRLDQ to the program. See the function PSRLDQ.
+func (b *Builder) PSRLDQ(rm VecReg, imm Imm) {
+	b.add("PSRLDQ", "PSRLDQ", rm, imm)
+}
+
+// PSRLD_MI appends PSRLD to the program. See the function PSRLD_MI.
+func (b *Builder) PSRLD_MI(rm Register, imm Imm) {
+	b.add("PSRLD", "PSRLD_MI", rm, imm)
+}
+
+// PSRLD_RM appends PSRLD to the program. See the function PSRLD_RM.
+func (b *Builder) PSRLD_RM(reg Register, rm RegMem) {
+	b.add("PSRLD", "PSRLD_RM", reg, rm)
+}
+
+// PSRLQ_MI appends PSRLQ to the program. See the function PSRLQ_MI.
+func (b *Builder) PSRLQ_MI(rm Register, imm Imm) {
+	b.add("PSRLQ", "PSRLQ_MI", rm, imm)
+}
+
+// PSRLQ_RM appends PSRLQ to the program. See the function PSRLQ_RM.
+func (b *Builder) PSRLQ_RM(reg Register, rm RegMem) {
+	b.add("PSRLQ", "PSRLQ_RM", reg, rm)
+}
+
+// PSRLW_MI appends PSRLW to the program. See the function PSRLW_MI.
+func (b *Builder) PSRLW_MI(rm Register, imm Imm) {
+	b.add("PSRLW", "PSRLW_MI", rm, imm)
+}
+
+// PSRLW_RM appends PSRLW to the program. See the function PSRLW_RM.
+func (b *Builder) PSRLW_RM(reg Register, rm RegMem) {
+	b.add("PSRLW", "PSRLW_RM", reg, rm)
+}
+
+// PSUBB appends PSUBB to the program. See the function PSUBB.
+func (b *Builder) PSUBB(reg Register, rm RegMem) {
+	b.add("PSUBB", "PSUBB", reg, rm)
+}
+
+// PSUBD appends PSUBD to the program. See the function PSUBD.
+func (b *Builder) PSUBD(reg Register, rm RegMem) {
+	b.add("PSUBD", "PSUBD", reg, rm)
+}
+
+// PSUBQ appends PSUBQ to the program. See the function PSUBQ.
+func (b *Builder) PSUBQ(reg Register, rm RegMem) {
+	b.add("PSUBQ", "PSUBQ", reg, rm)
+}
+
+// PSUBSB appends PSUBSB to the program. See the function PSUBSB.
+func (b *Builder) PSUBSB(reg Register, rm RegMem) {
+	b.add("PSUBSB", "PSUBSB", reg, rm)
+}
+
+// PSUBSW appends PSUBSW to the program. See the function PSUBSW.
+func (b *Builder) PSUBSW(reg Register, rm RegMem) {
+	b.add("PSUBSW", "PSUBSW", reg, rm)
+}
+
+// PSUBUSB appends PSUBUSB to the program. See the function PSUBUSB.
+func (b *Builder) PSUBUSB(reg Register, rm RegMem) {
+	b.add("PSUBUSB", "PSUBUSB", reg, rm)
+}
+
+// PSUBUSW appends PSUBUSW to the program. See the function PSUBUSW.
+func (b *Builder) PSUBUSW(reg Register, rm RegMem) {
+	b.add("PSUBUSW", "PSUBUSW", reg, rm)
+}
+
+// PSUBW appends PSUBW to the program. See the function PSUBW.
+func (b *Builder) PSUBW(reg Register, rm RegMem) {
+	b.add("PSUBW", "PSUBW", reg, rm)
+}
+
+// PTEST appends PTEST to the program. See the function PTEST.
+func (b *Builder) PTEST(reg VecReg, rm RegMem) {
+	b.add("PTEST", "PTEST", reg, rm)
+}
+
+// PUNPCKHBW appends PUNPCKHBW to the program. See the function PUNPCKHBW.
+func (b *Builder) PUNPCKHBW(reg Register, rm RegMem) {
+	b.add("PUNPCKHBW", "PUNPCKHBW", reg, rm)
+}
+
+// PUNPCKHDQ appends PUNPCKHDQ to the program. See the function PUNPCKHDQ.
+func (b *Builder) PUNPCKHDQ(reg Register, rm RegMem) {
+	b.add("PUNPCKHDQ", "PUNPCKHDQ", reg, rm)
+}
+
+// PUNPCKHQDQ appends PUNPCKHQDQ to the program. See the function PUNPCKHQDQ.
+func (b *Builder) PUNPCKHQDQ(reg VecReg, rm RegMem) {
+	b.add("PUNPCKHQDQ", "PUNPCKHQDQ", reg, rm)
+}
+
+// PUNPCKHWD appends PUNPCKHWD to the program. See the function PUNPCKHWD.
+func (b *Builder) PUNPCKHWD(reg Register, rm RegMem) {
+	b.add("PUNPCKHWD", "PUNPCKHWD", reg, rm)
+}
+
+// PUNPCKLBW appends PUNPCKLBW to the program. See the function PUNPCKLBW.
+func (b *Builder) PUNPCKLBW(reg Register, rm RegMem) {
+	b.add("PUNPCKLBW", "PUNPCKLBW", reg, rm)
+}
+
+// PUNPCKLDQ appends PUNPCKLDQ to the program. See the function PUNPCKLDQ.
+func (b *Builder) PUNPCKLDQ(reg Register, rm RegMem) {
+	b.add("PUNPCKLDQ", "PUNPCKLDQ", reg, rm)
+}
+
+// PUNPCKLQDQ appends PUNPCKLQDQ to the program. See the function PUNPCKLQDQ.
+func (b *Builder) PUNPCKLQDQ(reg VecReg, rm RegMem) {
+	b.add("PUNPCKLQDQ", "PUNPCKLQDQ", reg, rm)
+}
+
+// PUNPCKLWD appends PUNPCKLWD to the program. See the function PUNPCKLWD.
+func (b *Builder) PUNPCKLWD(reg Register, rm RegMem) {
+	b.add("PUNPCKLWD", "PUNPCKLWD", reg, rm)
+}
+
+// PUSHA appends PUSHA to the program. See the function PUSHA.
+func (b *Builder) PUSHA() {
+	b.add("PUSHA", "PUSHA")
+}
+
+// PUSHAD appends PUSHAD to the program. See the function PUSHAD.
+func (b *Builder) PUSHAD() {
+	b.add("PUSHAD", "PUSHAD")
+}
+
+// PUSHF appends PUSHF to the program. See the function PUSHF.
+func (b *Builder) PUSHF() {
+	b.add("PUSHF", "PUSHF")
+}
+
+// PUSHFD appends PUSHFD to the program. See the function PUSHFD.
+func (b *Builder) PUSHFD() {
+	b.add("PUSHFD", "PUSHFD")
+}
+
+// PUSHFQ appends PUSHFQ to the program. See the function PUSHFQ.
+func (b *Builder) PUSHFQ() {
+	b.add("PUSHFQ", "PUSHFQ")
+}
+
+// PUSH_I appends PUSH to the program. See the function PUSH_I.
+func (b *Builder) PUSH_I(imm Imm) {
+	b.add("PUSH", "PUSH_I", imm)
+}
+
+// PUSH_M appends PUSH to the program. See the function PUSH_M.
+func (b *Builder) PUSH_M(rm RegMem) {
+	b.add("PUSH", "PUSH_M", rm)
+}
+
+// PUSH_NP appends PUSH to the program. See the function PUSH_NP.
+func (b *Builder) PUSH_NP(cs SegReg) {
+	b.add("PUSH", "PUSH_NP", cs)
+}
+
+// PUSH_O appends PUSH to the program. See the function PUSH_O.
+func (b *Builder) PUSH_O(opcode Reg) {
+	b.add("PUSH", "PUSH_O", opcode)
+}
+
+// PXOR appends PXOR to the program. See the function PXOR.
+func (b *Builder) PXOR(reg Register, rm RegMem) {
+	b.add("PXOR", "PXOR", reg, rm)
+}
+
+// RCL_M1 appends RCL to the program. See the function RCL_M1.
+func (b *Builder) RCL_M1(rm RegMem, v1 Imm) {
+	b.add("RCL", "RCL_M1", rm, v1)
+}
+
+// RCL_MC appends RCL to the program. See the function RCL_MC.
+func (b *Builder) RCL_MC(rm RegMem, cl Reg) {
+	b.add("RCL", "RCL_MC", rm, cl)
+}
+
+// RCL_MI appends RCL to the program. See the function RCL_MI.
+func (b *Builder) RCL_MI(rm RegMem, imm Imm) {
+	b.add("RCL", "RCL_MI", rm, imm)
+}
+
+// RCPPS appends RCPPS to the program. See the function RCPPS.
+func (b *Builder) RCPPS(reg VecReg, rm RegMem) {
+	b.add("RCPPS", "RCPPS", reg, rm)
+}
+
+// RCPSS appends RCPSS to the program. See the function RCPSS.
+func (b *Builder) RCPSS(reg VecReg, rm RegMem) {
+	b.add("RCPSS", "RCPSS", reg, rm)
+}
+
+// RCR_M1 appends RCR to the program. See the function RCR_M1.
+func (b *Builder) RCR_M1(rm RegMem, v1 Imm) {
+	b.add("RCR", "RCR_M1", rm, v1)
+}
+
+// RCR_MC appends RCR to the program. See the function RCR_MC.
+func (b *Builder) RCR_MC(rm RegMem, cl Reg) {
+	b.add("RCR", "RCR_MC", rm, cl)
+}
+
+// RCR_MI appends RCR to the program. See the function RCR_MI.
+func (b *Builder) RCR_MI(rm RegMem, imm Imm) {
+	b.add("RCR", "RCR_MI", rm, imm)
+}
+
+// RDFSBASE appends RDFSBASE to the program. See the function RDFSBASE.
+func (b *Builder) RDFSBASE(rm Reg) {
+	b.add("RDFSBASE", "RDFSBASE", rm)
+}
+
+// RDGSBASE appends RDGSBASE to the program. See the function RDGSBASE.
+func (b *Builder) RDGSBASE(rm Reg) {
+	b.add("RDGSBASE", "RDGSBASE", rm)
+}
+
+// RDMSR appends RDMSR to the program. See the function RDMSR.
+func (b *Builder) RDMSR() {
+	b.add("RDMSR", "RDMSR")
+}
+
+// RDPID appends RDPID to the program. See the function RDPID.
+func (b *Builder) RDPID(rm Reg) {
+	b.add("RDPID", "RDPID", rm)
+}
+
+// RDPKRU appends RDPKRU to the program. See the function RDPKRU.
+func (b *Builder) RDPKRU() {
+	b.add("RDPKRU", "RDPKRU")
+}
+
+// RDPMC appends RDPMC to the program. See the function RDPMC.
+func (b *Builder) RDPMC() {
+	b.add("RDPMC", "RDPMC")
+}
+
+// RDRAND appends RDRAND to the program. See the function RDRAND.
+func (b *Builder) RDRAND(rm Reg) {
+	b.add("RDRAND", "RDRAND", rm)
+}
+
+// RDSEED appends RDSEED to the program. See the function RDSEED.
+func (b *Builder) RDSEED(rm Reg) {
+	b.add("RDSEED", "RDSEED", rm)
+}
+
+// RDTSC appends RDTSC to the program. See the function RDTSC.
+func (b *Builder) RDTSC() {
+	b.add("RDTSC", "RDTSC")
+}
+
+// RDTSCP appends RDTSCP to the program. See the function RDTSCP.
+func (b *Builder) RDTSCP() {
+	b.add("RDTSCP", "RDTSCP")
+}
+
+// RET_FAR_I appends RET_FAR to the program. See the function RET_FAR_I.
+func (b *Builder) RET_FAR_I(imm Imm) {
+	b.add("RET_FAR", "RET_FAR_I", imm)
+}
+
+// RET_FAR_ZO appends RET_FAR to the program. See the function RET_FAR_ZO.
+func (b *Builder) RET_FAR_ZO() {
+	b.add("RET_FAR", "RET_FAR_ZO")
+}
+
+// RET_I appends RET to the program. See the function RET_I.
+func (b *Builder) RET_I(imm Imm) {
+	b.add("RET", "RET_I", imm)
+}
+
+// RET_NP appends RET to the program. See the function RET_NP.
+func (b *Builder) RET_NP() {
+	b.add("RET", "RET_NP")
+}
+
+// ROL_M1 appends ROL to the program. See the function ROL_M1.
+func (b *Builder) ROL_M1(rm RegMem, v1 Imm) {
+	b.add("ROL", "ROL_M1", rm, v1)
+}
+
+// ROL_MC appends ROL to the program. See the function ROL_MC.
+func (b *Builder) ROL_MC(rm RegMem, cl Reg) {
+	b.add("ROL", "ROL_MC", rm, cl)
+}
+
+// ROL_MI appends ROL to the program. See the function ROL_MI.
+func (b *Builder) ROL_MI(rm RegMem, imm Imm) {
+	b.add("ROL", "ROL_MI", rm, imm)
+}
+
+// RORX appends RORX to the program. See the function RORX.
+func (b *Builder) RORX(reg Reg, rm RegMem, imm Imm) {
+	b.add("RORX", "RORX", reg, rm, imm)
+}
+
+// ROR_M1 appends ROR to the program. See the function ROR_M1.
+func (b *Builder) ROR_M1(rm RegMem, v1 Imm) {
+	b.add("ROR", "ROR_M1", rm, v1)
+}
+
+// ROR_MC appends ROR to the program. See the function ROR_MC.
+func (b *Builder) ROR_MC(rm RegMem, cl Reg) {
+	b.add("ROR", "ROR_MC", rm, cl)
+}
+
+// ROR_MI appends ROR to the program. See the function ROR_MI.
+func (b *Builder) ROR_MI(rm RegMem, imm Imm) {
+	b.add("ROR", "ROR_MI", rm, imm)
+}
+
+// ROUNDPD appends ROUNDPD to the program. See the function ROUNDPD.
+func (b *Builder) ROUNDPD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("ROUNDPD", "ROUNDPD", reg, rm, imm)
+}
+
+// ROUNDPS appends ROUNDPS to the program. See the function ROUNDPS.
+func (b *Builder) ROUNDPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("ROUNDPS", "ROUNDPS", reg, rm, imm)
+}
+
+// ROUNDSD appends ROUNDSD to the program. See the function ROUNDSD.
+func (b *Builder) ROUNDSD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("ROUNDSD", "ROUNDSD", reg, rm, imm)
+}
+
+// ROUNDSS appends ROUNDSS to the program. See the function ROUNDSS.
+func (b *Builder) ROUNDSS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("ROUNDSS", "ROUNDSS", reg, rm, imm)
+}
+
+// RSM appends RSM to the program. See the function RSM.
+func (b *Builder) RSM() {
+	b.add("RSM", "RSM")
+}
+
+// RSQRTPS appends RSQRTPS to the program. See the function RSQRTPS.
+func (b *Builder) RSQRTPS(reg VecReg, rm RegMem) {
+	b.add("RSQRTPS", "RSQRTPS", reg, rm)
+}
+
+// RSQRTSS appends RSQRTSS to the program. See the function RSQRTSS.
+func (b *Builder) RSQRTSS(reg VecReg, rm RegMem) {
+	b.add("RSQRTSS", "RSQRTSS", reg, rm)
+}
+
+// SAHF appends SAHF to the program. See the function SAHF.
+func (b *Builder) SAHF() {
+	b.add("SAHF", "SAHF")
+}
+
+// SAL_M1 appends SAL to the program. See the function SAL_M1.
+func (b *Builder) SAL_M1(rm RegMem, v1 Imm) {
+	b.add("SAL", "SAL_M1", rm, v1)
+}
+
+// SAL_MC appends SAL to the program. See the function SAL_MC.
+func (b *Builder) SAL_MC(rm RegMem, cl Reg) {
+	b.add("SAL", "SAL_MC", rm, cl)
+}
+
+// SAL_MI appends SAL to the program. See the function SAL_MI.
+func (b *Builder) SAL_MI(rm RegMem, imm Imm) {
+	b.add("SAL", "SAL_MI", rm, imm)
+}
+
+// SARX appends SARX to the program. See the function SARX.
+func (b *Builder) SARX(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SARX", "SARX", reg, rm, vvvv)
+}
+
+// SAR_M1 appends SAR to the program. See the function SAR_M1.
+func (b *Builder) SAR_M1(rm RegMem, v1 Imm) {
+	b.add("SAR", "SAR_M1", rm, v1)
+}
+
+// SAR_MC appends SAR to the program. See the function SAR_MC.
+func (b *Builder) SAR_MC(rm RegMem, cl Reg) {
+	b.add("SAR", "SAR_MC", rm, cl)
+}
+
+// SAR_MI appends SAR to the program. See the function SAR_MI.
+func (b *Builder) SAR_MI(rm RegMem, imm Imm) {
+	b.add("SAR", "SAR_MI", rm, imm)
+}
+
+// SBB_I appends SBB to the program. See the function SBB_I.
+func (b *Builder) SBB_I(al Reg, imm Imm) {
+	b.add("SBB", "SBB_I", al, imm)
+}
+
+// SBB_MI appends SBB to the program. See the function SBB_MI.
+func (b *Builder) SBB_MI(rm RegMem, imm Imm) {
+	b.add("SBB", "SBB_MI", rm, imm)
+}
+
+// SBB_MR appends SBB to the program. See the function SBB_MR.
+func (b *Builder) SBB_MR(rm RegMem, reg Reg) {
+	b.add("SBB", "SBB_MR", rm, reg)
+}
+
+// SBB_RM appends SBB to the program. See the function SBB_RM.
+func (b *Builder) SBB_RM(reg Reg, rm RegMem) {
+	b.add("SBB", "SBB_RM", reg, rm)
+}
+
+// SCASB appends SCASB to the program. See the function SCASB.
+func (b *Builder) SCASB() {
+	b.add("SCASB", "SCASB")
+}
+
+// SCASD appends SCASD to the program. See the function SCASD.
+func (b *Builder) SCASD() {
+	b.add("SCASD", "SCASD")
+}
+
+// SCASQ appends SCASQ to the program. See the function SCASQ.
+func (b *Builder) SCASQ() {
+	b.add("SCASQ", "SCASQ")
+}
+
+// SCASW appends SCASW to the program. See the function SCASW.
+func (b *Builder) SCASW() {
+	b.add("SCASW", "SCASW")
+}
+
+// SETA appends SETA to the program. See the function SETA.
+func (b *Builder) SETA(rm RegMem) {
+	b.add("SETA", "SETA", rm)
+}
+
+// SETAE appends SETAE to the program. See the function SETAE.
+func (b *Builder) SETAE(rm RegMem) {
+	b.add("SETAE", "SETAE", rm)
+}
+
+// SETB appends SETB to the program. See the function SETB.
+func (b *Builder) SETB(rm RegMem) {
+	b.add("SETB", "SETB", rm)
+}
+
+// SETBE appends SETBE to the program. See the function SETBE.
+func (b *Builder) SETBE(rm RegMem) {
+	b.add("SETBE", "SETBE", rm)
+}
+
+// SETC appends SETC to the program. See the function SETC.
+func (b *Builder) SETC(rm RegMem) {
+	b.add("SETC", "SETC", rm)
+}
+
+// SETE appends SETE to the program. See the function SETE.
+func (b *Builder) SETE(rm RegMem) {
+	b.add("SETE", "SETE", rm)
+}
+
+// SETG appends SETG to the program. See the function SETG.
+func (b *Builder) SETG(rm RegMem) {
+	b.add("SETG", "SETG", rm)
+}
+
+// SETGE appends SETGE to the program. See the function SETGE.
+func (b *Builder) SETGE(rm RegMem) {
+	b.add("SETGE", "SETGE", rm)
+}
+
+// SETL appends SETL to the program. See the function SETL.
+func (b *Builder) SETL(rm RegMem) {
+	b.add("SETL", "SETL", rm)
+}
+
+// SETLE appends SETLE to the program. See the function SETLE.
+func (b *Builder) SETLE(rm RegMem) {
+	b.add("SETLE", "SETLE", rm)
+}
+
+// SETNA appends SETNA to the program. See the function SETNA.
+func (b *Builder) SETNA(rm RegMem) {
+	b.add("SETNA", "SETNA", rm)
+}
+
+// SETNAE appends SETNAE to the program. See the function SETNAE.
+func (b *Builder) SETNAE(rm RegMem) {
+	b.add("SETNAE", "SETNAE", rm)
+}
+
+// SETNB appends SETNB to the program. See the function SETNB.
+func (b *Builder) SETNB(rm RegMem) {
+	b.add("SETNB", "SETNB", rm)
+}
+
+// SETNBE appends SETNBE to the program. See the function SETNBE.
+func (b *Builder) SETNBE(rm RegMem) {
+	b.add("SETNBE", "SETNBE", rm)
+}
+
+// SETNC appends SETNC to the program. See the function SETNC.
+func (b *Builder) SETNC(rm RegMem) {
+	b.add("SETNC", "SETNC", rm)
+}
+
+// SETNE appends SETNE to the program. See the function SETNE.
+func (b *Builder) SETNE(rm RegMem) {
+	b.add("SETNE", "SETNE", rm)
+}
+
+// SETNG appends SETNG to the program. See the function SETNG.
+func (b *Builder) SETNG(rm RegMem) {
+	b.add("SETNG", "SETNG", rm)
+}
+
+// SETNGE appends SETNGE to the program. See the function SETNGE.
+func (b *Builder) SETNGE(rm RegMem) {
+	b.add("SETNGE", "SETNGE", rm)
+}
+
+// SETNL appends SETNL to the program. See the function SETNL.
+func (b *Builder) SETNL(rm RegMem) {
+	b.add("SETNL", "SETNL", rm)
+}
+
+// SETNLE appends SETNLE to the program. See the function SETNLE.
+func (b *Builder) SETNLE(rm RegMem) {
+	b.add("SETNLE", "SETNLE", rm)
+}
+
+// SETNO appends SETNO to the program. See the function SETNO.
+func (b *Builder) SETNO(rm RegMem) {
+	b.add("SETNO", "SETNO", rm)
+}
+
+// SETNP appends SETNP to the program. See the function SETNP.
+func (b *Builder) SETNP(rm RegMem) {
+	b.add("SETNP", "SETNP", rm)
+}
+
+// SETNS appends SETNS to the program. See the function SETNS.
+func (b *Builder) SETNS(rm RegMem) {
+	b.add("SETNS", "SETNS", rm)
+}
+
+// SETNZ appends SETNZ to the program. See the function SETNZ.
+func (b *Builder) SETNZ(rm RegMem) {
+	b.add("SETNZ", "SETNZ", rm)
+}
+
+// SETO appends SETO to the program. See the function SETO.
+func (b *Builder) SETO(rm RegMem) {
+	b.add("SETO", "SETO", rm)
+}
+
+// SETP appends SETP to the program. See the function SETP.
+func (b *Builder) SETP(rm RegMem) {
+	b.add("SETP", "SETP", rm)
+}
+
+// SETPE appends SETPE to the program. See the function SETPE.
+func (b *Builder) SETPE(rm RegMem) {
+	b.add("SETPE", "SETPE", rm)
+}
+
+// SETPO appends SETPO to the program. See the function SETPO.
+func (b *Builder) SETPO(rm RegMem) {
+	b.add("SETPO", "SETPO", rm)
+}
+
+// SETS appends SETS to the program. See the function SETS.
+func (b *Builder) SETS(rm RegMem) {
+	b.add("SETS", "SETS", rm)
+}
+
+// SETZ appends SETZ to the program. See the function SETZ.
+func (b *Builder) SETZ(rm RegMem) {
+	b.add("SETZ", "SETZ", rm)
+}
+
+// SFENCE appends SFENCE to the program. See the function SFENCE.
+func (b *Builder) SFENCE() {
+	b.add("SFENCE", "SFENCE")
+}
+
+// SGDT appends SGDT to the program. See the function SGDT.
+func (b *Builder) SGDT(rm Mem) {
+	b.add("SGDT", "SGDT", rm)
+}
+
+// SHA1MSG1 appends SHA1MSG1 to the program. See the function SHA1MSG1.
+func (b *Builder) SHA1MSG1(reg VecReg, rm RegMem) {
+	b.add("SHA1MSG1", "SHA1MSG1", reg, rm)
+}
+
+// SHA1MSG2 appends SHA1MSG2 to the program. See the function SHA1MSG2.
+func (b *Builder) SHA1MSG2(reg VecReg, rm RegMem) {
+	b.add("SHA1MSG2", "SHA1MSG2", reg, rm)
+}
+
+// SHA1NEXTE appends SHA1NEXTE to the program. See the function SHA1NEXTE.
+func (b *Builder) SHA1NEXTE(reg VecReg, rm RegMem) {
+	b.add("SHA1NEXTE", "SHA1NEXTE", reg, rm)
+}
+
+// SHA1RNDS4 appends SHA1RNDS4 to the program. See the function SHA1RNDS4.
+func (b *Builder) SHA1RNDS4(reg VecReg, rm RegMem, imm Imm) {
+	b.add("SHA1RNDS4", "SHA1RNDS4", reg, rm, imm)
+}
+
+// SHA256MSG1 appends SHA256MSG1 to the program. See the function SHA256MSG1.
+func (b *Builder) SHA256MSG1(reg VecReg, rm RegMem) {
+	b.add("SHA256MSG1", "SHA256MSG1", reg, rm)
+}
+
+// SHA256MSG2 appends SHA256MSG2 to the program. See the function SHA256MSG2.
+func (b *Builder) SHA256MSG2(reg VecReg, rm RegMem) {
+	b.add("SHA256MSG2", "SHA256MSG2", reg, rm)
+}
+
+// SHA256RNDS2 appends SHA256RNDS2 to the program. See the function SHA256RNDS2.
+func (b *Builder) SHA256RNDS2(reg VecReg, rm RegMem, xmm0 VecReg) {
+	b.add("SHA256RNDS2", "SHA256RNDS2", reg, rm, xmm0)
+}
+
+// SHLD_MRC appends SHLD to the program. See the function SHLD_MRC.
+func (b *Builder) SHLD_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHLD", "SHLD_MRC", rm, reg, cl)
+}
+
+// SHLD_MRI appends SHLD to the program. See the function SHLD_MRI.
+func (b *Builder) SHLD_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHLD", "SHLD_MRI", rm, reg, imm)
+}
+
+// SHLX appends SHLX to the program. See the function SHLX.
+func (b *Builder) SHLX(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SHLX", "SHLX", reg, rm, vvvv)
+}
+
+// SHL_M1 appends SHL to the program. See the function SHL_M1.
+func (b *Builder) SHL_M1(rm RegMem, v1 Imm) {
+	b.add("SHL", "SHL_M1", rm, v1)
+}
+
+// SHL_MC appends SHL to the program. See the function SHL_MC.
+func (b *Builder) SHL_MC(rm RegMem, cl Reg) {
+	b.add("SHL", "SHL_MC", rm, cl)
+}
+
+// SHL_MI appends SHL to the program. See the function SHL_MI.
+func (b *Builder) SHL_MI(rm RegMem, imm Imm) {
+	b.add("SHL", "SHL_MI", rm, imm)
+}
+
+// SHRD_MRC appends SHRD to the program. See the function SHRD_MRC.
+func (b *Builder) SHRD_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHRD", "SHRD_MRC", rm, reg, cl)
+}
+
+// SHRD_MRI appends SHRD to the program. See the function SHRD_MRI.
+func (b *Builder) SHRD_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHRD", "SHRD_MRI", rm, reg, imm)
+}
+
+// SHRX appends SHRX to the program. See the function SHRX.
+func (b *Builder) SHRX(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SHRX", "SHRX", reg, rm, vvvv)
+}
+
+// SHR_M1 appends SHR to the program. See the function SHR_M1.
+func (b *Builder) SHR_M1(rm RegMem, v1 Imm) {
+	b.add("SHR", "SHR_M1", rm, v1)
+}
+
+// SHR_MC appends SHR to the program. See the function SHR_MC.
+func (b *Builder) SHR_MC(rm RegMem, cl Reg) {
+	b.add("SHR", "SHR_MC", rm, cl)
+}
+
+// SHR_MI appends SHR to the program. See the function SHR_MI.
+func (b *Builder) SHR_MI(rm RegMem, imm Imm) {
+	b.add("SHR", "SHR_MI", rm, imm)
+}
+
+// SHUFPD appends SHUFPD to the program. See the function SHUFPD.
+func (b *Builder) SHUFPD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("SHUFPD", "SHUFPD", reg, rm, imm)
+}
+
+// SHUFPS appends SHUFPS to the program. See the function SHUFPS.
+func (b *Builder) SHUFPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("SHUFPS", "SHUFPS", reg, rm, imm)
+}
+
+// SIDT appends SIDT to the program. See the function SIDT.
+func (b *Builder) SIDT(rm Mem) {
+	b.add("SIDT", "SIDT", rm)
+}
+
+// SLDT appends SLDT to the program. See the function SLDT.
+func (b *Builder) SLDT(rm RegMem) {
+	b.add("SLDT", "SLDT", rm)
+}
+
+// SMSW appends SMSW to the program. See the function SMSW.
+func (b *Builder) SMSW(rm RegMem) {
+	b.add("SMSW", "SMSW", rm)
+}
+
+// SQRTPD appends SQRTPD to the program. See the function SQRTPD.
+func (b *Builder) SQRTPD(reg VecReg, rm RegMem) {
+	b.add("SQRTPD", "SQRTPD", reg, rm)
+}
+
+// SQRTPS appends SQRTPS to the program. See the function SQRTPS.
+func (b *Builder) SQRTPS(reg VecReg, rm RegMem) {
+	b.add("SQRTPS", "SQRTPS", reg, rm)
+}
+
+// SQRTSD appends SQRTSD to the program. See the function SQRTSD.
+func (b *Builder) SQRTSD(reg VecReg, rm RegMem) {
+	b.add("SQRTSD", "SQRTSD", reg, rm)
+}
+
+// SQRTSS appends SQRTSS to the program. See the function SQRTSS.
+func (b *Builder) SQRTSS(reg VecReg, rm RegMem) {
+	b.add("SQRTSS", "SQRTSS", reg, rm)
+}
+
+// STAC appends STAC to the program. See the function STAC.
+func (b *Builder) STAC() {
+	b.add("STAC", "STAC")
+}
+
+// STC appends STC to the program. See the function STC.
+func (b *Builder) STC() {
+	b.add("STC", "STC")
+}
+
+// STD appends STD to the program. See the function STD.
+func (b *Builder) STD() {
+	b.add("STD", "STD")
+}
+
+// STI appends STI to the program. See the function STI.
+func (b *Builder) STI() {
+	b.add("STI", "STI")
+}
+
+// STMXCSR appends STMXCSR to the program. See the function STMXCSR.
+func (b *Builder) STMXCSR(rm Mem) {
+	b.add("STMXCSR", "STMXCSR", rm)
+}
+
+// STOSB appends STOSB to the program. See the function STOSB.
+func (b *Builder) STOSB() {
+	b.add("STOSB", "STOSB")
+}
+
+// STOSD appends STOSD to the program. See the function STOSD.
+func (b *Builder) STOSD() {
+	b.add("STOSD", "STOSD")
+}
+
+// STOSQ appends STOSQ to the program. See the function STOSQ.
+func (b *Builder) STOSQ() {
+	b.add("STOSQ", "STOSQ")
+}
+
+// STOSW appends STOSW to the program. See the function STOSW.
+func (b *Builder) STOSW() {
+	b.add("STOSW", "STOSW")
+}
+
+// STR appends STR to the program. See the function STR.
+func (b *Builder) STR(rm RegMem) {
+	b.add("STR", "STR", rm)
+}
+
+// SUBPD appends SUBPD to the program. See the function SUBPD.
+func (b *Builder) SUBPD(reg VecReg, rm RegMem) {
+	b.add("SUBPD", "SUBPD", reg, rm)
+}
+
+// SUBPS appends SUBPS to the program. See the function SUBPS.
+func (b *Builder) SUBPS(reg VecReg, rm RegMem) {
+	b.add("SUBPS", "SUBPS", reg, rm)
+}
+
+// SUBSD appends SUBSD to the program. See the function SUBSD.
+func (b *Builder) SUBSD(reg VecReg, rm RegMem) {
+	b.add("SUBSD", "SUBSD", reg, rm)
+}
+
+// SUBSS appends SUBSS to the program. See the function SUBSS.
+func (b *Builder) SUBSS(reg VecReg, rm RegMem) {
+	b.add("SUBSS", "SUBSS", reg, rm)
+}
+
+// SUB_I appends SUB to the program. See the function SUB_I.
+func (b *Builder) SUB_I(al Reg, imm Imm) {
+	b.add("SUB", "SUB_I", al, imm)
+}
+
+// SUB_MI appends SUB to the program. See the function SUB_MI.
+func (b *Builder) SUB_MI(rm RegMem, imm Imm) {
+	b.add("SUB", "SUB_MI", rm, imm)
+}
+
+// SUB_MR appends SUB to the program. See the function SUB_MR.
+func (b *Builder) SUB_MR(rm RegMem, reg Reg) {
+	b.add("SUB", "SUB_MR", rm, reg)
+}
+
+// SUB_RM appends SUB to the program. See the function SUB_RM.
+func (b *Builder) SUB_RM(reg Reg, rm RegMem) {
+	b.add("SUB", "SUB_RM", reg, rm)
+}
+
+// SWAPGS appends SWAPGS to the program. See the function SWAPGS.
+func (b *Builder) SWAPGS() {
+	b.add("SWAPGS", "SWAPGS")
+}
+
+// SYSCALL appends SYSCALL to the program. See the function SYSCALL.
+func (b *Builder) SYSCALL() {
+	b.add("SYSCALL", "SYSCALL")
+}
+
+// SYSENTER appends SYSENTER to the program. See the function SYSENTER.
+func (b *Builder) SYSENTER() {
+	b.add("SYSENTER", "SYSENTER")
+}
+
+// SYSEXIT appends SYSEXIT to the program. See the function SYSEXIT.
+func (b *Builder) SYSEXIT() {
+	b.add("SYSEXIT", "SYSEXIT")
+}
+
+// SYSRET appends SYSRET to the program. See the function SYSRET.
+func (b *Builder) SYSRET() {
+	b.add("SYSRET", "SYSRET")
+}
+
+// TEST_I appends TEST to the program. See the function TEST_I.
+func (b *Builder) TEST_I(al Reg, imm Imm) {
+	b.add("TEST", "TEST_I", al, imm)
+}
+
+// TEST_MI appends TEST to the program. See the function TEST_MI.
+func (b *Builder) TEST_MI(rm RegMem, imm Imm) {
+	b.add("TEST", "TEST_MI", rm, imm)
+}
+
+// TEST_MR appends TEST to the program. See the function TEST_MR.
+func (b *Builder) TEST_MR(rm RegMem, reg Reg) {
+	b.add("TEST", "TEST_MR", rm, reg)
+}
+
+// TZCNT appends TZCNT to the program. See the function TZCNT.
+func (b *Builder) TZCNT(reg Reg, rm RegMem) {
+	b.add("TZCNT", "TZCNT", reg, rm)
+}
+
+// UCOMISD appends UCOMISD to the program. See the function UCOMISD.
+func (b *Builder) UCOMISD(reg VecReg, rm RegMem) {
+	b.add("UCOMISD", "UCOMISD", reg, rm)
+}
+
+// UCOMISS appends UCOMISS to the program. See the function UCOMISS.
+func (b *Builder) UCOMISS(reg VecReg, rm RegMem) {
+	b.add("UCOMISS", "UCOMISS", reg, rm)
+}
+
+// UD1 appends UD1 to the program. See the function UD1.
+func (b *Builder) UD1() {
+	b.add("UD1", "UD1")
+}
+
+// UD2 appends UD2 to the program. See the function UD2.
+func (b *Builder) UD2() {
+	b.add("UD2", "UD2")
+}
+
+// UNPCKHPD appends UNPCKHPD to the program. See the function UNPCKHPD.
+func (b *Builder) UNPCKHPD(reg VecReg, rm RegMem) {
+	b.add("UNPCKHPD", "UNPCKHPD", reg, rm)
+}
+
+// UNPCKHPS appends UNPCKHPS to the program. See the function UNPCKHPS.
+func (b *Builder) UNPCKHPS(reg VecReg, rm RegMem) {
+	b.add("UNPCKHPS", "UNPCKHPS", reg, rm)
+}
+
+// UNPCKLPD appends UNPCKLPD to the program. See the function UNPCKLPD.
+func (b *Builder) UNPCKLPD(reg VecReg, rm RegMem) {
+	b.add("UNPCKLPD", "UNPCKLPD", reg, rm)
+}
+
+// UNPCKLPS appends UNPCKLPS to the program. See the function UNPCKLPS.
+func (b *Builder) UNPCKLPS(reg VecReg, rm RegMem) {
+	b.add("UNPCKLPS", "UNPCKLPS", reg, rm)
+}
+
+// VADDPD_FV appends VADDPD to the program. See the function VADDPD_FV.
+func (b *Builder) VADDPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VADDPD", "VADDPD_FV", reg, vvvv, rm)
+}
+
+// VADDPD_RVM appends VADDPD to the program. See the function VADDPD_RVM.
+func (b *Builder) VADDPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VADDPD", "VADDPD_RVM", reg, vvvv, rm)
+}
+
+// VADDPS_FV appends VADDPS to the program. See the function VADDPS_FV.
+func (b *Builder) VADDPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VADDPS", "VADDPS_FV", reg, vvvv, rm)
+}
+
+// VADDPS_RVM appends VADDPS to the program. See the function VADDPS_RVM.
+func (b *Builder) VADDPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VADDPS", "VADDPS_RVM", reg, vvvv, rm)
+}
+
+// VADDSD_RVM appends VADDSD to the program. See the function VADDSD_RVM.
+func (b *Builder) VADDSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VADDSD", "VADDSD_RVM", reg, vvvv, rm)
+}
+
+// VADDSD_T1S appends VADDSD to the program. See the function VADDSD_T1S.
+func (b *Builder) VADDSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VADDSD", "VADDSD_T1S", reg, vvvv, rm)
+}
+
+// VADDSS_RVM appends VADDSS to the program. See the function VADDSS_RVM.
+func (b *Builder) VADDSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VADDSS", "VADDSS_RVM", reg, vvvv, rm)
+}
+
+// VADDSS_T1S appends VADDSS to the program. See the function VADDSS_T1S.
+func (b *Builder) VADDSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VADDSS", "VADDSS_T1S", reg, vvvv, rm)
+}
+
+// VADDSUBPD appends VADDSUBPD to the program. See the function VADDSUBPD.
+func (b *Builder) VADDSUBPD(reg, vvvv VecReg, rm RegMem) {
+	b.add("VADDSUBPD", "VADDSUBPD", reg, vvvv, rm)
+}
+
+// VADDSUBPS appends VADDSUBPS to the program. See the function VADDSUBPS.
+func (b *Builder) VADDSUBPS(reg, vvvv VecReg, rm RegMem) {
+	b.add("VADDSUBPS", "VADDSUBPS", reg, vvvv, rm)
+}
+
+// VAESDEC appends VAESDEC to the program. See the function VAESDEC.
+func (b *Builder) VAESDEC(reg, vvvv VecReg, rm RegMem) {
+	b.add("VAESDEC", "VAESDEC", reg, vvvv, rm)
+}
+
+// VAESDECLAST appends VAESDECLAST to the program. See the function VAESDECLAST.
+func (b *Builder) VAESDECLAST(reg, vvvv VecReg, rm RegMem) {
+	b.add("VAESDECLAST", "VAESDECLAST", reg, vvvv, rm)
+}
+
+// VAESENC appends VAESENC to the program. See the function VAESENC.
+func (b *Builder) VAESENC(reg, vvvv VecReg, rm RegMem) {
+	b.add("VAESENC", "VAESENC", reg, vvvv, rm)
+}
+
+// VAESENCLAST appends VAESENCLAST to the program. See the function VAESENCLAST.
+func (b *Builder) VAESENCLAST(reg, vvvv VecReg, rm RegMem) {
+	b.add("VAESENCLAST", "VAESENCLAST", reg, vvvv, rm)
+}
+
+// VAESIMC appends VAESIMC to the program. See the function VAESIMC.
+func (b *Builder) VAESIMC(reg VecReg, rm RegMem) {
+	b.add("VAESIMC", "VAESIMC", reg, rm)
+}
+
+// VAESKEYGENASSIST appends VAESKEYGENASSIST to the program. See the function VAESKEYGENASSIST.
+func (b *Builder) VAESKEYGENASSIST(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VAESKEYGENASSIST", "VAESKEYGENASSIST", reg, rm, imm)
+}
+
+// VALIGND appends VALIGND to the program. See the function VALIGND.
+func (b *Builder) VALIGND(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VALIGND", "VALIGND", reg, vvvv, rm, imm)
+}
+
+// VALIGNQ appends VALIGNQ to the program. See the function VALIGNQ.
+func (b *Builder) VALIGNQ(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VALIGNQ", "VALIGNQ", reg, vvvv, rm, imm)
+}
+
+// VANDNPD_FV appends VANDNPD to the program. See the function VANDNPD_FV.
+func (b *Builder) VANDNPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VANDNPD", "VANDNPD_FV", reg, vvvv, rm)
+}
+
+// VANDNPD_RVM appends VANDNPD to the program. See the function VANDNPD_RVM.
+func (b *Builder) VANDNPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VANDNPD", "VANDNPD_RVM", reg, vvvv, rm)
+}
+
+// VANDNPS_FV appends VANDNPS to the program. See the function VANDNPS_FV.
+func (b *Builder) VANDNPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VANDNPS", "VANDNPS_FV", reg, vvvv, rm)
+}
+
+// VANDNPS_RVM appends VANDNPS to the program. See the function VANDNPS_RVM.
+func (b *Builder) VANDNPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VANDNPS", "VANDNPS_RVM", reg, vvvv, rm)
+}
+
+// VANDPD_FV appends VANDPD to the program. See the function VANDPD_FV.
+func (b *Builder) VANDPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VANDPD", "VANDPD_FV", reg, vvvv, rm)
+}
+
+// VANDPD_RVM appends VANDPD to the program. See the function VANDPD_RVM.
+func (b *Builder) VANDPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VANDPD", "VANDPD_RVM", reg, vvvv, rm)
+}
+
+// VANDPS_FV appends VANDPS to the program. See the function VANDPS_FV.
+func (b *Builder) VANDPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VANDPS", "VANDPS_FV", reg, vvvv, rm)
+}
+
+// VANDPS_RVM appends VANDPS to the program. See the function VANDPS_RVM.
+func (b *Builder) VANDPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VANDPS", "VANDPS_RVM", reg, vvvv, rm)
+}
+
+// VBLENDMPD appends VBLENDMPD to the program. See the function VBLENDMPD.
+func (b *Builder) VBLENDMPD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VBLENDMPD", "VBLENDMPD", reg, vvvv, rm)
+}
+
+// VBLENDMPS appends VBLENDMPS to the program. See the function VBLENDMPS.
+func (b *Builder) VBLENDMPS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VBLENDMPS", "VBLENDMPS", reg, vvvv, rm)
+}
+
+// VBLENDPD appends VBLENDPD to the program. See the function VBLENDPD.
+func (b *Builder) VBLENDPD(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VBLENDPD", "VBLENDPD", reg, vvvv, rm, imm)
+}
+
+// VBLENDPS appends VBLENDPS to the program. See the function VBLENDPS.
+func (b *Builder) VBLENDPS(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VBLENDPS", "VBLENDPS", reg, vvvv, rm, imm)
+}
+
+// VBLENDVPD appends VBLENDVPD to the program. See the function VBLENDVPD.
+func (b *Builder) VBLENDVPD(reg, vvvv VecReg, rm RegMem, is4 VecReg) {
+	b.add("VBLENDVPD", "VBLENDVPD", reg, vvvv, rm, is4)
+}
+
+// VBLENDVPS appends VBLENDVPS to the program. See the function VBLENDVPS.
+func (b *Builder) VBLENDVPS(reg, vvvv VecReg, rm RegMem, is4 VecReg) {
+	b.add("VBLENDVPS", "VBLENDVPS", reg, vvvv, rm, is4)
+}
+
+// VBROADCASTF128 appends VBROADCASTF128 to the program. See the function VBROADCASTF128.
+func (b *Builder) VBROADCASTF128(reg VecReg, rm Mem) {
+	b.add("VBROADCASTF128", "VBROADCASTF128", reg, rm)
+}
+
+// VBROADCASTF32X2 appends VBROADCASTF32X2 to the program. See the function VBROADCASTF32X2.
+func (b *Builder) VBROADCASTF32X2(reg Register, rm RegMem) {
+	b.add("VBROADCASTF32X2", "VBROADCASTF32X2", reg, rm)
+}
+
+// VBROADCASTF32X4 appends VBROADCASTF32X4 to the program. See the function VBROADCASTF32X4.
+func (b *Builder) VBROADCASTF32X4(reg Register, rm Mem) {
+	b.add("VBROADCASTF32X4", "VBROADCASTF32X4", reg, rm)
+}
+
+// VBROADCASTF32X8 appends VBROADCASTF32X8 to the program. See the function VBROADCASTF32X8.
+func (b *Builder) VBROADCASTF32X8(reg Register, rm Mem) {
+	b.add("VBROADCASTF32X8", "VBROADCASTF32X8", reg, rm)
+}
+
+// VBROADCASTF64X2 appends VBROADCASTF64X2 to the program. See the function VBROADCASTF64X2.
+func (b *Builder) VBROADCASTF64X2(reg Register, rm Mem) {
+	b.add("VBROADCASTF64X2", "VBROADCASTF64X2", reg, rm)
+}
+
+// VBROADCASTF64X4 appends VBROADCASTF64X4 to the program. See the function VBROADCASTF64X4.
+func (b *Builder) VBROADCASTF64X4(reg Register, rm Mem) {
+	b.add("VBROADCASTF64X4", "VBROADCASTF64X4", reg, rm)
+}
+
+// VBROADCASTI128 appends VBROADCASTI128 to the program. See the function VBROADCASTI128.
+func (b *Builder) VBROADCASTI128(reg VecReg, rm Mem) {
+	b.add("VBROADCASTI128", "VBROADCASTI128", reg, rm)
+}
+
+// VBROADCASTI32X4 appends VBROADCASTI32X4 to the program. See the function VBROADCASTI32X4.
+func (b *Builder) VBROADCASTI32X4(reg Register, rm Mem) {
+	b.add("VBROADCASTI32X4", "VBROADCASTI32X4", reg, rm)
+}
+
+// VBROADCASTI32X8 appends VBROADCASTI32X8 to the program. See the function VBROADCASTI32X8.
+func (b *Builder) VBROADCASTI32X8(reg Register, rm Mem) {
+	b.add("VBROADCASTI32X8", "VBROADCASTI32X8", reg, rm)
+}
+
+// VBROADCASTI32x2 appends VBROADCASTI32X2 to the program. See the function VBROADCASTI32x2.
+func (b *Builder) VBROADCASTI32x2(reg Register, rm RegMem) {
+	b.add("VBROADCASTI32X2", "VBROADCASTI32x2", reg, rm)
+}
+
+// VBROADCASTI64X2 appends VBROADCASTI64X2 to the program. See the function VBROADCASTI64X2.
+func (b *Builder) VBROADCASTI64X2(reg Register, rm Mem) {
+	b.add("VBROADCASTI64X2", "VBROADCASTI64X2", reg, rm)
+}
+
+// VBROADCASTI64X4 appends VBROADCASTI64X4 to the program. See the function VBROADCASTI64X4.
+func (b *Builder) VBROADCASTI64X4(reg Register, rm Mem) {
+	b.add("VBROADCASTI64X4", "VBROADCASTI64X4", reg, rm)
+}
+
+// VBROADCASTSD appends VBROADCASTSD to the program. See the function VBROADCASTSD.
+func (b *Builder) VBROADCASTSD(reg Register, rm RegMem) {
+	b.add("VBROADCASTSD", "VBROADCASTSD", reg, rm)
+}
+
+// VBROADCASTSS appends VBROADCASTSS to the program. See the function VBROADCASTSS.
+func (b *Builder) VBROADCASTSS(reg Register, rm RegMem) {
+	b.add("VBROADCASTSS", "VBROADCASTSS", reg, rm)
+}
+
+// VCMPPD_FV appends VCMPPD to the program. See the function VCMPPD_FV.
+func (b *Builder) VCMPPD_FV(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPPD", "VCMPPD_FV", reg, vvvv, rm, imm)
+}
+
+// VCMPPD_RVMI appends VCMPPD to the program. See the function VCMPPD_RVMI.
+func (b *Builder) VCMPPD_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPPD", "VCMPPD_RVMI", reg, vvvv, rm, imm)
+}
+
+// VCMPPS_FV appends VCMPPS to the program. See the function VCMPPS_FV.
+func (b *Builder) VCMPPS_FV(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPPS", "VCMPPS_FV", reg, vvvv, rm, imm)
+}
+
+// VCMPPS_RVMI appends VCMPPS to the program. See the function VCMPPS_RVMI.
+func (b *Builder) VCMPPS_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPPS", "VCMPPS_RVMI", reg, vvvv, rm, imm)
+}
+
+// VCMPSD_RVMI appends VCMPSD to the program. See the function VCMPSD_RVMI.
+func (b *Builder) VCMPSD_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPSD", "VCMPSD_RVMI", reg, vvvv, rm, imm)
+}
+
+// VCMPSD_T1S appends VCMPSD to the program. See the function VCMPSD_T1S.
+func (b *Builder) VCMPSD_T1S(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPSD", "VCMPSD_T1S", reg, vvvv, rm, imm)
+}
+
+// VCMPSS_RVMI appends VCMPSS to the program. See the function VCMPSS_RVMI.
+func (b *Builder) VCMPSS_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPSS", "VCMPSS_RVMI", reg, vvvv, rm, imm)
+}
+
+// VCMPSS_T1S appends VCMPSS to the program. See the function VCMPSS_T1S.
+func (b *Builder) VCMPSS_T1S(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VCMPSS", "VCMPSS_T1S", reg, vvvv, rm, imm)
+}
+
+// VCOMISD_RM appends VCOMISD to the program. See the function VCOMISD_RM.
+func (b *Builder) VCOMISD_RM(reg VecReg, rm RegMem) {
+	b.add("VCOMISD", "VCOMISD_RM", reg, rm)
+}
+
+// VCOMISD_T1S appends VCOMISD to the program. See the function VCOMISD_T1S.
+func (b *Builder) VCOMISD_T1S(reg VecReg, rm RegMem) {
+	b.add("VCOMISD", "VCOMISD_T1S", reg, rm)
+}
+
+// VCOMISS_RM appends VCOMISS to the program. See the function VCOMISS_RM.
+func (b *Builder) VCOMISS_RM(reg VecReg, rm RegMem) {
+	b.add("VCOMISS", "VCOMISS_RM", reg, rm)
+}
+
+// VCOMISS_T1S appends VCOMISS to the program. See the function VCOMISS_T1S.
+func (b *Builder) VCOMISS_T1S(reg VecReg, rm RegMem) {
+	b.add("VCOMISS", "VCOMISS_T1S", reg, rm)
+}
+
+// VCOMPRESSPD appends VCOMPRESSPD to the program. See the function VCOMPRESSPD.
+func (b *Builder) VCOMPRESSPD(rm RegMem, reg VecReg) {
+	b.add("VCOMPRESSPD", "VCOMPRESSPD", rm, reg)
+}
+
+// VCOMPRESSPS appends VCOMPRESSPS to the program. See the function VCOMPRESSPS.
+func (b *Builder) VCOMPRESSPS(rm RegMem, reg VecReg) {
+	b.add("VCOMPRESSPS", "VCOMPRESSPS", rm, reg)
+}
+
+// VCVTDQ2PD_HV appends VCVTDQ2PD to the program. See the function VCVTDQ2PD_HV.
+func (b *Builder) VCVTDQ2PD_HV(reg Register, rm RegMem) {
+	b.add("VCVTDQ2PD", "VCVTDQ2PD_HV", reg, rm)
+}
+
+// VCVTDQ2PD_RM appends VCVTDQ2PD to the program. See the function VCVTDQ2PD_RM.
+func (b *Builder) VCVTDQ2PD_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTDQ2PD", "VCVTDQ2PD_RM", reg, rm)
+}
+
+// VCVTDQ2PS appends VCVTDQ2PS to the program. See the function VCVTDQ2PS.
+func (b *Builder) VCVTDQ2PS(reg Register, rm RegMem) {
+	b.add("VCVTDQ2PS", "VCVTDQ2PS", reg, rm)
+}
+
+// VCVTPD2DQ appends VCVTPD2DQ to the program. See the function VCVTPD2DQ.
+func (b *Builder) VCVTPD2DQ(reg Register, rm RegMem) {
+	b.add("VCVTPD2DQ", "VCVTPD2DQ", reg, rm)
+}
+
+// VCVTPD2PS_FV appends VCVTPD2PS to the program. See the function VCVTPD2PS_FV.
+func (b *Builder) VCVTPD2PS_FV(reg Register, rm RegMem) {
+	b.add("VCVTPD2PS", "VCVTPD2PS_FV", reg, rm)
+}
+
+// VCVTPD2PS_RM appends VCVTPD2PS to the program. See the function VCVTPD2PS_RM.
+func (b *Builder) VCVTPD2PS_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTPD2PS", "VCVTPD2PS_RM", reg, rm)
+}
+
+// VCVTPD2QQ appends VCVTPD2QQ to the program. See the function VCVTPD2QQ.
+func (b *Builder) VCVTPD2QQ(reg Register, rm RegMem) {
+	b.add("VCVTPD2QQ", "VCVTPD2QQ", reg, rm)
+}
+
+// VCVTPD2UDQ appends VCVTPD2UDQ to the program. See the function VCVTPD2UDQ.
+func (b *Builder) VCVTPD2UDQ(reg Register, rm RegMem) {
+	b.add("VCVTPD2UDQ", "VCVTPD2UDQ", reg, rm)
+}
+
+// VCVTPD2UQQ appends VCVTPD2UQQ to the program. See the function VCVTPD2UQQ.
+func (b *Builder) VCVTPD2UQQ(reg Register, rm RegMem) {
+	b.add("VCVTPD2UQQ", "VCVTPD2UQQ", reg, rm)
+}
+
+// VCVTPH2PS_HVM appends VCVTPH2PS to the program. See the function VCVTPH2PS_HVM.
+func (b *Builder) VCVTPH2PS_HVM(reg Register, rm RegMem) {
+	b.add("VCVTPH2PS", "VCVTPH2PS_HVM", reg, rm)
+}
+
+// VCVTPH2PS_RM appends VCVTPH2PS to the program. See the function VCVTPH2PS_RM.
+func (b *Builder) VCVTPH2PS_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTPH2PS", "VCVTPH2PS_RM", reg, rm)
+}
+
+// VCVTPS2DQ_FV appends VCVTPS2DQ to the program. See the function VCVTPS2DQ_FV.
+func (b *Builder) VCVTPS2DQ_FV(reg Register, rm RegMem) {
+	b.add("VCVTPS2DQ", "VCVTPS2DQ_FV", reg, rm)
+}
+
+// VCVTPS2DQ_RM appends VCVTPS2DQ to the program. See the function VCVTPS2DQ_RM.
+func (b *Builder) VCVTPS2DQ_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTPS2DQ", "VCVTPS2DQ_RM", reg, rm)
+}
+
+// VCVTPS2PD_HV appends VCVTPS2PD to the program. See the function VCVTPS2PD_HV.
+func (b *Builder) VCVTPS2PD_HV(reg Register, rm RegMem) {
+	b.add("VCVTPS2PD", "VCVTPS2PD_HV", reg, rm)
+}
+
+// VCVTPS2PD_RM appends VCVTPS2PD to the program. See the function VCVTPS2PD_RM.
+func (b *Builder) VCVTPS2PD_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTPS2PD", "VCVTPS2PD_RM", reg, rm)
+}
+
+// VCVTPS2PH appends VCVTPS2PH to the program. See the function VCVTPS2PH.
+func (b *Builder) VCVTPS2PH(rm RegMem, reg Register, imm Imm) {
+	b.add("VCVTPS2PH", "VCVTPS2PH", rm, reg, imm)
+}
+
+// VCVTPS2QQ appends VCVTPS2QQ to the program. See the function VCVTPS2QQ.
+func (b *Builder) VCVTPS2QQ(reg Register, rm RegMem) {
+	b.add("VCVTPS2QQ", "VCVTPS2QQ", reg, rm)
+}
+
+// VCVTPS2UDQ appends VCVTPS2UDQ to the program. See the function VCVTPS2UDQ.
+func (b *Builder) VCVTPS2UDQ(reg Register, rm RegMem) {
+	b.add("VCVTPS2UDQ", "VCVTPS2UDQ", reg, rm)
+}
+
+// VCVTPS2UQQ appends VCVTPS2UQQ to the program. See the function VCVTPS2UQQ.
+func (b *Builder) VCVTPS2UQQ(reg Register, rm RegMem) {
+	b.add("VCVTPS2UQQ", "VCVTPS2UQQ", reg, rm)
+}
+
+// VCVTQQ2PD appends VCVTQQ2PD to the program. See the function VCVTQQ2PD.
+func (b *Builder) VCVTQQ2PD(reg Register, rm RegMem) {
+	b.add("VCVTQQ2PD", "VCVTQQ2PD", reg, rm)
+}
+
+// VCVTQQ2PS appends VCVTQQ2PS to the program. See the function VCVTQQ2PS.
+func (b *Builder) VCVTQQ2PS(reg Register, rm RegMem) {
+	b.add("VCVTQQ2PS", "VCVTQQ2PS", reg, rm)
+}
+
+// VCVTSD2SI_RM appends VCVTSD2SI to the program. See the function VCVTSD2SI_RM.
+func (b *Builder) VCVTSD2SI_RM(reg Reg, rm RegMem) {
+	b.add("VCVTSD2SI", "VCVTSD2SI_RM", reg, rm)
+}
+
+// VCVTSD2SI_T1F appends VCVTSD2SI to the program. See the function VCVTSD2SI_T1F.
+func (b *Builder) VCVTSD2SI_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTSD2SI", "VCVTSD2SI_T1F", reg, rm)
+}
+
+// VCVTSD2SS_RVM appends VCVTSD2SS to the program. See the function VCVTSD2SS_RVM.
+func (b *Builder) VCVTSD2SS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSD2SS", "VCVTSD2SS_RVM", reg, vvvv, rm)
+}
+
+// VCVTSD2SS_T1S appends VCVTSD2SS to the program. See the function VCVTSD2SS_T1S.
+func (b *Builder) VCVTSD2SS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSD2SS", "VCVTSD2SS_T1S", reg, vvvv, rm)
+}
+
+// VCVTSD2USI appends VCVTSD2USI to the program. See the function VCVTSD2USI.
+func (b *Builder) VCVTSD2USI(reg Reg, rm RegMem) {
+	b.add("VCVTSD2USI", "VCVTSD2USI", reg, rm)
+}
+
+// VCVTSI2SD_RVM appends VCVTSI2SD to the program. See the function VCVTSI2SD_RVM.
+func (b *Builder) VCVTSI2SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SD", "VCVTSI2SD_RVM", reg, vvvv, rm)
+}
+
+// VCVTSI2SD_T1S appends VCVTSI2SD to the program. See the function VCVTSI2SD_T1S.
+func (b *Builder) VCVTSI2SD_T1S(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SD", "VCVTSI2SD_T1S", reg, vvvv, rm)
+}
+
+// VCVTSI2SS_RVM appends VCVTSI2SS to the program. See the function VCVTSI2SS_RVM.
+func (b *Builder) VCVTSI2SS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SS", "VCVTSI2SS_RVM", reg, vvvv, rm)
+}
+
+// VCVTSI2SS_T1S appends VCVTSI2SS to the program. See the function VCVTSI2SS_T1S.
+func (b *Builder) VCVTSI2SS_T1S(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SS", "VCVTSI2SS_T1S", reg, vvvv, rm)
+}
+
+// VCVTSS2SD_RVM appends VCVTSS2SD to the program. See the function VCVTSS2SD_RVM.
+func (b *Builder) VCVTSS2SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSS2SD", "VCVTSS2SD_RVM", reg, vvvv, rm)
+}
+
+// VCVTSS2SD_T1S appends VCVTSS2SD to the program. See the function VCVTSS2SD_T1S.
+func (b *Builder) VCVTSS2SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSS2SD", "VCVTSS2SD_T1S", reg, vvvv, rm)
+}
+
+// VCVTSS2SI_RM appends VCVTSS2SI to the program. See the function VCVTSS2SI_RM.
+func (b *Builder) VCVTSS2SI_RM(reg Reg, rm RegMem) {
+	b.add("VCVTSS2SI", "VCVTSS2SI_RM", reg, rm)
+}
+
+// VCVTSS2SI_T1F appends VCVTSS2SI to the program. See the function VCVTSS2SI_T1F.
+func (b *Builder) VCVTSS2SI_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTSS2SI", "VCVTSS2SI_T1F", reg, rm)
+}
+
+// VCVTSS2USI appends VCVTSS2USI to the program. See the function VCVTSS2USI.
+func (b *Builder) VCVTSS2USI(reg Reg, rm RegMem) {
+	b.add("VCVTSS2USI", "VCVTSS2USI", reg, rm)
+}
+
+// VCVTTPD2DQ_FV appends VCVTTPD2DQ to the program. See the function VCVTTPD2DQ_FV.
+func (b *Builder) VCVTTPD2DQ_FV(reg Register, rm RegMem) {
+	b.add("VCVTTPD2DQ", "VCVTTPD2DQ_FV", reg, rm)
+}
+
+// VCVTTPD2DQ_RM appends VCVTTPD2DQ to the program. See the function VCVTTPD2DQ_RM.
+func (b *Builder) VCVTTPD2DQ_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTTPD2DQ", "VCVTTPD2DQ_RM", reg, rm)
+}
+
+// VCVTTPD2QQ appends VCVTTPD2QQ to the program. See the function VCVTTPD2QQ.
+func (b *Builder) VCVTTPD2QQ(reg Register, rm RegMem) {
+	b.add("VCVTTPD2QQ", "VCVTTPD2QQ", reg, rm)
+}
+
+// VCVTTPD2UDQ appends VCVTTPD2UDQ to the program. See the function VCVTTPD2UDQ.
+func (b *Builder) VCVTTPD2UDQ(reg Register, rm RegMem) {
+	b.add("VCVTTPD2UDQ", "VCVTTPD2UDQ", reg, rm)
+}
+
+// VCVTTPD2UQQ appends VCVTTPD2UQQ to the program. See the function VCVTTPD2UQQ.
+func (b *Builder) VCVTTPD2UQQ(reg Register, rm RegMem) {
+	b.add("VCVTTPD2UQQ", "VCVTTPD2UQQ", reg, rm)
+}
+
+// VCVTTPS2DQ_FV appends VCVTTPS2DQ to the program. See the function VCVTTPS2DQ_FV.
+func (b *Builder) VCVTTPS2DQ_FV(reg Register, rm RegMem) {
+	b.add("VCVTTPS2DQ", "VCVTTPS2DQ_FV", reg, rm)
+}
+
+// VCVTTPS2DQ_RM appends VCVTTPS2DQ to the program. See the function VCVTTPS2DQ_RM.
+func (b *Builder) VCVTTPS2DQ_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTTPS2DQ", "VCVTTPS2DQ_RM", reg, rm)
+}
+
+// VCVTTPS2QQ appends VCVTTPS2QQ to the program. See the function VCVTTPS2QQ.
+func (b *Builder) VCVTTPS2QQ(reg Register, rm RegMem) {
+	b.add("VCVTTPS2QQ", "VCVTTPS2QQ", reg, rm)
+}
+
+// VCVTTPS2UDQ appends VCVTTPS2UDQ to the program. See the function VCVTTPS2UDQ.
+func (b *Builder) VCVTTPS2UDQ(reg Register, rm RegMem) {
+	b.add("VCVTTPS2UDQ", "VCVTTPS2UDQ", reg, rm)
+}
+
+// VCVTTPS2UQQ appends VCVTTPS2UQQ to the program. See the function VCVTTPS2UQQ.
+func (b *Builder) VCVTTPS2UQQ(reg Register, rm RegMem) {
+	b.add("VCVTTPS2UQQ", "VCVTTPS2UQQ", reg, rm)
+}
+
+// VCVTTSD2SI_RM appends VCVTTSD2SI to the program. See the function VCVTTSD2SI_RM.
+func (b *Builder) VCVTTSD2SI_RM(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2SI", "VCVTTSD2SI_RM", reg, rm)
+}
+
+// VCVTTSD2SI_T1F appends VCVTTSD2SI to the program. See the function VCVTTSD2SI_T1F.
+func (b *Builder) VCVTTSD2SI_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2SI", "VCVTTSD2SI_T1F", reg, rm)
+}
+
+// VCVTTSD2USI appends VCVTTSD2USI to the program. See the function VCVTTSD2USI.
+func (b *Builder) VCVTTSD2USI(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2USI", "VCVTTSD2USI", reg, rm)
+}
+
+// VCVTTSS2SI_RM appends VCVTTSS2SI to the program. See the function VCVTTSS2SI_RM.
+func (b *Builder) VCVTTSS2SI_RM(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2SI", "VCVTTSS2SI_RM", reg, rm)
+}
+
+// VCVTTSS2SI_T1F appends VCVTTSS2SI to the program. See the function VCVTTSS2SI_T1F.
+func (b *Builder) VCVTTSS2SI_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2SI", "VCVTTSS2SI_T1F", reg, rm)
+}
+
+// VCVTTSS2USI appends VCVTTSS2USI to the program. See the function VCVTTSS2USI.
+func (b *Builder) VCVTTSS2USI(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2USI", "VCVTTSS2USI", reg, rm)
+}
+
+// VCVTUDQ2PD appends VCVTUDQ2PD to the program. See the function VCVTUDQ2PD.
+func (b *Builder) VCVTUDQ2PD(reg Register, rm RegMem) {
+	b.add("VCVTUDQ2PD", "VCVTUDQ2PD", reg, rm)
+}
+
+// VCVTUDQ2PS appends VCVTUDQ2PS to the program. See the function VCVTUDQ2PS.
+func (b *Builder) VCVTUDQ2PS(reg Register, rm RegMem) {
+	b.add("VCVTUDQ2PS", "VCVTUDQ2PS", reg, rm)
+}
+
+// VCVTUQQ2PD appends VCVTUQQ2PD to the program. See the function VCVTUQQ2PD.
+func (b *Builder) VCVTUQQ2PD(reg Register, rm RegMem) {
+	b.add("VCVTUQQ2PD", "VCVTUQQ2PD", reg, rm)
+}
+
+// VCVTUQQ2PS appends VCVTUQQ2PS to the program. See the function VCVTUQQ2PS.
+func (b *Builder) VCVTUQQ2PS(reg Register, rm RegMem) {
+	b.add("VCVTUQQ2PS", "VCVTUQQ2PS", reg, rm)
+}
+
+// VCVTUSI2SD appends VCVTUSI2SD to the program. See the function VCVTUSI2SD.
+func (b *Builder) VCVTUSI2SD(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTUSI2SD", "VCVTUSI2SD", reg, vvvv, rm)
+}
+
+// VCVTUSI2SS appends VCVTUSI2SS to the program. See the function VCVTUSI2SS.
+func (b *Builder) VCVTUSI2SS(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTUSI2SS", "VCVTUSI2SS", reg, vvvv, rm)
+}
+
+// VDBPSADBW appends VDBPSADBW to the program. See the function VDBPSADBW.
+func (b *Builder) VDBPSADBW(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VDBPSADBW", "VDBPSADBW", reg, vvvv, rm, imm)
+}
+
+// VDIVPD_FV appends VDIVPD to the program. See the function VDIVPD_FV.
+func (b *Builder) VDIVPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VDIVPD", "VDIVPD_FV", reg, vvvv, rm)
+}
+
+// VDIVPD_RVM appends VDIVPD to the program. See the function VDIVPD_RVM.
+func (b *Builder) VDIVPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VDIVPD", "VDIVPD_RVM", reg, vvvv, rm)
+}
+
+// VDIVPS_FV appends VDIVPS to the program. See the function VDIVPS_FV.
+func (b *Builder) VDIVPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VDIVPS", "VDIVPS_FV", reg, vvvv, rm)
+}
+
+// VDIVPS_RVM appends VDIVPS to the program. See the function VDIVPS_RVM.
+func (b *Builder) VDIVPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VDIVPS", "VDIVPS_RVM", reg, vvvv, rm)
+}
+
+// VDIVSD_RVM appends VDIVSD to the program. See the function VDIVSD_RVM.
+func (b *Builder) VDIVSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VDIVSD", "VDIVSD_RVM", reg, vvvv, rm)
+}
+
+// VDIVSD_T1S appends VDIVSD to the program. See the function VDIVSD_T1S.
+func (b *Builder) VDIVSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VDIVSD", "VDIVSD_T1S", reg, vvvv, rm)
+}
+
+// VDIVSS_RVM appends VDIVSS to the program. See the function VDIVSS_RVM.
+func (b *Builder) VDIVSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VDIVSS", "VDIVSS_RVM", reg, vvvv, rm)
+}
+
+// VDIVSS_T1S appends VDIVSS to the program. See the function VDIVSS_T1S.
+func (b *Builder) VDIVSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VDIVSS", "VDIVSS_T1S", reg, vvvv, rm)
+}
+
+// VDPPD appends VDPPD to the program. See the function VDPPD.
+func (b *Builder) VDPPD(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VDPPD", "VDPPD", reg, vvvv, rm, imm)
+}
+
+// VDPPS appends VDPPS to the program. See the function VDPPS.
+func (b *Builder) VDPPS(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VDPPS", "VDPPS", reg, vvvv, rm, imm)
+}
+
+// VERR appends VERR to the program. See the function VERR.
+func (b *Builder) VERR(rm RegMem) {
+	b.add("VERR", "VERR", rm)
+}
+
+// VERW appends VERW to the program. See the function VERW.
+func (b *Builder) VERW(rm RegMem) {
+	b.add("VERW", "VERW", rm)
+}
+
+// VEXP2PD appends VEXP2PD to the program. See the function VEXP2PD.
+func (b *Builder) VEXP2PD(reg Register, rm RegMem) {
+	b.add("VEXP2PD", "VEXP2PD", reg, rm)
+}
+
+// VEXP2PS appends VEXP2PS to the program. See the function VEXP2PS.
+func (b *Builder) VEXP2PS(reg Register, rm RegMem) {
+	b.add("VEXP2PS", "VEXP2PS", reg, rm)
+}
+
+// VEXPANDPD appends VEXPANDPD to the program. See the function VEXPANDPD.
+func (b *Builder) VEXPANDPD(reg Register, rm RegMem) {
+	b.add("VEXPANDPD", "VEXPANDPD", reg, rm)
+}
+
+// VEXPANDPS appends VEXPANDPS to the program. See the function VEXPANDPS.
+func (b *Builder) VEXPANDPS(reg Register, rm RegMem) {
+	b.add("VEXPANDPS", "VEXPANDPS", reg, rm)
+}
+
+// VEXTRACTF128 appends VEXTRACTF128 to the program. See the function VEXTRACTF128.
+func (b *Builder) VEXTRACTF128(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTF128", "VEXTRACTF128", rm, reg, imm)
+}
+
+// VEXTRACTF32X4 appends VEXTRACTF32X4 to the program. See the function VEXTRACTF32X4.
+func (b *Builder) VEXTRACTF32X4(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTF32X4", "VEXTRACTF32X4", rm, reg, imm)
+}
+
+// VEXTRACTF32X8 appends VEXTRACTF32X8 to the program. See the function VEXTRACTF32X8.
+func (b *Builder) VEXTRACTF32X8(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTF32X8", "VEXTRACTF32X8", rm, reg, imm)
+}
+
+// VEXTRACTF32x4 appends VEXTRACTF32X4 to the program. See the function VEXTRACTF32x4.
+func (b *Builder) VEXTRACTF32x4(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTF32X4", "VEXTRACTF32x4", rm, reg, imm)
+}
+
+// VEXTRACTF64X2 appends VEXTRACTF64X2 to the program. See the function VEXTRACTF64X2.
+func (b *Builder) VEXTRACTF64X2(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTF64X2", "VEXTRACTF64X2", rm, reg, imm)
+}
+
+// VEXTRACTF64x4 appends VEXTRACTF64X4 to the program. See the function VEXTRACTF64x4.
+func (b *Builder) VEXTRACTF64x4(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTF64X4", "VEXTRACTF64x4", rm, reg, imm)
+}
+
+// VEXTRACTI128 appends VEXTRACTI128 to the program. See the function VEXTRACTI128.
+func (b *Builder) VEXTRACTI128(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTI128", "VEXTRACTI128", rm, reg, imm)
+}
+
+// VEXTRACTI32X4 appends VEXTRACTI32X4 to the program. See the function VEXTRACTI32X4.
+func (b *Builder) VEXTRACTI32X4(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTI32X4", "VEXTRACTI32X4", rm, reg, imm)
+}
+
+// VEXTRACTI32X8 appends VEXTRACTI32X8 to the program. See the function VEXTRACTI32X8.
+func (b *Builder) VEXTRACTI32X8(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTI32X8", "VEXTRACTI32X8", rm, reg, imm)
+}
+
+// VEXTRACTI32x4 appends VEXTRACTI32X4 to the program. See the function VEXTRACTI32x4.
+func (b *Builder) VEXTRACTI32x4(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTI32X4", "VEXTRACTI32x4", rm, reg, imm)
+}
+
+// VEXTRACTI64X2 appends VEXTRACTI64X2 to the program. See the function VEXTRACTI64X2.
+func (b *Builder) VEXTRACTI64X2(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTI64X2", "VEXTRACTI64X2", rm, reg, imm)
+}
+
+// VEXTRACTI64x4 appends VEXTRACTI64X4 to the program. See the function VEXTRACTI64x4.
+func (b *Builder) VEXTRACTI64x4(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTI64X4", "VEXTRACTI64x4", rm, reg, imm)
+}
+
+// VEXTRACTPS appends VEXTRACTPS to the program. See the function VEXTRACTPS.
+func (b *Builder) VEXTRACTPS(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VEXTRACTPS", "VEXTRACTPS", rm, reg, imm)
+}
+
+// VFIXUPIMMPD appends VFIXUPIMMPD to the program. See the function VFIXUPIMMPD.
+func (b *Builder) VFIXUPIMMPD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VFIXUPIMMPD", "VFIXUPIMMPD", reg, vvvv, rm, imm)
+}
+
+// VFIXUPIMMPS appends VFIXUPIMMPS to the program. See the function VFIXUPIMMPS.
+func (b *Builder) VFIXUPIMMPS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VFIXUPIMMPS", "VFIXUPIMMPS", reg, vvvv, rm, imm)
+}
+
+// VFIXUPIMMSD appends VFIXUPIMMSD to the program. See the function VFIXUPIMMSD.
+func (b *Builder) VFIXUPIMMSD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VFIXUPIMMSD", "VFIXUPIMMSD", reg, vvvv, rm, imm)
+}
+
+// VFIXUPIMMSS appends VFIXUPIMMSS to the program. See the function VFIXUPIMMSS.
+func (b *Builder) VFIXUPIMMSS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VFIXUPIMMSS", "VFIXUPIMMSS", reg, vvvv, rm, imm)
+}
+
+// VFMADD132PD_FV appends VFMADD132PD to the program. See the function VFMADD132PD_FV.
+func (b *Builder) VFMADD132PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132PD", "VFMADD132PD_FV", reg, vvvv, rm)
+}
+
+// VFMADD132PD_RVM appends VFMADD132PD to the program. See the function VFMADD132PD_RVM.
+func (b *Builder) VFMADD132PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132PD", "VFMADD132PD_RVM", reg, vvvv, rm)
+}
+
+// VFMADD132PS_FV appends VFMADD132PS to the program. See the function VFMADD132PS_FV.
+func (b *Builder) VFMADD132PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132PS", "VFMADD132PS_FV", reg, vvvv, rm)
+}
+
+// VFMADD132PS_RVM appends VFMADD132PS to the program. See the function VFMADD132PS_RVM.
+func (b *Builder) VFMADD132PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132PS", "VFMADD132PS_RVM", reg, vvvv, rm)
+}
+
+// VFMADD132SD_RVM appends VFMADD132SD to the program. See the function VFMADD132SD_RVM.
+func (b *Builder) VFMADD132SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132SD", "VFMADD132SD_RVM", reg, vvvv, rm)
+}
+
+// VFMADD132SD_T1S appends VFMADD132SD to the program. See the function VFMADD132SD_T1S.
+func (b *Builder) VFMADD132SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132SD", "VFMADD132SD_T1S", reg, vvvv, rm)
+}
+
+// VFMADD132SS appends VFMADD132SS to the program. See the function VFMADD132SS.
+func (b *Builder) VFMADD132SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD132SS", "VFMADD132SS", reg, vvvv, rm)
+}
+
+// VFMADD213PD_FV appends VFMADD213PD to the program. See the function VFMADD213PD_FV.
+func (b *Builder) VFMADD213PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213PD", "VFMADD213PD_FV", reg, vvvv, rm)
+}
+
+// VFMADD213PD_RVM appends VFMADD213PD to the program. See the function VFMADD213PD_RVM.
+func (b *Builder) VFMADD213PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213PD", "VFMADD213PD_RVM", reg, vvvv, rm)
+}
+
+// VFMADD213PS_FV appends VFMADD213PS to the program. See the function VFMADD213PS_FV.
+func (b *Builder) VFMADD213PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213PS", "VFMADD213PS_FV", reg, vvvv, rm)
+}
+
+// VFMADD213PS_RVM appends VFMADD213PS to the program. See the function VFMADD213PS_RVM.
+func (b *Builder) VFMADD213PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213PS", "VFMADD213PS_RVM", reg, vvvv, rm)
+}
+
+// VFMADD213SD_RVM appends VFMADD213SD to the program. See the function VFMADD213SD_RVM.
+func (b *Builder) VFMADD213SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213SD", "VFMADD213SD_RVM", reg, vvvv, rm)
+}
+
+// VFMADD213SD_T1S appends VFMADD213SD to the program. See the function VFMADD213SD_T1S.
+func (b *Builder) VFMADD213SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213SD", "VFMADD213SD_T1S", reg, vvvv, rm)
+}
+
+// VFMADD213SS appends VFMADD213SS to the program. See the function VFMADD213SS.
+func (b *Builder) VFMADD213SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD213SS", "VFMADD213SS", reg, vvvv, rm)
+}
+
+// VFMADD231PD_FV appends VFMADD231PD to the program. See the function VFMADD231PD_FV.
+func (b *Builder) VFMADD231PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231PD", "VFMADD231PD_FV", reg, vvvv, rm)
+}
+
+// VFMADD231PD_RVM appends VFMADD231PD to the program. See the function VFMADD231PD_RVM.
+func (b *Builder) VFMADD231PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231PD", "VFMADD231PD_RVM", reg, vvvv, rm)
+}
+
+// VFMADD231PS_FV appends VFMADD231PS to the program. See the function VFMADD231PS_FV.
+func (b *Builder) VFMADD231PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231PS", "VFMADD231PS_FV", reg, vvvv, rm)
+}
+
+// VFMADD231PS_RVM appends VFMADD231PS to the program. See the function VFMADD231PS_RVM.
+func (b *Builder) VFMADD231PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231PS", "VFMADD231PS_RVM", reg, vvvv, rm)
+}
+
+// VFMADD231SD_RVM appends VFMADD231SD to the program. See the function VFMADD231SD_RVM.
+func (b *Builder) VFMADD231SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231SD", "VFMADD231SD_RVM", reg, vvvv, rm)
+}
+
+// VFMADD231SD_T1S appends VFMADD231SD to the program. See the function VFMADD231SD_T1S.
+func (b *Builder) VFMADD231SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231SD", "VFMADD231SD_T1S", reg, vvvv, rm)
+}
+
+// VFMADD231SS appends VFMADD231SS to the program. See the function VFMADD231SS.
+func (b *Builder) VFMADD231SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADD231SS", "VFMADD231SS", reg, vvvv, rm)
+}
+
+// VFMADDSUB132PD appends VFMADDSUB132PD to the program. See the function VFMADDSUB132PD.
+func (b *Builder) VFMADDSUB132PD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADDSUB132PD", "VFMADDSUB132PD", reg, vvvv, rm)
+}
+
+// VFMADDSUB132PS appends VFMADDSUB132PS to the program. See the function VFMADDSUB132PS.
+func (b *Builder) VFMADDSUB132PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADDSUB132PS", "VFMADDSUB132PS", reg, vvvv, rm)
+}
+
+// VFMADDSUB213PD appends VFMADDSUB213PD to the program. See the function VFMADDSUB213PD.
+func (b *Builder) VFMADDSUB213PD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADDSUB213PD", "VFMADDSUB213PD", reg, vvvv, rm)
+}
+
+// VFMADDSUB213PS appends VFMADDSUB213PS to the program. See the function VFMADDSUB213PS.
+func (b *Builder) VFMADDSUB213PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADDSUB213PS", "VFMADDSUB213PS", reg, vvvv, rm)
+}
+
+// VFMADDSUB231PD appends VFMADDSUB231PD to the program. See the function VFMADDSUB231PD.
+func (b *Builder) VFMADDSUB231PD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADDSUB231PD", "VFMADDSUB231PD", reg, vvvv, rm)
+}
+
+// VFMADDSUB231PS appends VFMADDSUB231PS to the program. See the function VFMADDSUB231PS.
+func (b *Builder) VFMADDSUB231PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMADDSUB231PS", "VFMADDSUB231PS", reg, vvvv, rm)
+}
+
+// VFMSUB132PD_FV appends VFMSUB132PD to the program. See the function VFMSUB132PD_FV.
+func (b *Builder) VFMSUB132PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB132PD", "VFMSUB132PD_FV", reg, vvvv, rm)
+}
+
+// VFMSUB132PD_RVM appends VFMSUB132PD to the program. See the function VFMSUB132PD_RVM.
+func (b *Builder) VFMSUB132PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB132PD", "VFMSUB132PD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUB132PS appends VFMSUB132PS to the program. See the function VFMSUB132PS.
+func (b *Builder) VFMSUB132PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB132PS", "VFMSUB132PS", reg, vvvv, rm)
+}
+
+// VFMSUB132SD_RVM appends VFMSUB132SD to the program. See the function VFMSUB132SD_RVM.
+func (b *Builder) VFMSUB132SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB132SD", "VFMSUB132SD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUB132SD_T1S appends VFMSUB132SD to the program. See the function VFMSUB132SD_T1S.
+func (b *Builder) VFMSUB132SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB132SD", "VFMSUB132SD_T1S", reg, vvvv, rm)
+}
+
+// VFMSUB132SS appends VFMSUB132SS to the program. See the function VFMSUB132SS.
+func (b *Builder) VFMSUB132SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB132SS", "VFMSUB132SS", reg, vvvv, rm)
+}
+
+// VFMSUB213PD_FV appends VFMSUB213PD to the program. See the function VFMSUB213PD_FV.
+func (b *Builder) VFMSUB213PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB213PD", "VFMSUB213PD_FV", reg, vvvv, rm)
+}
+
+// VFMSUB213PD_RVM appends VFMSUB213PD to the program. See the function VFMSUB213PD_RVM.
+func (b *Builder) VFMSUB213PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB213PD", "VFMSUB213PD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUB213PS appends VFMSUB213PS to the program. See the function VFMSUB213PS.
+func (b *Builder) VFMSUB213PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB213PS", "VFMSUB213PS", reg, vvvv, rm)
+}
+
+// VFMSUB213SD_RVM appends VFMSUB213SD to the program. See the function VFMSUB213SD_RVM.
+func (b *Builder) VFMSUB213SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB213SD", "VFMSUB213SD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUB213SD_T1S appends VFMSUB213SD to the program. See the function VFMSUB213SD_T1S.
+func (b *Builder) VFMSUB213SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB213SD", "VFMSUB213SD_T1S", reg, vvvv, rm)
+}
+
+// VFMSUB213SS appends VFMSUB213SS to the program. See the function VFMSUB213SS.
+func (b *Builder) VFMSUB213SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB213SS", "VFMSUB213SS", reg, vvvv, rm)
+}
+
+// VFMSUB231PD_FV appends VFMSUB231PD to the program. See the function VFMSUB231PD_FV.
+func (b *Builder) VFMSUB231PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB231PD", "VFMSUB231PD_FV", reg, vvvv, rm)
+}
+
+// VFMSUB231PD_RVM appends VFMSUB231PD to the program. See the function VFMSUB231PD_RVM.
+func (b *Builder) VFMSUB231PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB231PD", "VFMSUB231PD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUB231PS appends VFMSUB231PS to the program. See the function VFMSUB231PS.
+func (b *Builder) VFMSUB231PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB231PS", "VFMSUB231PS", reg, vvvv, rm)
+}
+
+// VFMSUB231SD_RVM appends VFMSUB231SD to the program. See the function VFMSUB231SD_RVM.
+func (b *Builder) VFMSUB231SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB231SD", "VFMSUB231SD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUB231SD_T1S appends VFMSUB231SD to the program. See the function VFMSUB231SD_T1S.
+func (b *Builder) VFMSUB231SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB231SD", "VFMSUB231SD_T1S", reg, vvvv, rm)
+}
+
+// VFMSUB231SS appends VFMSUB231SS to the program. See the function VFMSUB231SS.
+func (b *Builder) VFMSUB231SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUB231SS", "VFMSUB231SS", reg, vvvv, rm)
+}
+
+// VFMSUBADD132PD_FV appends VFMSUBADD132PD to the program. See the function VFMSUBADD132PD_FV.
+func (b *Builder) VFMSUBADD132PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD132PD", "VFMSUBADD132PD_FV", reg, vvvv, rm)
+}
+
+// VFMSUBADD132PD_RVM appends VFMSUBADD132PD to the program. See the function VFMSUBADD132PD_RVM.
+func (b *Builder) VFMSUBADD132PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD132PD", "VFMSUBADD132PD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUBADD132PS appends VFMSUBADD132PS to the program. See the function VFMSUBADD132PS.
+func (b *Builder) VFMSUBADD132PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD132PS", "VFMSUBADD132PS", reg, vvvv, rm)
+}
+
+// VFMSUBADD213PD_FV appends VFMSUBADD213PD to the program. See the function VFMSUBADD213PD_FV.
+func (b *Builder) VFMSUBADD213PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD213PD", "VFMSUBADD213PD_FV", reg, vvvv, rm)
+}
+
+// VFMSUBADD213PD_RVM appends VFMSUBADD213PD to the program. See the function VFMSUBADD213PD_RVM.
+func (b *Builder) VFMSUBADD213PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD213PD", "VFMSUBADD213PD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUBADD213PS appends VFMSUBADD213PS to the program. See the function VFMSUBADD213PS.
+func (b *Builder) VFMSUBADD213PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD213PS", "VFMSUBADD213PS", reg, vvvv, rm)
+}
+
+// VFMSUBADD231PD_FV appends VFMSUBADD231PD to the program. See the function VFMSUBADD231PD_FV.
+func (b *Builder) VFMSUBADD231PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD231PD", "VFMSUBADD231PD_FV", reg, vvvv, rm)
+}
+
+// VFMSUBADD231PD_RVM appends VFMSUBADD231PD to the program. See the function VFMSUBADD231PD_RVM.
+func (b *Builder) VFMSUBADD231PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD231PD", "VFMSUBADD231PD_RVM", reg, vvvv, rm)
+}
+
+// VFMSUBADD231PS appends VFMSUBADD231PS to the program. See the function VFMSUBADD231PS.
+func (b *Builder) VFMSUBADD231PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFMSUBADD231PS", "VFMSUBADD231PS", reg, vvvv, rm)
+}
+
+// VFNMADD132PD_FV appends VFNMADD132PD to the program. See the function VFNMADD132PD_FV.
+func (b *Builder) VFNMADD132PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132PD", "VFNMADD132PD_FV", reg, vvvv, rm)
+}
+
+// VFNMADD132PD_RVM appends VFNMADD132PD to the program. See the function VFNMADD132PD_RVM.
+func (b *Builder) VFNMADD132PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132PD", "VFNMADD132PD_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD132PS_FV appends VFNMADD132PS to the program. See the function VFNMADD132PS_FV.
+func (b *Builder) VFNMADD132PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132PS", "VFNMADD132PS_FV", reg, vvvv, rm)
+}
+
+// VFNMADD132PS_RVM appends VFNMADD132PS to the program. See the function VFNMADD132PS_RVM.
+func (b *Builder) VFNMADD132PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132PS", "VFNMADD132PS_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD132SD appends VFNMADD132SD to the program. See the function VFNMADD132SD.
+func (b *Builder) VFNMADD132SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132SD", "VFNMADD132SD", reg, vvvv, rm)
+}
+
+// VFNMADD132SS_RVM appends VFNMADD132SS to the program. See the function VFNMADD132SS_RVM.
+func (b *Builder) VFNMADD132SS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132SS", "VFNMADD132SS_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD132SS_T1S appends VFNMADD132SS to the program. See the function VFNMADD132SS_T1S.
+func (b *Builder) VFNMADD132SS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD132SS", "VFNMADD132SS_T1S", reg, vvvv, rm)
+}
+
+// VFNMADD213PD_FV appends VFNMADD213PD to the program. See the function VFNMADD213PD_FV.
+func (b *Builder) VFNMADD213PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213PD", "VFNMADD213PD_FV", reg, vvvv, rm)
+}
+
+// VFNMADD213PD_RVM appends VFNMADD213PD to the program. See the function VFNMADD213PD_RVM.
+func (b *Builder) VFNMADD213PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213PD", "VFNMADD213PD_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD213PS_FV appends VFNMADD213PS to the program. See the function VFNMADD213PS_FV.
+func (b *Builder) VFNMADD213PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213PS", "VFNMADD213PS_FV", reg, vvvv, rm)
+}
+
+// VFNMADD213PS_RVM appends VFNMADD213PS to the program. See the function VFNMADD213PS_RVM.
+func (b *Builder) VFNMADD213PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213PS", "VFNMADD213PS_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD213SD appends VFNMADD213SD to the program. See the function VFNMADD213SD.
+func (b *Builder) VFNMADD213SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213SD", "VFNMADD213SD", reg, vvvv, rm)
+}
+
+// VFNMADD213SS_RVM appends VFNMADD213SS to the program. See the function VFNMADD213SS_RVM.
+func (b *Builder) VFNMADD213SS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213SS", "VFNMADD213SS_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD213SS_T1S appends VFNMADD213SS to the program. See the function VFNMADD213SS_T1S.
+func (b *Builder) VFNMADD213SS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD213SS", "VFNMADD213SS_T1S", reg, vvvv, rm)
+}
+
+// VFNMADD231PD_FV appends VFNMADD231PD to the program. See the function VFNMADD231PD_FV.
+func (b *Builder) VFNMADD231PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231PD", "VFNMADD231PD_FV", reg, vvvv, rm)
+}
+
+// VFNMADD231PD_RVM appends VFNMADD231PD to the program. See the function VFNMADD231PD_RVM.
+func (b *Builder) VFNMADD231PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231PD", "VFNMADD231PD_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD231PS_FV appends VFNMADD231PS to the program. See the function VFNMADD231PS_FV.
+func (b *Builder) VFNMADD231PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231PS", "VFNMADD231PS_FV", reg, vvvv, rm)
+}
+
+// VFNMADD231PS_RVM appends VFNMADD231PS to the program. See the function VFNMADD231PS_RVM.
+func (b *Builder) VFNMADD231PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231PS", "VFNMADD231PS_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD231SD appends VFNMADD231SD to the program. See the function VFNMADD231SD.
+func (b *Builder) VFNMADD231SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231SD", "VFNMADD231SD", reg, vvvv, rm)
+}
+
+// VFNMADD231SS_RVM appends VFNMADD231SS to the program. See the function VFNMADD231SS_RVM.
+func (b *Builder) VFNMADD231SS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231SS", "VFNMADD231SS_RVM", reg, vvvv, rm)
+}
+
+// VFNMADD231SS_T1S appends VFNMADD231SS to the program. See the function VFNMADD231SS_T1S.
+func (b *Builder) VFNMADD231SS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMADD231SS", "VFNMADD231SS_T1S", reg, vvvv, rm)
+}
+
+// VFNMSUB132PD_FV appends VFNMSUB132PD to the program. See the function VFNMSUB132PD_FV.
+func (b *Builder) VFNMSUB132PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132PD", "VFNMSUB132PD_FV", reg, vvvv, rm)
+}
+
+// VFNMSUB132PD_RVM appends VFNMSUB132PD to the program. See the function VFNMSUB132PD_RVM.
+func (b *Builder) VFNMSUB132PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132PD", "VFNMSUB132PD_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB132PS_FV appends VFNMSUB132PS to the program. See the function VFNMSUB132PS_FV.
+func (b *Builder) VFNMSUB132PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132PS", "VFNMSUB132PS_FV", reg, vvvv, rm)
+}
+
+// VFNMSUB132PS_RVM appends VFNMSUB132PS to the program. See the function VFNMSUB132PS_RVM.
+func (b *Builder) VFNMSUB132PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132PS", "VFNMSUB132PS_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB132SD_RVM appends VFNMSUB132SD to the program. See the function VFNMSUB132SD_RVM.
+func (b *Builder) VFNMSUB132SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132SD", "VFNMSUB132SD_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB132SD_T1S appends VFNMSUB132SD to the program. See the function VFNMSUB132SD_T1S.
+func (b *Builder) VFNMSUB132SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132SD", "VFNMSUB132SD_T1S", reg, vvvv, rm)
+}
+
+// VFNMSUB132SS appends VFNMSUB132SS to the program. See the function VFNMSUB132SS.
+func (b *Builder) VFNMSUB132SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB132SS", "VFNMSUB132SS", reg, vvvv, rm)
+}
+
+// VFNMSUB213PD_FV appends VFNMSUB213PD to the program. See the function VFNMSUB213PD_FV.
+func (b *Builder) VFNMSUB213PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213PD", "VFNMSUB213PD_FV", reg, vvvv, rm)
+}
+
+// VFNMSUB213PD_RVM appends VFNMSUB213PD to the program. See the function VFNMSUB213PD_RVM.
+func (b *Builder) VFNMSUB213PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213PD", "VFNMSUB213PD_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB213PS_FV appends VFNMSUB213PS to the program. See the function VFNMSUB213PS_FV.
+func (b *Builder) VFNMSUB213PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213PS", "VFNMSUB213PS_FV", reg, vvvv, rm)
+}
+
+// VFNMSUB213PS_RVM appends VFNMSUB213PS to the program. See the function VFNMSUB213PS_RVM.
+func (b *Builder) VFNMSUB213PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213PS", "VFNMSUB213PS_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB213SD_RVM appends VFNMSUB213SD to the program. See the function VFNMSUB213SD_RVM.
+func (b *Builder) VFNMSUB213SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213SD", "VFNMSUB213SD_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB213SD_T1S appends VFNMSUB213SD to the program. See the function VFNMSUB213SD_T1S.
+func (b *Builder) VFNMSUB213SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213SD", "VFNMSUB213SD_T1S", reg, vvvv, rm)
+}
+
+// VFNMSUB213SS appends VFNMSUB213SS to the program. See the function VFNMSUB213SS.
+func (b *Builder) VFNMSUB213SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB213SS", "VFNMSUB213SS", reg, vvvv, rm)
+}
+
+// VFNMSUB231PD_FV appends VFNMSUB231PD to the program. See the function VFNMSUB231PD_FV.
+func (b *Builder) VFNMSUB231PD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231PD", "VFNMSUB231PD_FV", reg, vvvv, rm)
+}
+
+// VFNMSUB231PD_RVM appends VFNMSUB231PD to the program. See the function VFNMSUB231PD_RVM.
+func (b *Builder) VFNMSUB231PD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231PD", "VFNMSUB231PD_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB231PS_FV appends VFNMSUB231PS to the program. See the function VFNMSUB231PS_FV.
+func (b *Builder) VFNMSUB231PS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231PS", "VFNMSUB231PS_FV", reg, vvvv, rm)
+}
+
+// VFNMSUB231PS_RVM appends VFNMSUB231PS to the program. See the function VFNMSUB231PS_RVM.
+func (b *Builder) VFNMSUB231PS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231PS", "VFNMSUB231PS_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB231SD_RVM appends VFNMSUB231SD to the program. See the function VFNMSUB231SD_RVM.
+func (b *Builder) VFNMSUB231SD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231SD", "VFNMSUB231SD_RVM", reg, vvvv, rm)
+}
+
+// VFNMSUB231SD_T1S appends VFNMSUB231SD to the program. See the function VFNMSUB231SD_T1S.
+func (b *Builder) VFNMSUB231SD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231SD", "VFNMSUB231SD_T1S", reg, vvvv, rm)
+}
+
+// VFNMSUB231SS appends VFNMSUB231SS to the program. See the function VFNMSUB231SS.
+func (b *Builder) VFNMSUB231SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VFNMSUB231SS", "VFNMSUB231SS", reg, vvvv, rm)
+}
+
+// VFPCLASSPD appends VFPCLASSPD to the program. See the function VFPCLASSPD.
+func (b *Builder) VFPCLASSPD(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPD", "VFPCLASSPD", reg, rm, imm)
+}
+
+// VFPCLASSPS appends VFPCLASSPS to the program. See the function VFPCLASSPS.
+func (b *Builder) VFPCLASSPS(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPS", "VFPCLASSPS", reg, rm, imm)
+}
+
+// VFPCLASSSD appends VFPCLASSSD to the program. See the function VFPCLASSSD.
+func (b *Builder) VFPCLASSSD(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSSD", "VFPCLASSSD", reg, rm, imm)
+}
+
+// VFPCLASSSS appends VFPCLASSSS to the program. See the function VFPCLASSSS.
+func (b *Builder) VFPCLASSSS(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSSS", "VFPCLASSSS", reg, rm, imm)
+}
+
+// VGATHERDPD_RMV appends VGATHERDPD to the program. See the function VGATHERDPD_RMV.
+func (b *Builder) VGATHERDPD_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VGATHERDPD", "VGATHERDPD_RMV", reg, vsib, vvvv)
+}
+
+// VGATHERDPD_T1S appends VGATHERDPD to the program. See the function VGATHERDPD_T1S.
+func (b *Builder) VGATHERDPD_T1S(reg Register, vsib Mem) {
+	b.add("VGATHERDPD", "VGATHERDPD_T1S", reg, vsib)
+}
+
+// VGATHERDPS_RMV appends VGATHERDPS to the program. See the function VGATHERDPS_RMV.
+func (b *Builder) VGATHERDPS_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VGATHERDPS", "VGATHERDPS_RMV", reg, vsib, vvvv)
+}
+
+// VGATHERDPS_T1S appends VGATHERDPS to the program. See the function VGATHERDPS_T1S.
+func (b *Builder) VGATHERDPS_T1S(reg Register, vsib Mem) {
+	b.add("VGATHERDPS", "VGATHERDPS_T1S", reg, vsib)
+}
+
+// VGATHERPF0DPD appends VGATHERPF0DPD to the program. See the function VGATHERPF0DPD.
+func (b *Builder) VGATHERPF0DPD(vsib RegMem) {
+	b.add("VGATHERPF0DPD", "VGATHERPF0DPD", vsib)
+}
+
+// VGATHERPF0DPS appends VGATHERPF0DPS to the program. See the function VGATHERPF0DPS.
+func (b *Builder) VGATHERPF0DPS(vsib RegMem) {
+	b.add("VGATHERPF0DPS", "VGATHERPF0DPS", vsib)
+}
+
+// VGATHERPF0QPD appends VGATHERPF0QPD to the program. See the function VGATHERPF0QPD.
+func (b *Builder) VGATHERPF0QPD(vsib RegMem) {
+	b.add("VGATHERPF0QPD", "VGATHERPF0QPD", vsib)
+}
+
+// VGATHERPF0QPS appends VGATHERPF0QPS to the program. See the function VGATHERPF0QPS.
+func (b *Builder) VGATHERPF0QPS(vsib RegMem) {
+	b.add("VGATHERPF0QPS", "VGATHERPF0QPS", vsib)
+}
+
+// VGATHERPF1DPD appends VGATHERPF1DPD to the program. See the function VGATHERPF1DPD.
+func (b *Builder) VGATHERPF1DPD(vsib RegMem) {
+	b.add("VGATHERPF1DPD", "VGATHERPF1DPD", vsib)
+}
+
+// VGATHERPF1DPS appends VGATHERPF1DPS to the program. See the function VGATHERPF1DPS.
+func (b *Builder) VGATHERPF1DPS(vsib RegMem) {
+	b.add("VGATHERPF1DPS", "VGATHERPF1DPS", vsib)
+}
+
+// VGATHERPF1QPD appends VGATHERPF1QPD to the program. See the function VGATHERPF1QPD.
+func (b *Builder) VGATHERPF1QPD(vsib RegMem) {
+	b.add("VGATHERPF1QPD", "VGATHERPF1QPD", vsib)
+}
+
+// VGATHERPF1QPS appends VGATHERPF1QPS to the program. See the function VGATHERPF1QPS.
+func (b *Builder) VGATHERPF1QPS(vsib RegMem) {
+	b.add("VGATHERPF1QPS", "VGATHERPF1QPS", vsib)
+}
+
+// VGATHERQPD_RMV appends VGATHERQPD to the program. See the function VGATHERQPD_RMV.
+func (b *Builder) VGATHERQPD_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VGATHERQPD", "VGATHERQPD_RMV", reg, vsib, vvvv)
+}
+
+// VGATHERQPD_T1S appends VGATHERQPD to the program. See the function VGATHERQPD_T1S.
+func (b *Builder) VGATHERQPD_T1S(reg Register, vsib Mem) {
+	b.add("VGATHERQPD", "VGATHERQPD_T1S", reg, vsib)
+}
+
+// VGATHERQPS_RMV appends VGATHERQPS to the program. See the function VGATHERQPS_RMV.
+func (b *Builder) VGATHERQPS_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VGATHERQPS", "VGATHERQPS_RMV", reg, vsib, vvvv)
+}
+
+// VGATHERQPS_T1S appends VGATHERQPS to the program. See the function VGATHERQPS_T1S.
+func (b *Builder) VGATHERQPS_T1S(reg Register, vsib Mem) {
+	b.add("VGATHERQPS", "VGATHERQPS_T1S", reg, vsib)
+}
+
+// VGETEXPPD appends VGETEXPPD to the program. See the function VGETEXPPD.
+func (b *Builder) VGETEXPPD(reg Register, rm RegMem) {
+	b.add("VGETEXPPD", "VGETEXPPD", reg, rm)
+}
+
+// VGETEXPPS appends VGETEXPPS to the program. See the function VGETEXPPS.
+func (b *Builder) VGETEXPPS(reg Register, rm RegMem) {
+	b.add("VGETEXPPS", "VGETEXPPS", reg, rm)
+}
+
+// VGETEXPSD appends VGETEXPSD to the program. See the function VGETEXPSD.
+func (b *Builder) VGETEXPSD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VGETEXPSD", "VGETEXPSD", reg, vvvv, rm)
+}
+
+// VGETEXPSS appends VGETEXPSS to the program. See the function VGETEXPSS.
+func (b *Builder) VGETEXPSS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VGETEXPSS", "VGETEXPSS", reg, vvvv, rm)
+}
+
+// VGETMANTPD appends VGETMANTPD to the program. See the function VGETMANTPD.
+func (b *Builder) VGETMANTPD(reg Register, rm RegMem, imm Imm) {
+	b.add("VGETMANTPD", "VGETMANTPD", reg, rm, imm)
+}
+
+// VGETMANTPS appends VGETMANTPS to the program. See the function VGETMANTPS.
+func (b *Builder) VGETMANTPS(reg Register, rm RegMem, imm Imm) {
+	b.add("VGETMANTPS", "VGETMANTPS", reg, rm, imm)
+}
+
+// VGETMANTSD appends VGETMANTSD to the program. See the function VGETMANTSD.
+func (b *Builder) VGETMANTSD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VGETMANTSD", "VGETMANTSD", reg, vvvv, rm, imm)
+}
+
+// VGETMANTSS appends VGETMANTSS to the program. See the function VGETMANTSS.
+func (b *Builder) VGETMANTSS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VGETMANTSS", "VGETMANTSS", reg, vvvv, rm, imm)
+}
+
+// VGF2P8AFFINEINVQB appends VGF2P8AFFINEINVQB to the program. See the function VGF2P8AFFINEINVQB.
+func (b *Builder) VGF2P8AFFINEINVQB(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VGF2P8AFFINEINVQB", "VGF2P8AFFINEINVQB", reg, vvvv, rm, imm)
+}
+
+// VGF2P8AFFINEQB appends VGF2P8AFFINEQB to the program. See the function VGF2P8AFFINEQB.
+func (b *Builder) VGF2P8AFFINEQB(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VGF2P8AFFINEQB", "VGF2P8AFFINEQB", reg, vvvv, rm, imm)
+}
+
+// VGF2P8MULB appends VGF2P8MULB to the program. See the function VGF2P8MULB.
+func (b *Builder) VGF2P8MULB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VGF2P8MULB", "VGF2P8MULB", reg, vvvv, rm)
+}
+
+// VHADDPD appends VHADDPD to the program. See the function VHADDPD.
+func (b *Builder) VHADDPD(reg, vvvv VecReg, rm RegMem) {
+	b.add("VHADDPD", "VHADDPD", reg, vvvv, rm)
+}
+
+// VHADDPS appends VHADDPS to the program. See the function VHADDPS.
+func (b *Builder) VHADDPS(reg, vvvv VecReg, rm RegMem) {
+	b.add("VHADDPS", "VHADDPS", reg, vvvv, rm)
+}
+
+// VHSUBPD appends VHSUBPD to the program. See the function VHSUBPD.
+func (b *Builder) VHSUBPD(reg, vvvv VecReg, rm RegMem) {
+	b.add("VHSUBPD", "VHSUBPD", reg, vvvv, rm)
+}
+
+// VHSUBPS appends VHSUBPS to the program. See the function VHSUBPS.
+func (b *Builder) VHSUBPS(reg, vvvv VecReg, rm RegMem) {
+	b.add("VHSUBPS", "VHSUBPS", reg, vvvv, rm)
+}
+
+// VINSERTF128 appends VINSERTF128 to the program. See the function VINSERTF128.
+func (b *Builder) VINSERTF128(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTF128", "VINSERTF128", reg, vvvv, rm, imm)
+}
+
+// VINSERTF32X4 appends VINSERTF32X4 to the program. See the function VINSERTF32X4.
+func (b *Builder) VINSERTF32X4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTF32X4", "VINSERTF32X4", reg, vvvv, rm, imm)
+}
+
+// VINSERTF32X8 appends VINSERTF32X8 to the program. See the function VINSERTF32X8.
+func (b *Builder) VINSERTF32X8(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTF32X8", "VINSERTF32X8", reg, vvvv, rm, imm)
+}
+
+// VINSERTF64X2 appends VINSERTF64X2 to the program. See the function VINSERTF64X2.
+func (b *Builder) VINSERTF64X2(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTF64X2", "VINSERTF64X2", reg, vvvv, rm, imm)
+}
+
+// VINSERTF64X4 appends VINSERTF64X4 to the program. See the function VINSERTF64X4.
+func (b *Builder) VINSERTF64X4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTF64X4", "VINSERTF64X4", reg, vvvv, rm, imm)
+}
+
+// VINSERTI128 appends VINSERTI128 to the program. See the function VINSERTI128.
+func (b *Builder) VINSERTI128(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTI128", "VINSERTI128", reg, vvvv, rm, imm)
+}
+
+// VINSERTI32X4 appends VINSERTI32X4 to the program. See the function VINSERTI32X4.
+func (b *Builder) VINSERTI32X4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTI32X4", "VINSERTI32X4", reg, vvvv, rm, imm)
+}
+
+// VINSERTI32X8 appends VINSERTI32X8 to the program. See the function VINSERTI32X8.
+func (b *Builder) VINSERTI32X8(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTI32X8", "VINSERTI32X8", reg, vvvv, rm, imm)
+}
+
+// VINSERTI64X2 appends VINSERTI64X2 to the program. See the function VINSERTI64X2.
+func (b *Builder) VINSERTI64X2(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTI64X2", "VINSERTI64X2", reg, vvvv, rm, imm)
+}
+
+// VINSERTI64X4 appends VINSERTI64X4 to the program. See the function VINSERTI64X4.
+func (b *Builder) VINSERTI64X4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTI64X4", "VINSERTI64X4", reg, vvvv, rm, imm)
+}
+
+// VINSERTPS_RVMI appends VINSERTPS to the program. See the function VINSERTPS_RVMI.
+func (b *Builder) VINSERTPS_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTPS", "VINSERTPS_RVMI", reg, vvvv, rm, imm)
+}
+
+// VINSERTPS_T1S appends VINSERTPS to the program. See the function VINSERTPS_T1S.
+func (b *Builder) VINSERTPS_T1S(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VINSERTPS", "VINSERTPS_T1S", reg, vvvv, rm, imm)
+}
+
+// VLDDQU appends VLDDQU to the program. See the function VLDDQU.
+func (b *Builder) VLDDQU(reg VecReg, rm Mem) {
+	b.add("VLDDQU", "VLDDQU", reg, rm)
+}
+
+// VLDMXCSR appends VLDMXCSR to the program. See the function VLDMXCSR.
+func (b *Builder) VLDMXCSR(rm Mem) {
+	b.add("VLDMXCSR", "VLDMXCSR", rm)
+}
+
+// VMASKMOVDQU appends VMASKMOVDQU to the program. See the function VMASKMOVDQU.
+func (b *Builder) VMASKMOVDQU(reg, rm VecReg) {
+	b.add("VMASKMOVDQU", "VMASKMOVDQU", reg, rm)
+}
+
+// VMASKMOVPD_MVR appends VMASKMOVPD to the program. See the function VMASKMOVPD_MVR.
+func (b *Builder) VMASKMOVPD_MVR(rm Mem, vvvv, reg VecReg) {
+	b.add("VMASKMOVPD", "VMASKMOVPD_MVR", rm, vvvv, reg)
+}
+
+// VMASKMOVPD_RVM appends VMASKMOVPD to the program. See the function VMASKMOVPD_RVM.
+func (b *Builder) VMASKMOVPD_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VMASKMOVPD", "VMASKMOVPD_RVM", reg, vvvv, rm)
+}
+
+// VMASKMOVPS_MVR appends VMASKMOVPS to the program. See the function VMASKMOVPS_MVR.
+func (b *Builder) VMASKMOVPS_MVR(rm Mem, vvvv, reg VecReg) {
+	b.add("VMASKMOVPS", "VMASKMOVPS_MVR", rm, vvvv, reg)
+}
+
+// VMASKMOVPS_RVM appends VMASKMOVPS to the program. See the function VMASKMOVPS_RVM.
+func (b *Builder) VMASKMOVPS_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VMASKMOVPS", "VMASKMOVPS_RVM", reg, vvvv, rm)
+}
+
+// VMAXPD_FV appends VMAXPD to the program. See the function VMAXPD_FV.
+func (b *Builder) VMAXPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMAXPD", "VMAXPD_FV", reg, vvvv, rm)
+}
+
+// VMAXPD_RVM appends VMAXPD to the program. See the function VMAXPD_RVM.
+func (b *Builder) VMAXPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMAXPD", "VMAXPD_RVM", reg, vvvv, rm)
+}
+
+// VMAXPS_FV appends VMAXPS to the program. See the function VMAXPS_FV.
+func (b *Builder) VMAXPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMAXPS", "VMAXPS_FV", reg, vvvv, rm)
+}
+
+// VMAXPS_RVM appends VMAXPS to the program. See the function VMAXPS_RVM.
+func (b *Builder) VMAXPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMAXPS", "VMAXPS_RVM", reg, vvvv, rm)
+}
+
+// VMAXSD_RVM appends VMAXSD to the program. See the function VMAXSD_RVM.
+func (b *Builder) VMAXSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMAXSD", "VMAXSD_RVM", reg, vvvv, rm)
+}
+
+// VMAXSD_T1S appends VMAXSD to the program. See the function VMAXSD_T1S.
+func (b *Builder) VMAXSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMAXSD", "VMAXSD_T1S", reg, vvvv, rm)
+}
+
+// VMAXSS_RVM appends VMAXSS to the program. See the function VMAXSS_RVM.
+func (b *Builder) VMAXSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMAXSS", "VMAXSS_RVM", reg, vvvv, rm)
+}
+
+// VMAXSS_T1S appends VMAXSS to the program. See the function VMAXSS_T1S.
+func (b *Builder) VMAXSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMAXSS", "VMAXSS_T1S", reg, vvvv, rm)
+}
+
+// VMINPD_FV appends VMINPD to the program. See the function VMINPD_FV.
+func (b *Builder) VMINPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMINPD", "VMINPD_FV", reg, vvvv, rm)
+}
+
+// VMINPD_RVM appends VMINPD to the program. See the function VMINPD_RVM.
+func (b *Builder) VMINPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMINPD", "VMINPD_RVM", reg, vvvv, rm)
+}
+
+// VMINPS_FV appends VMINPS to the program. See the function VMINPS_FV.
+func (b *Builder) VMINPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMINPS", "VMINPS_FV", reg, vvvv, rm)
+}
+
+// VMINPS_RVM appends VMINPS to the program. See the function VMINPS_RVM.
+func (b *Builder) VMINPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMINPS", "VMINPS_RVM", reg, vvvv, rm)
+}
+
+// VMINSD_RVM appends VMINSD to the program. See the function VMINSD_RVM.
+func (b *Builder) VMINSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMINSD", "VMINSD_RVM", reg, vvvv, rm)
+}
+
+// VMINSD_T1S appends VMINSD to the program. See the function VMINSD_T1S.
+func (b *Builder) VMINSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMINSD", "VMINSD_T1S", reg, vvvv, rm)
+}
+
+// VMINSS_RVM appends VMINSS to the program. See the function VMINSS_RVM.
+func (b *Builder) VMINSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMINSS", "VMINSS_RVM", reg, vvvv, rm)
+}
+
+// VMINSS_T1S appends VMINSS to the program. See the function VMINSS_T1S.
+func (b *Builder) VMINSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMINSS", "VMINSS_T1S", reg, vvvv, rm)
+}
+
+// VMOVAPD_FVM_MR appends VMOVAPD to the program. See the function VMOVAPD_FVM_MR.
+func (b *Builder) VMOVAPD_FVM_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVAPD", "VMOVAPD_FVM_MR", rm, reg)
+}
+
+// VMOVAPD_FVM_RM appends VMOVAPD to the program. See the function VMOVAPD_FVM_RM.
+func (b *Builder) VMOVAPD_FVM_RM(reg Register, rm RegMem) {
+	b.add("VMOVAPD", "VMOVAPD_FVM_RM", reg, rm)
+}
+
+// VMOVAPD_MR appends VMOVAPD to the program. See the function VMOVAPD_MR.
+func (b *Builder) VMOVAPD_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVAPD", "VMOVAPD_MR", rm, reg)
+}
+
+// VMOVAPD_RM appends VMOVAPD to the program. See the function VMOVAPD_RM.
+func (b *Builder) VMOVAPD_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVAPD", "VMOVAPD_RM", reg, rm)
+}
+
+// VMOVAPS_FVM_MR appends VMOVAPS to the program. See the function VMOVAPS_FVM_MR.
+func (b *Builder) VMOVAPS_FVM_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVAPS", "VMOVAPS_FVM_MR", rm, reg)
+}
+
+// VMOVAPS_FVM_RM appends VMOVAPS to the program. See the function VMOVAPS_FVM_RM.
+func (b *Builder) VMOVAPS_FVM_RM(reg Register, rm RegMem) {
+	b.add("VMOVAPS", "VMOVAPS_FVM_RM", reg, rm)
+}
+
+// VMOVAPS_MR appends VMOVAPS to the program. See the function VMOVAPS_MR.
+func (b *Builder) VMOVAPS_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVAPS", "VMOVAPS_MR", rm, reg)
+}
+
+// VMOVAPS_RM appends VMOVAPS to the program. See the function VMOVAPS_RM.
+func (b *Builder) VMOVAPS_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVAPS", "VMOVAPS_RM", reg, rm)
+}
+
+// VMOVD appends VMOVD to the program. See the function VMOVD.
+func (b *Builder) VMOVD(reg VecReg, rm RegMem) {
+	b.add("VMOVD", "VMOVD", reg, rm)
+}
+
+// VMOVDDUP appends VMOVDDUP to the program. See the function VMOVDDUP.
+func (b *Builder) VMOVDDUP(reg Register, rm RegMem) {
+	b.add("VMOVDDUP", "VMOVDDUP", reg, rm)
+}
+
+// VMOVDQA32_FVM_MR appends VMOVDQA32 to the program. See the function VMOVDQA32_FVM_MR.
+func (b *Builder) VMOVDQA32_FVM_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQA32", "VMOVDQA32_FVM_MR", rm, reg)
+}
+
+// VMOVDQA32_FVM_RM appends VMOVDQA32 to the program. See the function VMOVDQA32_FVM_RM.
+func (b *Builder) VMOVDQA32_FVM_RM(reg Register, rm RegMem) {
+	b.add("VMOVDQA32", "VMOVDQA32_FVM_RM", reg, rm)
+}
+
+// VMOVDQA64_FVM_MR appends VMOVDQA64 to the program. See the function VMOVDQA64_FVM_MR.
+func (b *Builder) VMOVDQA64_FVM_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQA64", "VMOVDQA64_FVM_MR", rm, reg)
+}
+
+// VMOVDQA64_FVM_RM appends VMOVDQA64 to the program. See the function VMOVDQA64_FVM_RM.
+func (b *Builder) VMOVDQA64_FVM_RM(reg Register, rm RegMem) {
+	b.add("VMOVDQA64", "VMOVDQA64_FVM_RM", reg, rm)
+}
+
+// VMOVDQA_MR appends VMOVDQA to the program. See the function VMOVDQA_MR.
+func (b *Builder) VMOVDQA_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQA", "VMOVDQA_MR", rm, reg)
+}
+
+// VMOVDQA_RM appends VMOVDQA to the program. See the function VMOVDQA_RM.
+func (b *Builder) VMOVDQA_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVDQA", "VMOVDQA_RM", reg, rm)
+}
+
+// VMOVDQU16_MR appends VMOVDQU16 to the program. See the function VMOVDQU16_MR.
+func (b *Builder) VMOVDQU16_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQU16", "VMOVDQU16_MR", rm, reg)
+}
+
+// VMOVDQU16_RM appends VMOVDQU16 to the program. See the function VMOVDQU16_RM.
+func (b *Builder) VMOVDQU16_RM(reg Register, rm RegMem) {
+	b.add("VMOVDQU16", "VMOVDQU16_RM", reg, rm)
+}
+
+// VMOVDQU32_MR appends VMOVDQU32 to the program. See the function VMOVDQU32_MR.
+func (b *Builder) VMOVDQU32_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQU32", "VMOVDQU32_MR", rm, reg)
+}
+
+// VMOVDQU32_RM appends VMOVDQU32 to the program. See the function VMOVDQU32_RM.
+func (b *Builder) VMOVDQU32_RM(reg Register, rm RegMem) {
+	b.add("VMOVDQU32", "VMOVDQU32_RM", reg, rm)
+}
+
+// VMOVDQU64_MR appends VMOVDQU64 to the program. See the function VMOVDQU64_MR.
+func (b *Builder) VMOVDQU64_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQU64", "VMOVDQU64_MR", rm, reg)
+}
+
+// VMOVDQU64_RM appends VMOVDQU64 to the program. See the function VMOVDQU64_RM.
+func (b *Builder) VMOVDQU64_RM(reg Register, rm RegMem) {
+	b.add("VMOVDQU64", "VMOVDQU64_RM", reg, rm)
+}
+
+// VMOVDQU8_MR appends VMOVDQU8 to the program. See the function VMOVDQU8_MR.
+func (b *Builder) VMOVDQU8_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQU8", "VMOVDQU8_MR", rm, reg)
+}
+
+// VMOVDQU8_RM appends VMOVDQU8 to the program. See the function VMOVDQU8_RM.
+func (b *Builder) VMOVDQU8_RM(reg Register, rm RegMem) {
+	b.add("VMOVDQU8", "VMOVDQU8_RM", reg, rm)
+}
+
+// VMOVDQU_MR appends VMOVDQU to the program. See the function VMOVDQU_MR.
+func (b *Builder) VMOVDQU_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVDQU", "VMOVDQU_MR", rm, reg)
+}
+
+// VMOVDQU_RM appends VMOVDQU to the program. See the function VMOVDQU_RM.
+func (b *Builder) VMOVDQU_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVDQU", "VMOVDQU_RM", reg, rm)
+}
+
+// VMOVD_MR appends VMOVD to the program. See the function VMOVD_MR.
+func (b *Builder) VMOVD_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVD", "VMOVD_MR", rm, reg)
+}
+
+// VMOVHLPS appends VMOVHLPS to the program. See the function VMOVHLPS.
+func (b *Builder) VMOVHLPS(reg, vvvv, rm VecReg) {
+	b.add("VMOVHLPS", "VMOVHLPS", reg, vvvv, rm)
+}
+
+// VMOVHPD_MR appends VMOVHPD to the program. See the function VMOVHPD_MR.
+func (b *Builder) VMOVHPD_MR(rm Mem, reg VecReg) {
+	b.add("VMOVHPD", "VMOVHPD_MR", rm, reg)
+}
+
+// VMOVHPD_RVM appends VMOVHPD to the program. See the function VMOVHPD_RVM.
+func (b *Builder) VMOVHPD_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VMOVHPD", "VMOVHPD_RVM", reg, vvvv, rm)
+}
+
+// VMOVHPS_MR appends VMOVHPS to the program. See the function VMOVHPS_MR.
+func (b *Builder) VMOVHPS_MR(rm Mem, reg VecReg) {
+	b.add("VMOVHPS", "VMOVHPS_MR", rm, reg)
+}
+
+// VMOVHPS_RVM appends VMOVHPS to the program. See the function VMOVHPS_RVM.
+func (b *Builder) VMOVHPS_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VMOVHPS", "VMOVHPS_RVM", reg, vvvv, rm)
+}
+
+// VMOVLHPS appends VMOVLHPS to the program. See the function VMOVLHPS.
+func (b *Builder) VMOVLHPS(reg, vvvv, rm VecReg) {
+	b.add("VMOVLHPS", "VMOVLHPS", reg, vvvv, rm)
+}
+
+// VMOVLPD_MR appends VMOVLPD to the program. See the function VMOVLPD_MR.
+func (b *Builder) VMOVLPD_MR(rm Mem, reg VecReg) {
+	b.add("VMOVLPD", "VMOVLPD_MR", rm, reg)
+}
+
+// VMOVLPD_RVM appends VMOVLPD to the program. See the function VMOVLPD_RVM.
+func (b *Builder) VMOVLPD_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VMOVLPD", "VMOVLPD_RVM", reg, vvvv, rm)
+}
+
+// VMOVLPS_MR appends VMOVLPS to the program. See the function VMOVLPS_MR.
+func (b *Builder) VMOVLPS_MR(rm Mem, reg VecReg) {
+	b.add("VMOVLPS", "VMOVLPS_MR", rm, reg)
+}
+
+// VMOVLPS_RVM appends VMOVLPS to the program. See the function VMOVLPS_RVM.
+func (b *Builder) VMOVLPS_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VMOVLPS", "VMOVLPS_RVM", reg, vvvv, rm)
+}
+
+// VMOVMSKPD appends VMOVMSKPD to the program. See the function VMOVMSKPD.
+func (b *Builder) VMOVMSKPD(reg Reg, rm VecReg) {
+	b.add("VMOVMSKPD", "VMOVMSKPD", reg, rm)
+}
+
+// VMOVMSKPS appends VMOVMSKPS to the program. See the function VMOVMSKPS.
+func (b *Builder) VMOVMSKPS(reg Reg, rm VecReg) {
+	b.add("VMOVMSKPS", "VMOVMSKPS", reg, rm)
+}
+
+// VMOVNTDQA_FVM appends VMOVNTDQA to the program. See the function VMOVNTDQA_FVM.
+func (b *Builder) VMOVNTDQA_FVM(reg VecReg, rm Mem) {
+	b.add("VMOVNTDQA", "VMOVNTDQA_FVM", reg, rm)
+}
+
+// VMOVNTDQA_RM appends VMOVNTDQA to the program. See the function VMOVNTDQA_RM.
+func (b *Builder) VMOVNTDQA_RM(reg VecReg, rm Mem) {
+	b.add("VMOVNTDQA", "VMOVNTDQA_RM", reg, rm)
+}
+
+// VMOVNTDQ_FVM appends VMOVNTDQ to the program. See the function VMOVNTDQ_FVM.
+func (b *Builder) VMOVNTDQ_FVM(rm Mem, reg VecReg) {
+	b.add("VMOVNTDQ", "VMOVNTDQ_FVM", rm, reg)
+}
+
+// VMOVNTDQ_MR appends VMOVNTDQ to the program. See the function VMOVNTDQ_MR.
+func (b *Builder) VMOVNTDQ_MR(rm Mem, reg VecReg) {
+	b.add("VMOVNTDQ", "VMOVNTDQ_MR", rm, reg)
+}
+
+// VMOVNTPD_FVM appends VMOVNTPD to the program. See the function VMOVNTPD_FVM.
+func (b *Builder) VMOVNTPD_FVM(rm Mem, reg VecReg) {
+	b.add("VMOVNTPD", "VMOVNTPD_FVM", rm, reg)
+}
+
+// VMOVNTPD_MR appends VMOVNTPD to the program. See the function VMOVNTPD_MR.
+func (b *Builder) VMOVNTPD_MR(rm Mem, reg VecReg) {
+	b.add("VMOVNTPD", "VMOVNTPD_MR", rm, reg)
+}
+
+// VMOVNTPS_FVM appends VMOVNTPS to the program. See the function VMOVNTPS_FVM.
+func (b *Builder) VMOVNTPS_FVM(rm Mem, reg VecReg) {
+	b.add("VMOVNTPS", "VMOVNTPS_FVM", rm, reg)
+}
+
+// VMOVNTPS_MR appends VMOVNTPS to the program. See the function VMOVNTPS_MR.
+func (b *Builder) VMOVNTPS_MR(rm Mem, reg VecReg) {
+	b.add("VMOVNTPS", "VMOVNTPS_MR", rm, reg)
+}
+
+// VMOVQ_MR appends VMOVQ to the program. See the function VMOVQ_MR.
+func (b *Builder) VMOVQ_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVQ", "VMOVQ_MR", rm, reg)
+}
+
+// VMOVQ_RM appends VMOVQ to the program. See the function VMOVQ_RM.
+func (b *Builder) VMOVQ_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVQ", "VMOVQ_RM", reg, rm)
+}
+
+// VMOVQ_T1S_MR appends VMOVQ to the program. See the function VMOVQ_T1S_MR.
+func (b *Builder) VMOVQ_T1S_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVQ", "VMOVQ_T1S_MR", rm, reg)
+}
+
+// VMOVQ_T1S_RM appends VMOVQ to the program. See the function VMOVQ_T1S_RM.
+func (b *Builder) VMOVQ_T1S_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVQ", "VMOVQ_T1S_RM", reg, rm)
+}
+
+// VMOVSD_MR appends VMOVSD to the program. See the function VMOVSD_MR.
+func (b *Builder) VMOVSD_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVSD", "VMOVSD_MR", rm, reg)
+}
+
+// VMOVSD_MVR appends VMOVSD to the program. See the function VMOVSD_MVR.
+func (b *Builder) VMOVSD_MVR(rm Register, vvvv, reg VecReg) {
+	b.add("VMOVSD", "VMOVSD_MVR", rm, vvvv, reg)
+}
+
+// VMOVSD_RM appends VMOVSD to the program. See the function VMOVSD_RM.
+func (b *Builder) VMOVSD_RM(reg Register, rm Mem) {
+	b.add("VMOVSD", "VMOVSD_RM", reg, rm)
+}
+
+// VMOVSD_RVM appends VMOVSD to the program. See the function VMOVSD_RVM.
+func (b *Builder) VMOVSD_RVM(reg Register, vvvv, rm VecReg) {
+	b.add("VMOVSD", "VMOVSD_RVM", reg, vvvv, rm)
+}
+
+// VMOVSHDUP_FVM appends VMOVSHDUP to the program. See the function VMOVSHDUP_FVM.
+func (b *Builder) VMOVSHDUP_FVM(reg Register, rm RegMem) {
+	b.add("VMOVSHDUP", "VMOVSHDUP_FVM", reg, rm)
+}
+
+// VMOVSHDUP_RM appends VMOVSHDUP to the program. See the function VMOVSHDUP_RM.
+func (b *Builder) VMOVSHDUP_RM(reg VecReg, rm RegMem) {
+	b.add("VMOVSHDUP", "VMOVSHDUP_RM", reg, rm)
+}
+
+// VMOVSLDUP appends VMOVSLDUP to the program. See the function VMOVSLDUP.
+func (b *Builder) VMOVSLDUP(reg Register, rm RegMem) {
+	b.add("VMOVSLDUP", "VMOVSLDUP", reg, rm)
+}
+
+// VMOVSS_MR appends VMOVSS to the program. See the function VMOVSS_MR.
+func (b *Builder) VMOVSS_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVSS", "VMOVSS_MR", rm, reg)
+}
+
+// VMOVSS_MVR appends VMOVSS to the program. See the function VMOVSS_MVR.
+func (b *Builder) VMOVSS_MVR(rm Register, vvvv, reg VecReg) {
+	b.add("VMOVSS", "VMOVSS_MVR", rm, vvvv, reg)
+}
+
+// VMOVSS_RM appends VMOVSS to the program. See the function VMOVSS_RM.
+func (b *Builder) VMOVSS_RM(reg Register, rm Mem) {
+	b.add("VMOVSS", "VMOVSS_RM", reg, rm)
+}
+
+// VMOVSS_RVM appends VMOVSS to the program. See the function VMOVSS_RVM.
+func (b *Builder) VMOVSS_RVM(reg Register, vvvv, rm VecReg) {
+	b.add("VMOVSS", "VMOVSS_RVM", reg, vvvv, rm)
+}
+
+// VMOVUPD_MR appends VMOVUPD to the program. See the function VMOVUPD_MR.
+func (b *Builder) VMOVUPD_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVUPD", "VMOVUPD_MR", rm, reg)
+}
+
+// VMOVUPD_RM appends VMOVUPD to the program. See the function VMOVUPD_RM.
+func (b *Builder) VMOVUPD_RM(reg Register, rm RegMem) {
+	b.add("VMOVUPD", "VMOVUPD_RM", reg, rm)
+}
+
+// VMOVUPS_MR appends VMOVUPS to the program. See the function VMOVUPS_MR.
+func (b *Builder) VMOVUPS_MR(rm RegMem, reg VecReg) {
+	b.add("VMOVUPS", "VMOVUPS_MR", rm, reg)
+}
+
+// VMOVUPS_RM appends VMOVUPS to the program. See the function VMOVUPS_RM.
+func (b *Builder) VMOVUPS_RM(reg Register, rm RegMem) {
+	b.add("VMOVUPS", "VMOVUPS_RM", reg, rm)
+}
+
+// VMPSADBW appends VMPSADBW to the program. See the function VMPSADBW.
+func (b *Builder) VMPSADBW(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VMPSADBW", "VMPSADBW", reg, vvvv, rm, imm)
+}
+
+// VMULPD_FV appends VMULPD to the program. See the function VMULPD_FV.
+func (b *Builder) VMULPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMULPD", "VMULPD_FV", reg, vvvv, rm)
+}
+
+// VMULPD_RVM appends VMULPD to the program. See the function VMULPD_RVM.
+func (b *Builder) VMULPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMULPD", "VMULPD_RVM", reg, vvvv, rm)
+}
+
+// VMULPS_FV appends VMULPS to the program. See the function VMULPS_FV.
+func (b *Builder) VMULPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMULPS", "VMULPS_FV", reg, vvvv, rm)
+}
+
+// VMULPS_RVM appends VMULPS to the program. See the function VMULPS_RVM.
+func (b *Builder) VMULPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMULPS", "VMULPS_RVM", reg, vvvv, rm)
+}
+
+// VMULSD_RVM appends VMULSD to the program. See the function VMULSD_RVM.
+func (b *Builder) VMULSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMULSD", "VMULSD_RVM", reg, vvvv, rm)
+}
+
+// VMULSD_T1S appends VMULSD to the program. See the function VMULSD_T1S.
+func (b *Builder) VMULSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMULSD", "VMULSD_T1S", reg, vvvv, rm)
+}
+
+// VMULSS_RVM appends VMULSS to the program. See the function VMULSS_RVM.
+func (b *Builder) VMULSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VMULSS", "VMULSS_RVM", reg, vvvv, rm)
+}
+
+// VMULSS_T1S appends VMULSS to the program. See the function VMULSS_T1S.
+func (b *Builder) VMULSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VMULSS", "VMULSS_T1S", reg, vvvv, rm)
+}
+
+// VORPD_FV appends VORPD to the program. See the function VORPD_FV.
+func (b *Builder) VORPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VORPD", "VORPD_FV", reg, vvvv, rm)
+}
+
+// VORPD_RVM appends VORPD to the program. See the function VORPD_RVM.
+func (b *Builder) VORPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VORPD", "VORPD_RVM", reg, vvvv, rm)
+}
+
+// VORPS_FV appends VORPS to the program. See the function VORPS_FV.
+func (b *Builder) VORPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VORPS", "VORPS_FV", reg, vvvv, rm)
+}
+
+// VORPS_RVM appends VORPS to the program. See the function VORPS_RVM.
+func (b *Builder) VORPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VORPS", "VORPS_RVM", reg, vvvv, rm)
+}
+
+// VPABSB_FVM appends VPABSB to the program. See the function VPABSB_FVM.
+func (b *Builder) VPABSB_FVM(reg Register, rm RegMem) {
+	b.add("VPABSB", "VPABSB_FVM", reg, rm)
+}
+
+// VPABSB_RM appends VPABSB to the program. See the function VPABSB_RM.
+func (b *Builder) VPABSB_RM(reg VecReg, rm RegMem) {
+	b.add("VPABSB", "VPABSB_RM", reg, rm)
+}
+
+// VPABSD appends VPABSD to the program. See the function VPABSD.
+func (b *Builder) VPABSD(reg Register, rm RegMem) {
+	b.add("VPABSD", "VPABSD", reg, rm)
+}
+
+// VPABSQ appends VPABSQ to the program. See the function VPABSQ.
+func (b *Builder) VPABSQ(reg Register, rm RegMem) {
+	b.add("VPABSQ", "VPABSQ", reg, rm)
+}
+
+// VPABSW_FVM appends VPABSW to the program. See the function VPABSW_FVM.
+func (b *Builder) VPABSW_FVM(reg Register, rm RegMem) {
+	b.add("VPABSW", "VPABSW_FVM", reg, rm)
+}
+
+// VPABSW_RM appends VPABSW to the program. See the function VPABSW_RM.
+func (b *Builder) VPABSW_RM(reg VecReg, rm RegMem) {
+	b.add("VPABSW", "VPABSW_RM", reg, rm)
+}
+
+// VPACKSSDW_FV appends VPACKSSDW to the program. See the function VPACKSSDW_FV.
+func (b *Builder) VPACKSSDW_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPACKSSDW", "VPACKSSDW_FV", reg, vvvv, rm)
+}
+
+// VPACKSSDW_RVM appends VPACKSSDW to the program. See the function VPACKSSDW_RVM.
+func (b *Builder) VPACKSSDW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPACKSSDW", "VPACKSSDW_RVM", reg, vvvv, rm)
+}
+
+// VPACKSSWB_FVM appends VPACKSSWB to the program. See the function VPACKSSWB_FVM.
+func (b *Builder) VPACKSSWB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPACKSSWB", "VPACKSSWB_FVM", reg, vvvv, rm)
+}
+
+// VPACKSSWB_RVM appends VPACKSSWB to the program. See the function VPACKSSWB_RVM.
+func (b *Builder) VPACKSSWB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPACKSSWB", "VPACKSSWB_RVM", reg, vvvv, rm)
+}
+
+// VPACKUSDW_FV appends VPACKUSDW to the program. See the function VPACKUSDW_FV.
+func (b *Builder) VPACKUSDW_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPACKUSDW", "VPACKUSDW_FV", reg, vvvv, rm)
+}
+
+// VPACKUSDW_RVM appends VPACKUSDW to the program. See the function VPACKUSDW_RVM.
+func (b *Builder) VPACKUSDW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPACKUSDW", "VPACKUSDW_RVM", reg, vvvv, rm)
+}
+
+// VPACKUSWB_FVM appends VPACKUSWB to the program. See the function VPACKUSWB_FVM.
+func (b *Builder) VPACKUSWB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPACKUSWB", "VPACKUSWB_FVM", reg, vvvv, rm)
+}
+
+// VPACKUSWB_RVM appends VPACKUSWB to the program. See the function VPACKUSWB_RVM.
+func (b *Builder) VPACKUSWB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPACKUSWB", "VPACKUSWB_RVM", reg, vvvv, rm)
+}
+
+// VPADDB appends VPADDB to the program. See the function VPADDB.
+func (b *Builder) VPADDB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDB", "VPADDB", reg, vvvv, rm)
+}
+
+// VPADDD appends VPADDD to the program. See the function VPADDD.
+func (b *Builder) VPADDD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDD", "VPADDD", reg, vvvv, rm)
+}
+
+// VPADDQ appends VPADDQ to the program. See the function VPADDQ.
+func (b *Builder) VPADDQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDQ", "VPADDQ", reg, vvvv, rm)
+}
+
+// VPADDSB_FVM appends VPADDSB to the program. See the function VPADDSB_FVM.
+func (b *Builder) VPADDSB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDSB", "VPADDSB_FVM", reg, vvvv, rm)
+}
+
+// VPADDSB_RVM appends VPADDSB to the program. See the function VPADDSB_RVM.
+func (b *Builder) VPADDSB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPADDSB", "VPADDSB_RVM", reg, vvvv, rm)
+}
+
+// VPADDSW_FVM appends VPADDSW to the program. See the function VPADDSW_FVM.
+func (b *Builder) VPADDSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDSW", "VPADDSW_FVM", reg, vvvv, rm)
+}
+
+// VPADDSW_RVM appends VPADDSW to the program. See the function VPADDSW_RVM.
+func (b *Builder) VPADDSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPADDSW", "VPADDSW_RVM", reg, vvvv, rm)
+}
+
+// VPADDUSB_FVM appends VPADDUSB to the program. See the function VPADDUSB_FVM.
+func (b *Builder) VPADDUSB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDUSB", "VPADDUSB_FVM", reg, vvvv, rm)
+}
+
+// VPADDUSB_RVM appends VPADDUSB to the program. See the function VPADDUSB_RVM.
+func (b *Builder) VPADDUSB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPADDUSB", "VPADDUSB_RVM", reg, vvvv, rm)
+}
+
+// VPADDUSW_FVM appends VPADDUSW to the program. See the function VPADDUSW_FVM.
+func (b *Builder) VPADDUSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDUSW", "VPADDUSW_FVM", reg, vvvv, rm)
+}
+
+// VPADDUSW_RVM appends VPADDUSW to the program. See the function VPADDUSW_RVM.
+func (b *Builder) VPADDUSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPADDUSW", "VPADDUSW_RVM", reg, vvvv, rm)
+}
+
+// VPADDW appends VPADDW to the program. See the function VPADDW.
+func (b *Builder) VPADDW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPADDW", "VPADDW", reg, vvvv, rm)
+}
+
+// VPALIGNR_FVM appends VPALIGNR to the program. See the function VPALIGNR_FVM.
+func (b *Builder) VPALIGNR_FVM(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPALIGNR", "VPALIGNR_FVM", reg, vvvv, rm, imm)
+}
+
+// VPALIGNR_RVMI appends VPALIGNR to the program. See the function VPALIGNR_RVMI.
+func (b *Builder) VPALIGNR_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPALIGNR", "VPALIGNR_RVMI", reg, vvvv, rm, imm)
+}
+
+// VPAND appends VPAND to the program. See the function VPAND.
+func (b *Builder) VPAND(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPAND", "VPAND", reg, vvvv, rm)
+}
+
+// VPANDD appends VPANDD to the program. See the function VPANDD.
+func (b *Builder) VPANDD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPANDD", "VPANDD", reg, vvvv, rm)
+}
+
+// VPANDN appends VPANDN to the program. See the function VPANDN.
+func (b *Builder) VPANDN(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPANDN", "VPANDN", reg, vvvv, rm)
+}
+
+// VPANDND appends VPANDND to the program. See the function VPANDND.
+func (b *Builder) VPANDND(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPANDND", "VPANDND", reg, vvvv, rm)
+}
+
+// VPANDNQ appends VPANDNQ to the program. See the function VPANDNQ.
+func (b *Builder) VPANDNQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPANDNQ", "VPANDNQ", reg, vvvv, rm)
+}
+
+// VPANDQ appends VPANDQ to the program. See the function VPANDQ.
+func (b *Builder) VPANDQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPANDQ", "VPANDQ", reg, vvvv, rm)
+}
+
+// VPAVGB_FVM appends VPAVGB to the program. See the function VPAVGB_FVM.
+func (b *Builder) VPAVGB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPAVGB", "VPAVGB_FVM", reg, vvvv, rm)
+}
+
+// VPAVGB_RVM appends VPAVGB to the program. See the function VPAVGB_RVM.
+func (b *Builder) VPAVGB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPAVGB", "VPAVGB_RVM", reg, vvvv, rm)
+}
+
+// VPAVGW_FVM appends VPAVGW to the program. See the function VPAVGW_FVM.
+func (b *Builder) VPAVGW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPAVGW", "VPAVGW_FVM", reg, vvvv, rm)
+}
+
+// VPAVGW_RVM appends VPAVGW to the program. See the function VPAVGW_RVM.
+func (b *Builder) VPAVGW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPAVGW", "VPAVGW_RVM", reg, vvvv, rm)
+}
+
+// VPBLENDD appends VPBLENDD to the program. See the function VPBLENDD.
+func (b *Builder) VPBLENDD(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPBLENDD", "VPBLENDD", reg, vvvv, rm, imm)
+}
+
+// VPBLENDMB appends VPBLENDMB to the program. See the function VPBLENDMB.
+func (b *Builder) VPBLENDMB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPBLENDMB", "VPBLENDMB", reg, vvvv, rm)
+}
+
+// VPBLENDMD appends VPBLENDMD to the program. See the function VPBLENDMD.
+func (b *Builder) VPBLENDMD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPBLENDMD", "VPBLENDMD", reg, vvvv, rm)
+}
+
+// VPBLENDMQ appends VPBLENDMQ to the program. See the function VPBLENDMQ.
+func (b *Builder) VPBLENDMQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPBLENDMQ", "VPBLENDMQ", reg, vvvv, rm)
+}
+
+// VPBLENDMW appends VPBLENDMW to the program. See the function VPBLENDMW.
+func (b *Builder) VPBLENDMW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPBLENDMW", "VPBLENDMW", reg, vvvv, rm)
+}
+
+// VPBLENDVB appends VPBLENDVB to the program. See the function VPBLENDVB.
+func (b *Builder) VPBLENDVB(reg, vvvv VecReg, rm RegMem, is4 VecReg) {
+	b.add("VPBLENDVB", "VPBLENDVB", reg, vvvv, rm, is4)
+}
+
+// VPBLENDW appends VPBLENDW to the program. See the function VPBLENDW.
+func (b *Builder) VPBLENDW(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPBLENDW", "VPBLENDW", reg, vvvv, rm, imm)
+}
+
+// VPBROADCASTB_RM appends VPBROADCASTB to the program. See the function VPBROADCASTB_RM.
+func (b *Builder) VPBROADCASTB_RM(reg VecReg, rm RegMem) {
+	b.add("VPBROADCASTB", "VPBROADCASTB_RM", reg, rm)
+}
+
+// VPBROADCASTB_T1S appends VPBROADCASTB to the program. See the function VPBROADCASTB_T1S.
+func (b *Builder) VPBROADCASTB_T1S(reg Register, rm RegMem) {
+	b.add("VPBROADCASTB", "VPBROADCASTB_T1S", reg, rm)
+}
+
+// VPBROADCASTD_RM appends VPBROADCASTD to the program. See the function VPBROADCASTD_RM.
+func (b *Builder) VPBROADCASTD_RM(reg VecReg, rm RegMem) {
+	b.add("VPBROADCASTD", "VPBROADCASTD_RM", reg, rm)
+}
+
+// VPBROADCASTD_T1S appends VPBROADCASTD to the program. See the function VPBROADCASTD_T1S.
+func (b *Builder) VPBROADCASTD_T1S(reg Register, rm RegMem) {
+	b.add("VPBROADCASTD", "VPBROADCASTD_T1S", reg, rm)
+}
+
+// VPBROADCASTMB2Q appends VPBROADCASTMB2Q to the program. See the function VPBROADCASTMB2Q.
+func (b *Builder) VPBROADCASTMB2Q(reg VecReg, rm MaskReg) {
+	b.add("VPBROADCASTMB2Q", "VPBROADCASTMB2Q", reg, rm)
+}
+
+// VPBROADCASTMW2D appends VPBROADCASTMW2D to the program. See the function VPBROADCASTMW2D.
+func (b *Builder) VPBROADCASTMW2D(reg VecReg, rm MaskReg) {
+	b.add("VPBROADCASTMW2D", "VPBROADCASTMW2D", reg, rm)
+}
+
+// VPBROADCASTQ_RM appends VPBROADCASTQ to the program. See the function VPBROADCASTQ_RM.
+func (b *Builder) VPBROADCASTQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPBROADCASTQ", "VPBROADCASTQ_RM", reg, rm)
+}
+
+// VPBROADCASTQ_T1S appends VPBROADCASTQ to the program. See the function VPBROADCASTQ_T1S.
+func (b *Builder) VPBROADCASTQ_T1S(reg Register, rm RegMem) {
+	b.add("VPBROADCASTQ", "VPBROADCASTQ_T1S", reg, rm)
+}
+
+// VPBROADCASTW_RM appends VPBROADCASTW to the program. See the function VPBROADCASTW_RM.
+func (b *Builder) VPBROADCASTW_RM(reg VecReg, rm RegMem) {
+	b.add("VPBROADCASTW", "VPBROADCASTW_RM", reg, rm)
+}
+
+// VPBROADCASTW_T1S appends VPBROADCASTW to the program. See the function VPBROADCASTW_T1S.
+func (b *Builder) VPBROADCASTW_T1S(reg Register, rm RegMem) {
+	b.add("VPBROADCASTW", "VPBROADCASTW_T1S", reg, rm)
+}
+
+// VPCLMULQDQ appends VPCLMULQDQ to the program. See the function VPCLMULQDQ.
+func (b *Builder) VPCLMULQDQ(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCLMULQDQ", "VPCLMULQDQ", reg, vvvv, rm, imm)
+}
+
+// VPCMPB appends VPCMPB to the program. See the function VPCMPB.
+func (b *Builder) VPCMPB(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPB", "VPCMPB", reg, vvvv, rm, imm)
+}
+
+// VPCMPD appends VPCMPD to the program. See the function VPCMPD.
+func (b *Builder) VPCMPD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPD", "VPCMPD", reg, vvvv, rm, imm)
+}
+
+// VPCMPEQB_FVM appends VPCMPEQB to the program. See the function VPCMPEQB_FVM.
+func (b *Builder) VPCMPEQB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQB", "VPCMPEQB_FVM", reg, vvvv, rm)
+}
+
+// VPCMPEQB_RVM appends VPCMPEQB to the program. See the function VPCMPEQB_RVM.
+func (b *Builder) VPCMPEQB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQB", "VPCMPEQB_RVM", reg, vvvv, rm)
+}
+
+// VPCMPEQD_FV appends VPCMPEQD to the program. See the function VPCMPEQD_FV.
+func (b *Builder) VPCMPEQD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQD", "VPCMPEQD_FV", reg, vvvv, rm)
+}
+
+// VPCMPEQD_RVM appends VPCMPEQD to the program. See the function VPCMPEQD_RVM.
+func (b *Builder) VPCMPEQD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQD", "VPCMPEQD_RVM", reg, vvvv, rm)
+}
+
+// VPCMPEQQ_FV appends VPCMPEQQ to the program. See the function VPCMPEQQ_FV.
+func (b *Builder) VPCMPEQQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQQ", "VPCMPEQQ_FV", reg, vvvv, rm)
+}
+
+// VPCMPEQQ_RVM appends VPCMPEQQ to the program. See the function VPCMPEQQ_RVM.
+func (b *Builder) VPCMPEQQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQQ", "VPCMPEQQ_RVM", reg, vvvv, rm)
+}
+
+// VPCMPEQW appends VPCMPEQW to the program. See the function VPCMPEQW.
+func (b *Builder) VPCMPEQW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPEQW", "VPCMPEQW", reg, vvvv, rm)
+}
+
+// VPCMPESTRI appends VPCMPESTRI to the program. See the function VPCMPESTRI.
+func (b *Builder) VPCMPESTRI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPESTRI", "VPCMPESTRI", reg, rm, imm)
+}
+
+// VPCMPESTRM appends VPCMPESTRM to the program. See the function VPCMPESTRM.
+func (b *Builder) VPCMPESTRM(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPESTRM", "VPCMPESTRM", reg, rm, imm)
+}
+
+// VPCMPGTB_FVM appends VPCMPGTB to the program. See the function VPCMPGTB_FVM.
+func (b *Builder) VPCMPGTB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTB", "VPCMPGTB_FVM", reg, vvvv, rm)
+}
+
+// VPCMPGTB_RVM appends VPCMPGTB to the program. See the function VPCMPGTB_RVM.
+func (b *Builder) VPCMPGTB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTB", "VPCMPGTB_RVM", reg, vvvv, rm)
+}
+
+// VPCMPGTD_FV appends VPCMPGTD to the program. See the function VPCMPGTD_FV.
+func (b *Builder) VPCMPGTD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTD", "VPCMPGTD_FV", reg, vvvv, rm)
+}
+
+// VPCMPGTD_RVM appends VPCMPGTD to the program. See the function VPCMPGTD_RVM.
+func (b *Builder) VPCMPGTD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTD", "VPCMPGTD_RVM", reg, vvvv, rm)
+}
+
+// VPCMPGTQ_FV appends VPCMPGTQ to the program. See the function VPCMPGTQ_FV.
+func (b *Builder) VPCMPGTQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTQ", "VPCMPGTQ_FV", reg, vvvv, rm)
+}
+
+// VPCMPGTQ_RVM appends VPCMPGTQ to the program. See the function VPCMPGTQ_RVM.
+func (b *Builder) VPCMPGTQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTQ", "VPCMPGTQ_RVM", reg, vvvv, rm)
+}
+
+// VPCMPGTW appends VPCMPGTW to the program. See the function VPCMPGTW.
+func (b *Builder) VPCMPGTW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPCMPGTW", "VPCMPGTW", reg, vvvv, rm)
+}
+
+// VPCMPISTRI appends VPCMPISTRI to the program. See the function VPCMPISTRI.
+func (b *Builder) VPCMPISTRI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPISTRI", "VPCMPISTRI", reg, rm, imm)
+}
+
+// VPCMPISTRM appends VPCMPISTRM to the program. See the function VPCMPISTRM.
+func (b *Builder) VPCMPISTRM(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPISTRM", "VPCMPISTRM", reg, rm, imm)
+}
+
+// VPCMPQ appends VPCMPQ to the program. See the function VPCMPQ.
+func (b *Builder) VPCMPQ(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPQ", "VPCMPQ", reg, vvvv, rm, imm)
+}
+
+// VPCMPUB appends VPCMPUB to the program. See the function VPCMPUB.
+func (b *Builder) VPCMPUB(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPUB", "VPCMPUB", reg, vvvv, rm, imm)
+}
+
+// VPCMPUD appends VPCMPUD to the program. See the function VPCMPUD.
+func (b *Builder) VPCMPUD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPUD", "VPCMPUD", reg, vvvv, rm, imm)
+}
+
+// VPCMPUQ appends VPCMPUQ to the program. See the function VPCMPUQ.
+func (b *Builder) VPCMPUQ(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPUQ", "VPCMPUQ", reg, vvvv, rm, imm)
+}
+
+// VPCMPUW appends VPCMPUW to the program. See the function VPCMPUW.
+func (b *Builder) VPCMPUW(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPUW", "VPCMPUW", reg, vvvv, rm, imm)
+}
+
+// VPCMPW appends VPCMPW to the program. See the function VPCMPW.
+func (b *Builder) VPCMPW(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPCMPW", "VPCMPW", reg, vvvv, rm, imm)
+}
+
+// VPCOMPRESSB appends VPCOMPRESSB to the program. See the function VPCOMPRESSB.
+func (b *Builder) VPCOMPRESSB(rm RegMem, reg VecReg) {
+	b.add("VPCOMPRESSB", "VPCOMPRESSB", rm, reg)
+}
+
+// VPCOMPRESSD appends VPCOMPRESSD to the program. See the function VPCOMPRESSD.
+func (b *Builder) VPCOMPRESSD(rm RegMem, reg VecReg) {
+	b.add("VPCOMPRESSD", "VPCOMPRESSD", rm, reg)
+}
+
+// VPCOMPRESSQ appends VPCOMPRESSQ to the program. See the function VPCOMPRESSQ.
+func (b *Builder) VPCOMPRESSQ(rm RegMem, reg VecReg) {
+	b.add("VPCOMPRESSQ", "VPCOMPRESSQ", rm, reg)
+}
+
+// VPCOMPRESSW appends VPCOMPRESSW to the program. See the function VPCOMPRESSW.
+func (b *Builder) VPCOMPRESSW(rm RegMem, reg VecReg) {
+	b.add("VPCOMPRESSW", "VPCOMPRESSW", rm, reg)
+}
+
+// VPCONFLICTD appends VPCONFLICTD to the program. See the function VPCONFLICTD.
+func (b *Builder) VPCONFLICTD(reg Register, rm RegMem) {
+	b.add("VPCONFLICTD", "VPCONFLICTD", reg, rm)
+}
+
+// VPCONFLICTQ appends VPCONFLICTQ to the program. See the function VPCONFLICTQ.
+func (b *Builder) VPCONFLICTQ(reg Register, rm RegMem) {
+	b.add("VPCONFLICTQ", "VPCONFLICTQ", reg, rm)
+}
+
+// VPDPBUSD appends VPDPBUSD to the program. See the function VPDPBUSD.
+func (b *Builder) VPDPBUSD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPDPBUSD", "VPDPBUSD", reg, vvvv, rm)
+}
+
+// VPDPBUSDS appends VPDPBUSDS to the program. See the function VPDPBUSDS.
+func (b *Builder) VPDPBUSDS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPDPBUSDS", "VPDPBUSDS", reg, vvvv, rm)
+}
+
+// VPDPWSSD appends VPDPWSSD to the program. See the function VPDPWSSD.
+func (b *Builder) VPDPWSSD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPDPWSSD", "VPDPWSSD", reg, vvvv, rm)
+}
+
+// VPDPWSSDS appends VPDPWSSDS to the program. See the function VPDPWSSDS.
+func (b *Builder) VPDPWSSDS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPDPWSSDS", "VPDPWSSDS", reg, vvvv, rm)
+}
+
+// VPERM2F128 appends VPERM2F128 to the program. See the function VPERM2F128.
+func (b *Builder) VPERM2F128(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPERM2F128", "VPERM2F128", reg, vvvv, rm, imm)
+}
+
+// VPERM2I128 appends VPERM2I128 to the program. See the function VPERM2I128.
+func (b *Builder) VPERM2I128(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPERM2I128", "VPERM2I128", reg, vvvv, rm, imm)
+}
+
+// VPERMB appends VPERMB to the program. See the function VPERMB.
+func (b *Builder) VPERMB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMB", "VPERMB", reg, vvvv, rm)
+}
+
+// VPERMD_FV appends VPERMD to the program. See the function VPERMD_FV.
+func (b *Builder) VPERMD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMD", "VPERMD_FV", reg, vvvv, rm)
+}
+
+// VPERMD_RVM appends VPERMD to the program. See the function VPERMD_RVM.
+func (b *Builder) VPERMD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPERMD", "VPERMD_RVM", reg, vvvv, rm)
+}
+
+// VPERMI2B appends VPERMI2B to the program. See the function VPERMI2B.
+func (b *Builder) VPERMI2B(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMI2B", "VPERMI2B", reg, vvvv, rm)
+}
+
+// VPERMI2D appends VPERMI2D to the program. See the function VPERMI2D.
+func (b *Builder) VPERMI2D(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMI2D", "VPERMI2D", reg, vvvv, rm)
+}
+
+// VPERMI2PD appends VPERMI2PD to the program. See the function VPERMI2PD.
+func (b *Builder) VPERMI2PD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMI2PD", "VPERMI2PD", reg, vvvv, rm)
+}
+
+// VPERMI2PS appends VPERMI2PS to the program. See the function VPERMI2PS.
+func (b *Builder) VPERMI2PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMI2PS", "VPERMI2PS", reg, vvvv, rm)
+}
+
+// VPERMI2Q appends VPERMI2Q to the program. See the function VPERMI2Q.
+func (b *Builder) VPERMI2Q(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMI2Q", "VPERMI2Q", reg, vvvv, rm)
+}
+
+// VPERMI2W appends VPERMI2W to the program. See the function VPERMI2W.
+func (b *Builder) VPERMI2W(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMI2W", "VPERMI2W", reg, vvvv, rm)
+}
+
+// VPERMILPD_RMI appends VPERMILPD to the program. See the function VPERMILPD_RMI.
+func (b *Builder) VPERMILPD_RMI(reg Register, rm RegMem, imm Imm) {
+	b.add("VPERMILPD", "VPERMILPD_RMI", reg, rm, imm)
+}
+
+// VPERMILPD_RVM appends VPERMILPD to the program. See the function VPERMILPD_RVM.
+func (b *Builder) VPERMILPD_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMILPD", "VPERMILPD_RVM", reg, vvvv, rm)
+}
+
+// VPERMILPS_RMI appends VPERMILPS to the program. See the function VPERMILPS_RMI.
+func (b *Builder) VPERMILPS_RMI(reg Register, rm RegMem, imm Imm) {
+	b.add("VPERMILPS", "VPERMILPS_RMI", reg, rm, imm)
+}
+
+// VPERMILPS_RVM appends VPERMILPS to the program. See the function VPERMILPS_RVM.
+func (b *Builder) VPERMILPS_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMILPS", "VPERMILPS_RVM", reg, vvvv, rm)
+}
+
+// VPERMPD_RMI appends VPERMPD to the program. See the function VPERMPD_RMI.
+func (b *Builder) VPERMPD_RMI(reg Register, rm RegMem, imm Imm) {
+	b.add("VPERMPD", "VPERMPD_RMI", reg, rm, imm)
+}
+
+// VPERMPD_RVM appends VPERMPD to the program. See the function VPERMPD_RVM.
+func (b *Builder) VPERMPD_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMPD", "VPERMPD_RVM", reg, vvvv, rm)
+}
+
+// VPERMPS_FV appends VPERMPS to the program. See the function VPERMPS_FV.
+func (b *Builder) VPERMPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMPS", "VPERMPS_FV", reg, vvvv, rm)
+}
+
+// VPERMPS_RVM appends VPERMPS to the program. See the function VPERMPS_RVM.
+func (b *Builder) VPERMPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPERMPS", "VPERMPS_RVM", reg, vvvv, rm)
+}
+
+// VPERMQ_RMI appends VPERMQ to the program. See the function VPERMQ_RMI.
+func (b *Builder) VPERMQ_RMI(reg Register, rm RegMem, imm Imm) {
+	b.add("VPERMQ", "VPERMQ_RMI", reg, rm, imm)
+}
+
+// VPERMQ_RVM appends VPERMQ to the program. See the function VPERMQ_RVM.
+func (b *Builder) VPERMQ_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMQ", "VPERMQ_RVM", reg, vvvv, rm)
+}
+
+// VPERMT2B appends VPERMT2B to the program. See the function VPERMT2B.
+func (b *Builder) VPERMT2B(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMT2B", "VPERMT2B", reg, vvvv, rm)
+}
+
+// VPERMT2D appends VPERMT2D to the program. See the function VPERMT2D.
+func (b *Builder) VPERMT2D(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMT2D", "VPERMT2D", reg, vvvv, rm)
+}
+
+// VPERMT2PD appends VPERMT2PD to the program. See the function VPERMT2PD.
+func (b *Builder) VPERMT2PD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMT2PD", "VPERMT2PD", reg, vvvv, rm)
+}
+
+// VPERMT2PS appends VPERMT2PS to the program. See the function VPERMT2PS.
+func (b *Builder) VPERMT2PS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMT2PS", "VPERMT2PS", reg, vvvv, rm)
+}
+
+// VPERMT2Q appends VPERMT2Q to the program. See the function VPERMT2Q.
+func (b *Builder) VPERMT2Q(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMT2Q", "VPERMT2Q", reg, vvvv, rm)
+}
+
+// VPERMT2W appends VPERMT2W to the program. See the function VPERMT2W.
+func (b *Builder) VPERMT2W(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMT2W", "VPERMT2W", reg, vvvv, rm)
+}
+
+// VPERMW appends VPERMW to the program. See the function VPERMW.
+func (b *Builder) VPERMW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPERMW", "VPERMW", reg, vvvv, rm)
+}
+
+// VPEXPANDB appends VPEXPANDB to the program. See the function VPEXPANDB.
+func (b *Builder) VPEXPANDB(reg Register, rm RegMem) {
+	b.add("VPEXPANDB", "VPEXPANDB", reg, rm)
+}
+
+// VPEXPANDD appends VPEXPANDD to the program. See the function VPEXPANDD.
+func (b *Builder) VPEXPANDD(reg Register, rm RegMem) {
+	b.add("VPEXPANDD", "VPEXPANDD", reg, rm)
+}
+
+// VPEXPANDQ appends VPEXPANDQ to the program. See the function VPEXPANDQ.
+func (b *Builder) VPEXPANDQ(reg Register, rm RegMem) {
+	b.add("VPEXPANDQ", "VPEXPANDQ", reg, rm)
+}
+
+// VPEXPANDW appends VPEXPANDW to the program. See the function VPEXPANDW.
+func (b *Builder) VPEXPANDW(reg Register, rm RegMem) {
+	b.add("VPEXPANDW", "VPEXPANDW", reg, rm)
+}
+
+// VPEXTRB_MRI appends VPEXTRB to the program. See the function VPEXTRB_MRI.
+func (b *Builder) VPEXTRB_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRB", "VPEXTRB_MRI", rm, reg, imm)
+}
+
+// VPEXTRB_T1S_MRI appends VPEXTRB to the program. See the function VPEXTRB_T1S_MRI.
+func (b *Builder) VPEXTRB_T1S_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRB", "VPEXTRB_T1S_MRI", rm, reg, imm)
+}
+
+// VPEXTRD_MRI appends VPEXTRD to the program. See the function VPEXTRD_MRI.
+func (b *Builder) VPEXTRD_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRD", "VPEXTRD_MRI", rm, reg, imm)
+}
+
+// VPEXTRD_T1S_MRI appends VPEXTRD to the program. See the function VPEXTRD_T1S_MRI.
+func (b *Builder) VPEXTRD_T1S_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRD", "VPEXTRD_T1S_MRI", rm, reg, imm)
+}
+
+// VPEXTRQ_MRI appends VPEXTRQ to the program. See the function VPEXTRQ_MRI.
+func (b *Builder) VPEXTRQ_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRQ", "VPEXTRQ_MRI", rm, reg, imm)
+}
+
+// VPEXTRQ_T1S_MRI appends VPEXTRQ to the program. See the function VPEXTRQ_T1S_MRI.
+func (b *Builder) VPEXTRQ_T1S_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRQ", "VPEXTRQ_T1S_MRI", rm, reg, imm)
+}
+
+// VPEXTRW_MRI appends VPEXTRW to the program. See the function VPEXTRW_MRI.
+func (b *Builder) VPEXTRW_MRI(rm RegMem, reg VecReg, imm Imm) {
+	b.add("VPEXTRW", "VPEXTRW_MRI", rm, reg, imm)
+}
+
+// VPEXTRW_RMI appends VPEXTRW to the program. See the function VPEXTRW_RMI.
+func (b *Builder) VPEXTRW_RMI(reg Reg, rm VecReg, imm Imm) {
+	b.add("VPEXTRW", "VPEXTRW_RMI", reg, rm, imm)
+}
+
+// VPGATHERDD_RMV appends VPGATHERDD to the program. See the function VPGATHERDD_RMV.
+func (b *Builder) VPGATHERDD_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VPGATHERDD", "VPGATHERDD_RMV", reg, vsib, vvvv)
+}
+
+// VPGATHERDD_T1S appends VPGATHERDD to the program. See the function VPGATHERDD_T1S.
+func (b *Builder) VPGATHERDD_T1S(reg Register, vsib Mem) {
+	b.add("VPGATHERDD", "VPGATHERDD_T1S", reg, vsib)
+}
+
+// VPGATHERDQ_RMV appends VPGATHERDQ to the program. See the function VPGATHERDQ_RMV.
+func (b *Builder) VPGATHERDQ_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VPGATHERDQ", "VPGATHERDQ_RMV", reg, vsib, vvvv)
+}
+
+// VPGATHERDQ_T1S appends VPGATHERDQ to the program. See the function VPGATHERDQ_T1S.
+func (b *Builder) VPGATHERDQ_T1S(reg Register, vsib Mem) {
+	b.add("VPGATHERDQ", "VPGATHERDQ_T1S", reg, vsib)
+}
+
+// VPGATHERQD_RMV appends VPGATHERQD to the program. See the function VPGATHERQD_RMV.
+func (b *Builder) VPGATHERQD_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VPGATHERQD", "VPGATHERQD_RMV", reg, vsib, vvvv)
+}
+
+// VPGATHERQD_T1S appends VPGATHERQD to the program. See the function VPGATHERQD_T1S.
+func (b *Builder) VPGATHERQD_T1S(reg Register, vsib Mem) {
+	b.add("VPGATHERQD", "VPGATHERQD_T1S", reg, vsib)
+}
+
+// VPGATHERQQ_RMV appends VPGATHERQQ to the program. See the function VPGATHERQQ_RMV.
+func (b *Builder) VPGATHERQQ_RMV(reg VecReg, vsib Mem, vvvv VecReg) {
+	b.add("VPGATHERQQ", "VPGATHERQQ_RMV", reg, vsib, vvvv)
+}
+
+// VPGATHERQQ_T1S appends VPGATHERQQ to the program. See the function VPGATHERQQ_T1S.
+func (b *Builder) VPGATHERQQ_T1S(reg Register, vsib Mem) {
+	b.add("VPGATHERQQ", "VPGATHERQQ_T1S", reg, vsib)
+}
+
+// VPHADDD appends VPHADDD to the program. See the function VPHADDD.
+func (b *Builder) VPHADDD(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPHADDD", "VPHADDD", reg, vvvv, rm)
+}
+
+// VPHADDSW appends VPHADDSW to the program. See the function VPHADDSW.
+func (b *Builder) VPHADDSW(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPHADDSW", "VPHADDSW", reg, vvvv, rm)
+}
+
+// VPHADDW appends VPHADDW to the program. See the function VPHADDW.
+func (b *Builder) VPHADDW(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPHADDW", "VPHADDW", reg, vvvv, rm)
+}
+
+// VPHMINPOSUW appends VPHMINPOSUW to the program. See the function VPHMINPOSUW.
+func (b *Builder) VPHMINPOSUW(reg VecReg, rm RegMem) {
+	b.add("VPHMINPOSUW", "VPHMINPOSUW", reg, rm)
+}
+
+// VPHSUBD appends VPHSUBD to the program. See the function VPHSUBD.
+func (b *Builder) VPHSUBD(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPHSUBD", "VPHSUBD", reg, vvvv, rm)
+}
+
+// VPHSUBSW appends VPHSUBSW to the program. See the function VPHSUBSW.
+func (b *Builder) VPHSUBSW(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPHSUBSW", "VPHSUBSW", reg, vvvv, rm)
+}
+
+// VPHSUBW appends VPHSUBW to the program. See the function VPHSUBW.
+func (b *Builder) VPHSUBW(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPHSUBW", "VPHSUBW", reg, vvvv, rm)
+}
+
+// VPINSRB_RVMI appends VPINSRB to the program. See the function VPINSRB_RVMI.
+func (b *Builder) VPINSRB_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRB", "VPINSRB_RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRB_T1S__RVMI appends VPINSRB to the program. See the function VPINSRB_T1S__RVMI.
+func (b *Builder) VPINSRB_T1S__RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRB", "VPINSRB_T1S__RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRD_RVMI appends VPINSRD to the program. See the function VPINSRD_RVMI.
+func (b *Builder) VPINSRD_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRD", "VPINSRD_RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRD_T1S__RVMI appends VPINSRD to the program. See the function VPINSRD_T1S__RVMI.
+func (b *Builder) VPINSRD_T1S__RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRD", "VPINSRD_T1S__RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRQ_RVMI appends VPINSRQ to the program. See the function VPINSRQ_RVMI.
+func (b *Builder) VPINSRQ_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRQ", "VPINSRQ_RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRQ_T1S__RVMI appends VPINSRQ to the program. See the function VPINSRQ_T1S__RVMI.
+func (b *Builder) VPINSRQ_T1S__RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRQ", "VPINSRQ_T1S__RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRW_RVMI appends VPINSRW to the program. See the function VPINSRW_RVMI.
+func (b *Builder) VPINSRW_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRW", "VPINSRW_RVMI", reg, vvvv, rm, imm)
+}
+
+// VPINSRW_T1S__RVMI appends VPINSRW to the program. See the function VPINSRW_T1S__RVMI.
+func (b *Builder) VPINSRW_T1S__RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPINSRW", "VPINSRW_T1S__RVMI", reg, vvvv, rm, imm)
+}
+
+// VPLZCNTD appends VPLZCNTD to the program. See the function VPLZCNTD.
+func (b *Builder) VPLZCNTD(reg Register, rm RegMem) {
+	b.add("VPLZCNTD", "VPLZCNTD", reg, rm)
+}
+
+// VPLZCNTQ appends VPLZCNTQ to the program. See the function VPLZCNTQ.
+func (b *Builder) VPLZCNTQ(reg Register, rm RegMem) {
+	b.add("VPLZCNTQ", "VPLZCNTQ", reg, rm)
+}
+
+// VPMADD52HUQ appends VPMADD52HUQ to the program. See the function VPMADD52HUQ.
+func (b *Builder) VPMADD52HUQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMADD52HUQ", "VPMADD52HUQ", reg, vvvv, rm)
+}
+
+// VPMADD52LUQ appends VPMADD52LUQ to the program. See the function VPMADD52LUQ.
+func (b *Builder) VPMADD52LUQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMADD52LUQ", "VPMADD52LUQ", reg, vvvv, rm)
+}
+
+// VPMADDUBSW_FVM appends VPMADDUBSW to the program. See the function VPMADDUBSW_FVM.
+func (b *Builder) VPMADDUBSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMADDUBSW", "VPMADDUBSW_FVM", reg, vvvv, rm)
+}
+
+// VPMADDUBSW_RVM appends VPMADDUBSW to the program. See the function VPMADDUBSW_RVM.
+func (b *Builder) VPMADDUBSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMADDUBSW", "VPMADDUBSW_RVM", reg, vvvv, rm)
+}
+
+// VPMADDWD_FVM appends VPMADDWD to the program. See the function VPMADDWD_FVM.
+func (b *Builder) VPMADDWD_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMADDWD", "VPMADDWD_FVM", reg, vvvv, rm)
+}
+
+// VPMADDWD_RVM appends VPMADDWD to the program. See the function VPMADDWD_RVM.
+func (b *Builder) VPMADDWD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMADDWD", "VPMADDWD_RVM", reg, vvvv, rm)
+}
+
+// VPMASKMOVD_MVR appends VPMASKMOVD to the program. See the function VPMASKMOVD_MVR.
+func (b *Builder) VPMASKMOVD_MVR(rm Mem, vvvv, reg VecReg) {
+	b.add("VPMASKMOVD", "VPMASKMOVD_MVR", rm, vvvv, reg)
+}
+
+// VPMASKMOVD_RVM appends VPMASKMOVD to the program. See the function VPMASKMOVD_RVM.
+func (b *Builder) VPMASKMOVD_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VPMASKMOVD", "VPMASKMOVD_RVM", reg, vvvv, rm)
+}
+
+// VPMASKMOVQ_MVR appends VPMASKMOVQ to the program. See the function VPMASKMOVQ_MVR.
+func (b *Builder) VPMASKMOVQ_MVR(rm Mem, vvvv, reg VecReg) {
+	b.add("VPMASKMOVQ", "VPMASKMOVQ_MVR", rm, vvvv, reg)
+}
+
+// VPMASKMOVQ_RVM appends VPMASKMOVQ to the program. See the function VPMASKMOVQ_RVM.
+func (b *Builder) VPMASKMOVQ_RVM(reg, vvvv VecReg, rm Mem) {
+	b.add("VPMASKMOVQ", "VPMASKMOVQ_RVM", reg, vvvv, rm)
+}
+
+// VPMAXSB_FVM appends VPMAXSB to the program. See the function VPMAXSB_FVM.
+func (b *Builder) VPMAXSB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSB", "VPMAXSB_FVM", reg, vvvv, rm)
+}
+
+// VPMAXSB_RVM appends VPMAXSB to the program. See the function VPMAXSB_RVM.
+func (b *Builder) VPMAXSB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSB", "VPMAXSB_RVM", reg, vvvv, rm)
+}
+
+// VPMAXSD_FV appends VPMAXSD to the program. See the function VPMAXSD_FV.
+func (b *Builder) VPMAXSD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSD", "VPMAXSD_FV", reg, vvvv, rm)
+}
+
+// VPMAXSD_RVM appends VPMAXSD to the program. See the function VPMAXSD_RVM.
+func (b *Builder) VPMAXSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSD", "VPMAXSD_RVM", reg, vvvv, rm)
+}
+
+// VPMAXSQ appends VPMAXSQ to the program. See the function VPMAXSQ.
+func (b *Builder) VPMAXSQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSQ", "VPMAXSQ", reg, vvvv, rm)
+}
+
+// VPMAXSW_FVM appends VPMAXSW to the program. See the function VPMAXSW_FVM.
+func (b *Builder) VPMAXSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSW", "VPMAXSW_FVM", reg, vvvv, rm)
+}
+
+// VPMAXSW_RVM appends VPMAXSW to the program. See the function VPMAXSW_RVM.
+func (b *Builder) VPMAXSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXSW", "VPMAXSW_RVM", reg, vvvv, rm)
+}
+
+// VPMAXUB_FVM appends VPMAXUB to the program. See the function VPMAXUB_FVM.
+func (b *Builder) VPMAXUB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXUB", "VPMAXUB_FVM", reg, vvvv, rm)
+}
+
+// VPMAXUB_RVM appends VPMAXUB to the program. See the function VPMAXUB_RVM.
+func (b *Builder) VPMAXUB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXUB", "VPMAXUB_RVM", reg, vvvv, rm)
+}
+
+// VPMAXUD appends VPMAXUD to the program. See the function VPMAXUD.
+func (b *Builder) VPMAXUD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXUD", "VPMAXUD", reg, vvvv, rm)
+}
+
+// VPMAXUQ appends VPMAXUQ to the program. See the function VPMAXUQ.
+func (b *Builder) VPMAXUQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXUQ", "VPMAXUQ", reg, vvvv, rm)
+}
+
+// VPMAXUW_FVM appends VPMAXUW to the program. See the function VPMAXUW_FVM.
+func (b *Builder) VPMAXUW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXUW", "VPMAXUW_FVM", reg, vvvv, rm)
+}
+
+// VPMAXUW_RVM appends VPMAXUW to the program. See the function VPMAXUW_RVM.
+func (b *Builder) VPMAXUW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMAXUW", "VPMAXUW_RVM", reg, vvvv, rm)
+}
+
+// VPMINSB_FVM appends VPMINSB to the program. See the function VPMINSB_FVM.
+func (b *Builder) VPMINSB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINSB", "VPMINSB_FVM", reg, vvvv, rm)
+}
+
+// VPMINSB_RVM appends VPMINSB to the program. See the function VPMINSB_RVM.
+func (b *Builder) VPMINSB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMINSB", "VPMINSB_RVM", reg, vvvv, rm)
+}
+
+// VPMINSD appends VPMINSD to the program. See the function VPMINSD.
+func (b *Builder) VPMINSD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINSD", "VPMINSD", reg, vvvv, rm)
+}
+
+// VPMINSQ appends VPMINSQ to the program. See the function VPMINSQ.
+func (b *Builder) VPMINSQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINSQ", "VPMINSQ", reg, vvvv, rm)
+}
+
+// VPMINSW_FVM appends VPMINSW to the program. See the function VPMINSW_FVM.
+func (b *Builder) VPMINSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINSW", "VPMINSW_FVM", reg, vvvv, rm)
+}
+
+// VPMINSW_RVM appends VPMINSW to the program. See the function VPMINSW_RVM.
+func (b *Builder) VPMINSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMINSW", "VPMINSW_RVM", reg, vvvv, rm)
+}
+
+// VPMINUB_FVM appends VPMINUB to the program. See the function VPMINUB_FVM.
+func (b *Builder) VPMINUB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUB", "VPMINUB_FVM", reg, vvvv, rm)
+}
+
+// VPMINUB_RVM appends VPMINUB to the program. See the function VPMINUB_RVM.
+func (b *Builder) VPMINUB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUB", "VPMINUB_RVM", reg, vvvv, rm)
+}
+
+// VPMINUD_FV appends VPMINUD to the program. See the function VPMINUD_FV.
+func (b *Builder) VPMINUD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUD", "VPMINUD_FV", reg, vvvv, rm)
+}
+
+// VPMINUD_RVM appends VPMINUD to the program. See the function VPMINUD_RVM.
+func (b *Builder) VPMINUD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUD", "VPMINUD_RVM", reg, vvvv, rm)
+}
+
+// VPMINUQ appends VPMINUQ to the program. See the function VPMINUQ.
+func (b *Builder) VPMINUQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUQ", "VPMINUQ", reg, vvvv, rm)
+}
+
+// VPMINUW_FVM appends VPMINUW to the program. See the function VPMINUW_FVM.
+func (b *Builder) VPMINUW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUW", "VPMINUW_FVM", reg, vvvv, rm)
+}
+
+// VPMINUW_RVM appends VPMINUW to the program. See the function VPMINUW_RVM.
+func (b *Builder) VPMINUW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMINUW", "VPMINUW_RVM", reg, vvvv, rm)
+}
+
+// VPMOVB2M appends VPMOVB2M to the program. See the function VPMOVB2M.
+func (b *Builder) VPMOVB2M(reg MaskReg, rm VecReg) {
+	b.add("VPMOVB2M", "VPMOVB2M", reg, rm)
+}
+
+// VPMOVD2M appends VPMOVD2M to the program. See the function VPMOVD2M.
+func (b *Builder) VPMOVD2M(reg MaskReg, rm VecReg) {
+	b.add("VPMOVD2M", "VPMOVD2M", reg, rm)
+}
+
+// VPMOVDB appends VPMOVDB to the program. See the function VPMOVDB.
+func (b *Builder) VPMOVDB(rm RegMem, reg VecReg) {
+	b.add("VPMOVDB", "VPMOVDB", rm, reg)
+}
+
+// VPMOVDW appends VPMOVDW to the program. See the function VPMOVDW.
+func (b *Builder) VPMOVDW(rm RegMem, reg VecReg) {
+	b.add("VPMOVDW", "VPMOVDW", rm, reg)
+}
+
+// VPMOVM2B appends VPMOVM2B to the program. See the function VPMOVM2B.
+func (b *Builder) VPMOVM2B(reg VecReg, rm MaskReg) {
+	b.add("VPMOVM2B", "VPMOVM2B", reg, rm)
+}
+
+// VPMOVM2D appends VPMOVM2D to the program. See the function VPMOVM2D.
+func (b *Builder) VPMOVM2D(reg VecReg, rm MaskReg) {
+	b.add("VPMOVM2D", "VPMOVM2D", reg, rm)
+}
+
+// VPMOVM2Q appends VPMOVM2Q to the program. See the function VPMOVM2Q.
+func (b *Builder) VPMOVM2Q(reg VecReg, rm MaskReg) {
+	b.add("VPMOVM2Q", "VPMOVM2Q", reg, rm)
+}
+
+// VPMOVM2W appends VPMOVM2W to the program. See the function VPMOVM2W.
+func (b *Builder) VPMOVM2W(reg VecReg, rm MaskReg) {
+	b.add("VPMOVM2W", "VPMOVM2W", reg, rm)
+}
+
+// VPMOVMSKB appends VPMOVMSKB to the program. See the function VPMOVMSKB.
+func (b *Builder) VPMOVMSKB(reg Reg, rm VecReg) {
+	b.add("VPMOVMSKB", "VPMOVMSKB", reg, rm)
+}
+
+// VPMOVQ2M appends VPMOVQ2M to the program. See the function VPMOVQ2M.
+func (b *Builder) VPMOVQ2M(reg MaskReg, rm VecReg) {
+	b.add("VPMOVQ2M", "VPMOVQ2M", reg, rm)
+}
+
+// VPMOVQB appends VPMOVQB to the program. See the function VPMOVQB.
+func (b *Builder) VPMOVQB(rm RegMem, reg VecReg) {
+	b.add("VPMOVQB", "VPMOVQB", rm, reg)
+}
+
+// VPMOVQD appends VPMOVQD to the program. See the function VPMOVQD.
+func (b *Builder) VPMOVQD(rm RegMem, reg VecReg) {
+	b.add("VPMOVQD", "VPMOVQD", rm, reg)
+}
+
+// VPMOVQW appends VPMOVQW to the program. See the function VPMOVQW.
+func (b *Builder) VPMOVQW(rm RegMem, reg VecReg) {
+	b.add("VPMOVQW", "VPMOVQW", rm, reg)
+}
+
+// VPMOVSDB appends VPMOVSDB to the program. See the function VPMOVSDB.
+func (b *Builder) VPMOVSDB(rm RegMem, reg VecReg) {
+	b.add("VPMOVSDB", "VPMOVSDB", rm, reg)
+}
+
+// VPMOVSDW appends VPMOVSDW to the program. See the function VPMOVSDW.
+func (b *Builder) VPMOVSDW(rm RegMem, reg VecReg) {
+	b.add("VPMOVSDW", "VPMOVSDW", rm, reg)
+}
+
+// VPMOVSQB appends VPMOVSQB to the program. See the function VPMOVSQB.
+func (b *Builder) VPMOVSQB(rm RegMem, reg VecReg) {
+	b.add("VPMOVSQB", "VPMOVSQB", rm, reg)
+}
+
+// VPMOVSQD appends VPMOVSQD to the program. See the function VPMOVSQD.
+func (b *Builder) VPMOVSQD(rm RegMem, reg VecReg) {
+	b.add("VPMOVSQD", "VPMOVSQD", rm, reg)
+}
+
+// VPMOVSQW appends VPMOVSQW to the program. See the function VPMOVSQW.
+func (b *Builder) VPMOVSQW(rm RegMem, reg VecReg) {
+	b.add("VPMOVSQW", "VPMOVSQW", rm, reg)
+}
+
+// VPMOVSWB appends VPMOVSWB to the program. See the function VPMOVSWB.
+func (b *Builder) VPMOVSWB(rm RegMem, reg VecReg) {
+	b.add("VPMOVSWB", "VPMOVSWB", rm, reg)
+}
+
+// VPMOVSXBD_QVM appends VPMOVSXBD to the program. See the function VPMOVSXBD_QVM.
+func (b *Builder) VPMOVSXBD_QVM(reg Register, rm RegMem) {
+	b.add("VPMOVSXBD", "VPMOVSXBD_QVM", reg, rm)
+}
+
+// VPMOVSXBD_RM appends VPMOVSXBD to the program. See the function VPMOVSXBD_RM.
+func (b *Builder) VPMOVSXBD_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVSXBD", "VPMOVSXBD_RM", reg, rm)
+}
+
+// VPMOVSXBQ_OVM appends VPMOVSXBQ to the program. See the function VPMOVSXBQ_OVM.
+func (b *Builder) VPMOVSXBQ_OVM(reg Register, rm RegMem) {
+	b.add("VPMOVSXBQ", "VPMOVSXBQ_OVM", reg, rm)
+}
+
+// VPMOVSXBQ_RM appends VPMOVSXBQ to the program. See the function VPMOVSXBQ_RM.
+func (b *Builder) VPMOVSXBQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVSXBQ", "VPMOVSXBQ_RM", reg, rm)
+}
+
+// VPMOVSXBW_HVM appends VPMOVSXBW to the program. See the function VPMOVSXBW_HVM.
+func (b *Builder) VPMOVSXBW_HVM(reg Register, rm RegMem) {
+	b.add("VPMOVSXBW", "VPMOVSXBW_HVM", reg, rm)
+}
+
+// VPMOVSXBW_RM appends VPMOVSXBW to the program. See the function VPMOVSXBW_RM.
+func (b *Builder) VPMOVSXBW_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVSXBW", "VPMOVSXBW_RM", reg, rm)
+}
+
+// VPMOVSXDQ_HVM appends VPMOVSXDQ to the program. See the function VPMOVSXDQ_HVM.
+func (b *Builder) VPMOVSXDQ_HVM(reg Register, rm RegMem) {
+	b.add("VPMOVSXDQ", "VPMOVSXDQ_HVM", reg, rm)
+}
+
+// VPMOVSXDQ_RM appends VPMOVSXDQ to the program. See the function VPMOVSXDQ_RM.
+func (b *Builder) VPMOVSXDQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVSXDQ", "VPMOVSXDQ_RM", reg, rm)
+}
+
+// VPMOVSXWD_HVM appends VPMOVSXWD to the program. See the function VPMOVSXWD_HVM.
+func (b *Builder) VPMOVSXWD_HVM(reg Register, rm RegMem) {
+	b.add("VPMOVSXWD", "VPMOVSXWD_HVM", reg, rm)
+}
+
+// VPMOVSXWD_RM appends VPMOVSXWD to the program. See the function VPMOVSXWD_RM.
+func (b *Builder) VPMOVSXWD_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVSXWD", "VPMOVSXWD_RM", reg, rm)
+}
+
+// VPMOVSXWQ_QVM appends VPMOVSXWQ to the program. See the function VPMOVSXWQ_QVM.
+func (b *Builder) VPMOVSXWQ_QVM(reg Register, rm RegMem) {
+	b.add("VPMOVSXWQ", "VPMOVSXWQ_QVM", reg, rm)
+}
+
+// VPMOVSXWQ_RM appends VPMOVSXWQ to the program. See the function VPMOVSXWQ_RM.
+func (b *Builder) VPMOVSXWQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVSXWQ", "VPMOVSXWQ_RM", reg, rm)
+}
+
+// VPMOVUSDB appends VPMOVUSDB to the program. See the function VPMOVUSDB.
+func (b *Builder) VPMOVUSDB(rm RegMem, reg VecReg) {
+	b.add("VPMOVUSDB", "VPMOVUSDB", rm, reg)
+}
+
+// VPMOVUSDW appends VPMOVUSDW to the program. See the function VPMOVUSDW.
+func (b *Builder) VPMOVUSDW(rm RegMem, reg VecReg) {
+	b.add("VPMOVUSDW", "VPMOVUSDW", rm, reg)
+}
+
+// VPMOVUSQB appends VPMOVUSQB to the program. See the function VPMOVUSQB.
+func (b *Builder) VPMOVUSQB(rm RegMem, reg VecReg) {
+	b.add("VPMOVUSQB", "VPMOVUSQB", rm, reg)
+}
+
+// VPMOVUSQD appends VPMOVUSQD to the program. See the function VPMOVUSQD.
+func (b *Builder) VPMOVUSQD(rm RegMem, reg VecReg) {
+	b.add("VPMOVUSQD", "VPMOVUSQD", rm, reg)
+}
+
+// VPMOVUSQW appends VPMOVUSQW to the program. See the function VPMOVUSQW.
+func (b *Builder) VPMOVUSQW(rm RegMem, reg VecReg) {
+	b.add("VPMOVUSQW", "VPMOVUSQW", rm, reg)
+}
+
+// VPMOVUSWB appends VPMOVUSWB to the program. See the function VPMOVUSWB.
+func (b *Builder) VPMOVUSWB(rm RegMem, reg VecReg) {
+	b.add("VPMOVUSWB", "VPMOVUSWB", rm, reg)
+}
+
+// VPMOVW2M appends VPMOVW2M to the program. See the function VPMOVW2M.
+func (b *Builder) VPMOVW2M(reg MaskReg, rm VecReg) {
+	b.add("VPMOVW2M", "VPMOVW2M", reg, rm)
+}
+
+// VPMOVWB appends VPMOVWB to the program. See the function VPMOVWB.
+func (b *Builder) VPMOVWB(rm RegMem, reg VecReg) {
+	b.add("VPMOVWB", "VPMOVWB", rm, reg)
+}
+
+// VPMOVZXBD_QVM appends VPMOVZXBD to the program. See the function VPMOVZXBD_QVM.
+func (b *Builder) VPMOVZXBD_QVM(reg Register, rm RegMem) {
+	b.add("VPMOVZXBD", "VPMOVZXBD_QVM", reg, rm)
+}
+
+// VPMOVZXBD_RM appends VPMOVZXBD to the program. See the function VPMOVZXBD_RM.
+func (b *Builder) VPMOVZXBD_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVZXBD", "VPMOVZXBD_RM", reg, rm)
+}
+
+// VPMOVZXBQ_OVM appends VPMOVZXBQ to the program. See the function VPMOVZXBQ_OVM.
+func (b *Builder) VPMOVZXBQ_OVM(reg Register, rm RegMem) {
+	b.add("VPMOVZXBQ", "VPMOVZXBQ_OVM", reg, rm)
+}
+
+// VPMOVZXBQ_RM appends VPMOVZXBQ to the program. See the function VPMOVZXBQ_RM.
+func (b *Builder) VPMOVZXBQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVZXBQ", "VPMOVZXBQ_RM", reg, rm)
+}
+
+// VPMOVZXBW_HVM appends VPMOVZXBW to the program. See the function VPMOVZXBW_HVM.
+func (b *Builder) VPMOVZXBW_HVM(reg Register, rm RegMem) {
+	b.add("VPMOVZXBW", "VPMOVZXBW_HVM", reg, rm)
+}
+
+// VPMOVZXBW_RM appends VPMOVZXBW to the program. See the function VPMOVZXBW_RM.
+func (b *Builder) VPMOVZXBW_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVZXBW", "VPMOVZXBW_RM", reg, rm)
+}
+
+// VPMOVZXDQ_HVM appends VPMOVZXDQ to the program. See the function VPMOVZXDQ_HVM.
+func (b *Builder) VPMOVZXDQ_HVM(reg Register, rm RegMem) {
+	b.add("VPMOVZXDQ", "VPMOVZXDQ_HVM", reg, rm)
+}
+
+// VPMOVZXDQ_RM appends VPMOVZXDQ to the program. See the function VPMOVZXDQ_RM.
+func (b *Builder) VPMOVZXDQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVZXDQ", "VPMOVZXDQ_RM", reg, rm)
+}
+
+// VPMOVZXWD_HVM appends VPMOVZXWD to the program. See the function VPMOVZXWD_HVM.
+func (b *Builder) VPMOVZXWD_HVM(reg Register, rm RegMem) {
+	b.add("VPMOVZXWD", "VPMOVZXWD_HVM", reg, rm)
+}
+
+// VPMOVZXWD_RM appends VPMOVZXWD to the program. See the function VPMOVZXWD_RM.
+func (b *Builder) VPMOVZXWD_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVZXWD", "VPMOVZXWD_RM", reg, rm)
+}
+
+// VPMOVZXWQ_QVM appends VPMOVZXWQ to the program. See the function VPMOVZXWQ_QVM.
+func (b *Builder) VPMOVZXWQ_QVM(reg Register, rm RegMem) {
+	b.add("VPMOVZXWQ", "VPMOVZXWQ_QVM", reg, rm)
+}
+
+// VPMOVZXWQ_RM appends VPMOVZXWQ to the program. See the function VPMOVZXWQ_RM.
+func (b *Builder) VPMOVZXWQ_RM(reg VecReg, rm RegMem) {
+	b.add("VPMOVZXWQ", "VPMOVZXWQ_RM", reg, rm)
+}
+
+// VPMULDQ_FV appends VPMULDQ to the program. See the function VPMULDQ_FV.
+func (b *Builder) VPMULDQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULDQ", "VPMULDQ_FV", reg, vvvv, rm)
+}
+
+// VPMULDQ_RVM appends VPMULDQ to the program. See the function VPMULDQ_RVM.
+func (b *Builder) VPMULDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMULDQ", "VPMULDQ_RVM", reg, vvvv, rm)
+}
+
+// VPMULHRSW_FVM appends VPMULHRSW to the program. See the function VPMULHRSW_FVM.
+func (b *Builder) VPMULHRSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULHRSW", "VPMULHRSW_FVM", reg, vvvv, rm)
+}
+
+// VPMULHRSW_RVM appends VPMULHRSW to the program. See the function VPMULHRSW_RVM.
+func (b *Builder) VPMULHRSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMULHRSW", "VPMULHRSW_RVM", reg, vvvv, rm)
+}
+
+// VPMULHUW_FVM appends VPMULHUW to the program. See the function VPMULHUW_FVM.
+func (b *Builder) VPMULHUW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULHUW", "VPMULHUW_FVM", reg, vvvv, rm)
+}
+
+// VPMULHUW_RVM appends VPMULHUW to the program. See the function VPMULHUW_RVM.
+func (b *Builder) VPMULHUW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMULHUW", "VPMULHUW_RVM", reg, vvvv, rm)
+}
+
+// VPMULHW_FVM appends VPMULHW to the program. See the function VPMULHW_FVM.
+func (b *Builder) VPMULHW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULHW", "VPMULHW_FVM", reg, vvvv, rm)
+}
+
+// VPMULHW_RVM appends VPMULHW to the program. See the function VPMULHW_RVM.
+func (b *Builder) VPMULHW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMULHW", "VPMULHW_RVM", reg, vvvv, rm)
+}
+
+// VPMULLD appends VPMULLD to the program. See the function VPMULLD.
+func (b *Builder) VPMULLD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULLD", "VPMULLD", reg, vvvv, rm)
+}
+
+// VPMULLQ appends VPMULLQ to the program. See the function VPMULLQ.
+func (b *Builder) VPMULLQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULLQ", "VPMULLQ", reg, vvvv, rm)
+}
+
+// VPMULLW_FVM appends VPMULLW to the program. See the function VPMULLW_FVM.
+func (b *Builder) VPMULLW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULLW", "VPMULLW_FVM", reg, vvvv, rm)
+}
+
+// VPMULLW_RVM appends VPMULLW to the program. See the function VPMULLW_RVM.
+func (b *Builder) VPMULLW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMULLW", "VPMULLW_RVM", reg, vvvv, rm)
+}
+
+// VPMULTISHIFTQB appends VPMULTISHIFTQB to the program. See the function VPMULTISHIFTQB.
+func (b *Builder) VPMULTISHIFTQB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULTISHIFTQB", "VPMULTISHIFTQB", reg, vvvv, rm)
+}
+
+// VPMULUDQ_FV appends VPMULUDQ to the program. See the function VPMULUDQ_FV.
+func (b *Builder) VPMULUDQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPMULUDQ", "VPMULUDQ_FV", reg, vvvv, rm)
+}
+
+// VPMULUDQ_RVM appends VPMULUDQ to the program. See the function VPMULUDQ_RVM.
+func (b *Builder) VPMULUDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPMULUDQ", "VPMULUDQ_RVM", reg, vvvv, rm)
+}
+
+// VPOPCNTB appends VPOPCNTB to the program. See the function VPOPCNTB.
+func (b *Builder) VPOPCNTB(reg Register, rm RegMem) {
+	b.add("VPOPCNTB", "VPOPCNTB", reg, rm)
+}
+
+// VPOPCNTD appends VPOPCNTD to the program. See the function VPOPCNTD.
+func (b *Builder) VPOPCNTD(reg Register, rm RegMem) {
+	b.add("VPOPCNTD", "VPOPCNTD", reg, rm)
+}
+
+// VPOPCNTQ appends VPOPCNTQ to the program. See the function VPOPCNTQ.
+func (b *Builder) VPOPCNTQ(reg Register, rm RegMem) {
+	b.add("VPOPCNTQ", "VPOPCNTQ", reg, rm)
+}
+
+// VPOPCNTW appends VPOPCNTW to the program. See the function VPOPCNTW.
+func (b *Builder) VPOPCNTW(reg Register, rm RegMem) {
+	b.add("VPOPCNTW", "VPOPCNTW", reg, rm)
+}
+
+// VPOR appends VPOR to the program. See the function VPOR.
+func (b *Builder) VPOR(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPOR", "VPOR", reg, vvvv, rm)
+}
+
+// VPORD appends VPORD to the program. See the function VPORD.
+func (b *Builder) VPORD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPORD", "VPORD", reg, vvvv, rm)
+}
+
+// VPORQ appends VPORQ to the program. See the function VPORQ.
+func (b *Builder) VPORQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPORQ", "VPORQ", reg, vvvv, rm)
+}
+
+// VPROLD appends VPROLD to the program. See the function VPROLD.
+func (b *Builder) VPROLD(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPROLD", "VPROLD", vvvv, rm, imm)
+}
+
+// VPROLQ appends VPROLQ to the program. See the function VPROLQ.
+func (b *Builder) VPROLQ(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPROLQ", "VPROLQ", vvvv, rm, imm)
+}
+
+// VPROLVD appends VPROLVD to the program. See the function VPROLVD.
+func (b *Builder) VPROLVD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPROLVD", "VPROLVD", reg, vvvv, rm)
+}
+
+// VPROLVQ appends VPROLVQ to the program. See the function VPROLVQ.
+func (b *Builder) VPROLVQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPROLVQ", "VPROLVQ", reg, vvvv, rm)
+}
+
+// VPRORD appends VPRORD to the program. See the function VPRORD.
+func (b *Builder) VPRORD(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPRORD", "VPRORD", vvvv, rm, imm)
+}
+
+// VPRORQ appends VPRORQ to the program. See the function VPRORQ.
+func (b *Builder) VPRORQ(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPRORQ", "VPRORQ", vvvv, rm, imm)
+}
+
+// VPRORVD appends VPRORVD to the program. See the function VPRORVD.
+func (b *Builder) VPRORVD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPRORVD", "VPRORVD", reg, vvvv, rm)
+}
+
+// VPRORVQ appends VPRORVQ to the program. See the function VPRORVQ.
+func (b *Builder) VPRORVQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPRORVQ", "VPRORVQ", reg, vvvv, rm)
+}
+
+// VPSADBW appends VPSADBW to the program. See the function VPSADBW.
+func (b *Builder) VPSADBW(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSADBW", "VPSADBW", reg, vvvv, rm)
+}
+
+// VPSCATTERDD appends VPSCATTERDD to the program. See the function VPSCATTERDD.
+func (b *Builder) VPSCATTERDD(vsib RegMem, reg VecReg) {
+	b.add("VPSCATTERDD", "VPSCATTERDD", vsib, reg)
+}
+
+// VPSCATTERDQ appends VPSCATTERDQ to the program. See the function VPSCATTERDQ.
+func (b *Builder) VPSCATTERDQ(vsib RegMem, reg VecReg) {
+	b.add("VPSCATTERDQ", "VPSCATTERDQ", vsib, reg)
+}
+
+// VPSCATTERQD appends VPSCATTERQD to the program. See the function VPSCATTERQD.
+func (b *Builder) VPSCATTERQD(vsib RegMem, reg VecReg) {
+	b.add("VPSCATTERQD", "VPSCATTERQD", vsib, reg)
+}
+
+// VPSCATTERQQ appends VPSCATTERQQ to the program. See the function VPSCATTERQQ.
+func (b *Builder) VPSCATTERQQ(vsib RegMem, reg VecReg) {
+	b.add("VPSCATTERQQ", "VPSCATTERQQ", vsib, reg)
+}
+
+// VPSHLDD appends VPSHLDD to the program. See the function VPSHLDD.
+func (b *Builder) VPSHLDD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHLDD", "VPSHLDD", reg, vvvv, rm, imm)
+}
+
+// VPSHLDQ appends VPSHLDQ to the program. See the function VPSHLDQ.
+func (b *Builder) VPSHLDQ(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHLDQ", "VPSHLDQ", reg, vvvv, rm, imm)
+}
+
+// VPSHLDVD appends VPSHLDVD to the program. See the function VPSHLDVD.
+func (b *Builder) VPSHLDVD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHLDVD", "VPSHLDVD", reg, vvvv, rm)
+}
+
+// VPSHLDVQ appends VPSHLDVQ to the program. See the function VPSHLDVQ.
+func (b *Builder) VPSHLDVQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHLDVQ", "VPSHLDVQ", reg, vvvv, rm)
+}
+
+// VPSHLDVW appends VPSHLDVW to the program. See the function VPSHLDVW.
+func (b *Builder) VPSHLDVW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHLDVW", "VPSHLDVW", reg, vvvv, rm)
+}
+
+// VPSHLDW appends VPSHLDW to the program. See the function VPSHLDW.
+func (b *Builder) VPSHLDW(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHLDW", "VPSHLDW", reg, vvvv, rm, imm)
+}
+
+// VPSHRDD appends VPSHRDD to the program. See the function VPSHRDD.
+func (b *Builder) VPSHRDD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHRDD", "VPSHRDD", reg, vvvv, rm, imm)
+}
+
+// VPSHRDQ appends VPSHRDQ to the program. See the function VPSHRDQ.
+func (b *Builder) VPSHRDQ(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHRDQ", "VPSHRDQ", reg, vvvv, rm, imm)
+}
+
+// VPSHRDVD appends VPSHRDVD to the program. See the function VPSHRDVD.
+func (b *Builder) VPSHRDVD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHRDVD", "VPSHRDVD", reg, vvvv, rm)
+}
+
+// VPSHRDVQ appends VPSHRDVQ to the program. See the function VPSHRDVQ.
+func (b *Builder) VPSHRDVQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHRDVQ", "VPSHRDVQ", reg, vvvv, rm)
+}
+
+// VPSHRDVW appends VPSHRDVW to the program. See the function VPSHRDVW.
+func (b *Builder) VPSHRDVW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHRDVW", "VPSHRDVW", reg, vvvv, rm)
+}
+
+// VPSHRDW appends VPSHRDW to the program. See the function VPSHRDW.
+func (b *Builder) VPSHRDW(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHRDW", "VPSHRDW", reg, vvvv, rm, imm)
+}
+
+// VPSHUFBITQMB appends VPSHUFBITQMB to the program. See the function VPSHUFBITQMB.
+func (b *Builder) VPSHUFBITQMB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHUFBITQMB", "VPSHUFBITQMB", reg, vvvv, rm)
+}
+
+// VPSHUFB_FVM appends VPSHUFB to the program. See the function VPSHUFB_FVM.
+func (b *Builder) VPSHUFB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSHUFB", "VPSHUFB_FVM", reg, vvvv, rm)
+}
+
+// VPSHUFB_RVM appends VPSHUFB to the program. See the function VPSHUFB_RVM.
+func (b *Builder) VPSHUFB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSHUFB", "VPSHUFB_RVM", reg, vvvv, rm)
+}
+
+// VPSHUFD_FV appends VPSHUFD to the program. See the function VPSHUFD_FV.
+func (b *Builder) VPSHUFD_FV(reg Register, rm RegMem, imm Imm) {
+	b.add("VPSHUFD", "VPSHUFD_FV", reg, rm, imm)
+}
+
+// VPSHUFD_RMI appends VPSHUFD to the program. See the function VPSHUFD_RMI.
+func (b *Builder) VPSHUFD_RMI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHUFD", "VPSHUFD_RMI", reg, rm, imm)
+}
+
+// VPSHUFHW_FVM appends VPSHUFHW to the program. See the function VPSHUFHW_FVM.
+func (b *Builder) VPSHUFHW_FVM(reg Register, rm RegMem, imm Imm) {
+	b.add("VPSHUFHW", "VPSHUFHW_FVM", reg, rm, imm)
+}
+
+// VPSHUFHW_RMI appends VPSHUFHW to the program. See the function VPSHUFHW_RMI.
+func (b *Builder) VPSHUFHW_RMI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHUFHW", "VPSHUFHW_RMI", reg, rm, imm)
+}
+
+// VPSHUFLW_FVM appends VPSHUFLW to the program. See the function VPSHUFLW_FVM.
+func (b *Builder) VPSHUFLW_FVM(reg Register, rm RegMem, imm Imm) {
+	b.add("VPSHUFLW", "VPSHUFLW_FVM", reg, rm, imm)
+}
+
+// VPSHUFLW_RMI appends VPSHUFLW to the program. See the function VPSHUFLW_RMI.
+func (b *Builder) VPSHUFLW_RMI(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VPSHUFLW", "VPSHUFLW_RMI", reg, rm, imm)
+}
+
+// VPSIGNB appends VPSIGNB to the program. See the function VPSIGNB.
+func (b *Builder) VPSIGNB(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSIGNB", "VPSIGNB", reg, vvvv, rm)
+}
+
+// VPSIGND appends VPSIGND to the program. See the function VPSIGND.
+func (b *Builder) VPSIGND(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSIGND", "VPSIGND", reg, vvvv, rm)
+}
+
+// VPSIGNW appends VPSIGNW to the program. See the function VPSIGNW.
+func (b *Builder) VPSIGNW(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSIGNW", "VPSIGNW", reg, vvvv, rm)
+}
+
+// VPSLLDQ_FVMI appends VPSLLDQ to the program. See the function VPSLLDQ_FVMI.
+func (b *Builder) VPSLLDQ_FVMI(vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSLLDQ", "VPSLLDQ_FVMI", vvvv, rm, imm)
+}
+
+// VPSLLDQ_VMI appends VPSLLDQ to the program. See the function VPSLLDQ_VMI.
+func (b *Builder) VPSLLDQ_VMI(vvvv, rm VecReg, imm Imm) {
+	b.add("VPSLLDQ", "VPSLLDQ_VMI", vvvv, rm, imm)
+}
+
+// VPSLLD_RVM appends VPSLLD to the program. See the function VPSLLD_RVM.
+func (b *Builder) VPSLLD_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLD", "VPSLLD_RVM", reg, vvvv, rm)
+}
+
+// VPSLLD_VMI appends VPSLLD to the program. See the function VPSLLD_VMI.
+func (b *Builder) VPSLLD_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSLLD", "VPSLLD_VMI", vvvv, rm, imm)
+}
+
+// VPSLLQ_RVM appends VPSLLQ to the program. See the function VPSLLQ_RVM.
+func (b *Builder) VPSLLQ_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLQ", "VPSLLQ_RVM", reg, vvvv, rm)
+}
+
+// VPSLLQ_VMI appends VPSLLQ to the program. See the function VPSLLQ_VMI.
+func (b *Builder) VPSLLQ_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSLLQ", "VPSLLQ_VMI", vvvv, rm, imm)
+}
+
+// VPSLLVD_FV appends VPSLLVD to the program. See the function VPSLLVD_FV.
+func (b *Builder) VPSLLVD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLVD", "VPSLLVD_FV", reg, vvvv, rm)
+}
+
+// VPSLLVD_RVM appends VPSLLVD to the program. See the function VPSLLVD_RVM.
+func (b *Builder) VPSLLVD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLVD", "VPSLLVD_RVM", reg, vvvv, rm)
+}
+
+// VPSLLVQ_FV appends VPSLLVQ to the program. See the function VPSLLVQ_FV.
+func (b *Builder) VPSLLVQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLVQ", "VPSLLVQ_FV", reg, vvvv, rm)
+}
+
+// VPSLLVQ_RVM appends VPSLLVQ to the program. See the function VPSLLVQ_RVM.
+func (b *Builder) VPSLLVQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLVQ", "VPSLLVQ_RVM", reg, vvvv, rm)
+}
+
+// VPSLLVW appends VPSLLVW to the program. See the function VPSLLVW.
+func (b *Builder) VPSLLVW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLVW", "VPSLLVW", reg, vvvv, rm)
+}
+
+// VPSLLW_RVM appends VPSLLW to the program. See the function VPSLLW_RVM.
+func (b *Builder) VPSLLW_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSLLW", "VPSLLW_RVM", reg, vvvv, rm)
+}
+
+// VPSLLW_VMI appends VPSLLW to the program. See the function VPSLLW_VMI.
+func (b *Builder) VPSLLW_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSLLW", "VPSLLW_VMI", vvvv, rm, imm)
+}
+
+// VPSRAD_RVM appends VPSRAD to the program. See the function VPSRAD_RVM.
+func (b *Builder) VPSRAD_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAD", "VPSRAD_RVM", reg, vvvv, rm)
+}
+
+// VPSRAD_VMI appends VPSRAD to the program. See the function VPSRAD_VMI.
+func (b *Builder) VPSRAD_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSRAD", "VPSRAD_VMI", vvvv, rm, imm)
+}
+
+// VPSRAQ_RVM appends VPSRAQ to the program. See the function VPSRAQ_RVM.
+func (b *Builder) VPSRAQ_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAQ", "VPSRAQ_RVM", reg, vvvv, rm)
+}
+
+// VPSRAQ_VMI appends VPSRAQ to the program. See the function VPSRAQ_VMI.
+func (b *Builder) VPSRAQ_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSRAQ", "VPSRAQ_VMI", vvvv, rm, imm)
+}
+
+// VPSRAVD_FV appends VPSRAVD to the program. See the function VPSRAVD_FV.
+func (b *Builder) VPSRAVD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAVD", "VPSRAVD_FV", reg, vvvv, rm)
+}
+
+// VPSRAVD_RVM appends VPSRAVD to the program. See the function VPSRAVD_RVM.
+func (b *Builder) VPSRAVD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAVD", "VPSRAVD_RVM", reg, vvvv, rm)
+}
+
+// VPSRAVQ appends VPSRAVQ to the program. See the function VPSRAVQ.
+func (b *Builder) VPSRAVQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAVQ", "VPSRAVQ", reg, vvvv, rm)
+}
+
+// VPSRAVW appends VPSRAVW to the program. See the function VPSRAVW.
+func (b *Builder) VPSRAVW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAVW", "VPSRAVW", reg, vvvv, rm)
+}
+
+// VPSRAW_M128 appends VPSRAW to the program. See the function VPSRAW_M128.
+func (b *Builder) VPSRAW_M128(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAW", "VPSRAW_M128", reg, vvvv, rm)
+}
+
+// VPSRAW_RVM appends VPSRAW to the program. See the function VPSRAW_RVM.
+func (b *Builder) VPSRAW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSRAW", "VPSRAW_RVM", reg, vvvv, rm)
+}
+
+// VPSRAW_VMI appends VPSRAW to the program. See the function VPSRAW_VMI.
+func (b *Builder) VPSRAW_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSRAW", "VPSRAW_VMI", vvvv, rm, imm)
+}
+
+// VPSRLDQ_FVM appends VPSRLDQ to the program. See the function VPSRLDQ_FVM.
+func (b *Builder) VPSRLDQ_FVM(vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPSRLDQ", "VPSRLDQ_FVM", vvvv, rm, imm)
+}
+
+// VPSRLDQ_VMI appends VPSRLDQ to the program. See the function VPSRLDQ_VMI.
+func (b *Builder) VPSRLDQ_VMI(vvvv, rm VecReg, imm Imm) {
+	b.add("VPSRLDQ", "VPSRLDQ_VMI", vvvv, rm, imm)
+}
+
+// VPSRLD_RVM appends VPSRLD to the program. See the function VPSRLD_RVM.
+func (b *Builder) VPSRLD_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRLD", "VPSRLD_RVM", reg, vvvv, rm)
+}
+
+// VPSRLD_VMI appends VPSRLD to the program. See the function VPSRLD_VMI.
+func (b *Builder) VPSRLD_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSRLD", "VPSRLD_VMI", vvvv, rm, imm)
+}
+
+// VPSRLQ_RVM appends VPSRLQ to the program. See the function VPSRLQ_RVM.
+func (b *Builder) VPSRLQ_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRLQ", "VPSRLQ_RVM", reg, vvvv, rm)
+}
+
+// VPSRLQ_VMI appends VPSRLQ to the program. See the function VPSRLQ_VMI.
+func (b *Builder) VPSRLQ_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSRLQ", "VPSRLQ_VMI", vvvv, rm, imm)
+}
+
+// VPSRLVD appends VPSRLVD to the program. See the function VPSRLVD.
+func (b *Builder) VPSRLVD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRLVD", "VPSRLVD", reg, vvvv, rm)
+}
+
+// VPSRLVQ appends VPSRLVQ to the program. See the function VPSRLVQ.
+func (b *Builder) VPSRLVQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRLVQ", "VPSRLVQ", reg, vvvv, rm)
+}
+
+// VPSRLVW appends VPSRLVW to the program. See the function VPSRLVW.
+func (b *Builder) VPSRLVW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRLVW", "VPSRLVW", reg, vvvv, rm)
+}
+
+// VPSRLW_RVM appends VPSRLW to the program. See the function VPSRLW_RVM.
+func (b *Builder) VPSRLW_RVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSRLW", "VPSRLW_RVM", reg, vvvv, rm)
+}
+
+// VPSRLW_VMI appends VPSRLW to the program. See the function VPSRLW_VMI.
+func (b *Builder) VPSRLW_VMI(vvvv Register, rm RegMem, imm Imm) {
+	b.add("VPSRLW", "VPSRLW_VMI", vvvv, rm, imm)
+}
+
+// VPSUBB_FVM appends VPSUBB to the program. See the function VPSUBB_FVM.
+func (b *Builder) VPSUBB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBB", "VPSUBB_FVM", reg, vvvv, rm)
+}
+
+// VPSUBB_RVM appends VPSUBB to the program. See the function VPSUBB_RVM.
+func (b *Builder) VPSUBB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBB", "VPSUBB_RVM", reg, vvvv, rm)
+}
+
+// VPSUBD appends VPSUBD to the program. See the function VPSUBD.
+func (b *Builder) VPSUBD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBD", "VPSUBD", reg, vvvv, rm)
+}
+
+// VPSUBQ_FV appends VPSUBQ to the program. See the function VPSUBQ_FV.
+func (b *Builder) VPSUBQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBQ", "VPSUBQ_FV", reg, vvvv, rm)
+}
+
+// VPSUBQ_RVM appends VPSUBQ to the program. See the function VPSUBQ_RVM.
+func (b *Builder) VPSUBQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBQ", "VPSUBQ_RVM", reg, vvvv, rm)
+}
+
+// VPSUBSB_FVM appends VPSUBSB to the program. See the function VPSUBSB_FVM.
+func (b *Builder) VPSUBSB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBSB", "VPSUBSB_FVM", reg, vvvv, rm)
+}
+
+// VPSUBSB_RVM appends VPSUBSB to the program. See the function VPSUBSB_RVM.
+func (b *Builder) VPSUBSB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBSB", "VPSUBSB_RVM", reg, vvvv, rm)
+}
+
+// VPSUBSW_FVM appends VPSUBSW to the program. See the function VPSUBSW_FVM.
+func (b *Builder) VPSUBSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBSW", "VPSUBSW_FVM", reg, vvvv, rm)
+}
+
+// VPSUBSW_RVM appends VPSUBSW to the program. See the function VPSUBSW_RVM.
+func (b *Builder) VPSUBSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBSW", "VPSUBSW_RVM", reg, vvvv, rm)
+}
+
+// VPSUBUSB_FVM appends VPSUBUSB to the program. See the function VPSUBUSB_FVM.
+func (b *Builder) VPSUBUSB_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBUSB", "VPSUBUSB_FVM", reg, vvvv, rm)
+}
+
+// VPSUBUSB_RVM appends VPSUBUSB to the program. See the function VPSUBUSB_RVM.
+func (b *Builder) VPSUBUSB_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBUSB", "VPSUBUSB_RVM", reg, vvvv, rm)
+}
+
+// VPSUBUSW_FVM appends VPSUBUSW to the program. See the function VPSUBUSW_FVM.
+func (b *Builder) VPSUBUSW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBUSW", "VPSUBUSW_FVM", reg, vvvv, rm)
+}
+
+// VPSUBUSW_RVM appends VPSUBUSW to the program. See the function VPSUBUSW_RVM.
+func (b *Builder) VPSUBUSW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBUSW", "VPSUBUSW_RVM", reg, vvvv, rm)
+}
+
+// VPSUBW_FVM appends VPSUBW to the program. See the function VPSUBW_FVM.
+func (b *Builder) VPSUBW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBW", "VPSUBW_FVM", reg, vvvv, rm)
+}
+
+// VPSUBW_RVM appends VPSUBW to the program. See the function VPSUBW_RVM.
+func (b *Builder) VPSUBW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPSUBW", "VPSUBW_RVM", reg, vvvv, rm)
+}
+
+// VPTERNLOGD appends VPTERNLOGD to the program. See the function VPTERNLOGD.
+func (b *Builder) VPTERNLOGD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPTERNLOGD", "VPTERNLOGD", reg, vvvv, rm, imm)
+}
+
+// VPTERNLOGQ appends VPTERNLOGQ to the program. See the function VPTERNLOGQ.
+func (b *Builder) VPTERNLOGQ(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VPTERNLOGQ", "VPTERNLOGQ", reg, vvvv, rm, imm)
+}
+
+// VPTEST appends VPTEST to the program. See the function VPTEST.
+func (b *Builder) VPTEST(reg VecReg, rm RegMem) {
+	b.add("VPTEST", "VPTEST", reg, rm)
+}
+
+// VPTESTMB appends VPTESTMB to the program. See the function VPTESTMB.
+func (b *Builder) VPTESTMB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTMB", "VPTESTMB", reg, vvvv, rm)
+}
+
+// VPTESTMD appends VPTESTMD to the program. See the function VPTESTMD.
+func (b *Builder) VPTESTMD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTMD", "VPTESTMD", reg, vvvv, rm)
+}
+
+// VPTESTMQ appends VPTESTMQ to the program. See the function VPTESTMQ.
+func (b *Builder) VPTESTMQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTMQ", "VPTESTMQ", reg, vvvv, rm)
+}
+
+// VPTESTMW appends VPTESTMW to the program. See the function VPTESTMW.
+func (b *Builder) VPTESTMW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTMW", "VPTESTMW", reg, vvvv, rm)
+}
+
+// VPTESTNMB appends VPTESTNMB to the program. See the function VPTESTNMB.
+func (b *Builder) VPTESTNMB(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTNMB", "VPTESTNMB", reg, vvvv, rm)
+}
+
+// VPTESTNMD appends VPTESTNMD to the program. See the function VPTESTNMD.
+func (b *Builder) VPTESTNMD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTNMD", "VPTESTNMD", reg, vvvv, rm)
+}
+
+// VPTESTNMQ appends VPTESTNMQ to the program. See the function VPTESTNMQ.
+func (b *Builder) VPTESTNMQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTNMQ", "VPTESTNMQ", reg, vvvv, rm)
+}
+
+// VPTESTNMW appends VPTESTNMW to the program. See the function VPTESTNMW.
+func (b *Builder) VPTESTNMW(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPTESTNMW", "VPTESTNMW", reg, vvvv, rm)
+}
+
+// VPUNPCKHBW_FVM appends VPUNPCKHBW to the program. See the function VPUNPCKHBW_FVM.
+func (b *Builder) VPUNPCKHBW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHBW", "VPUNPCKHBW_FVM", reg, vvvv, rm)
+}
+
+// VPUNPCKHBW_RVM appends VPUNPCKHBW to the program. See the function VPUNPCKHBW_RVM.
+func (b *Builder) VPUNPCKHBW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHBW", "VPUNPCKHBW_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKHDQ_FV appends VPUNPCKHDQ to the program. See the function VPUNPCKHDQ_FV.
+func (b *Builder) VPUNPCKHDQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHDQ", "VPUNPCKHDQ_FV", reg, vvvv, rm)
+}
+
+// VPUNPCKHDQ_RVM appends VPUNPCKHDQ to the program. See the function VPUNPCKHDQ_RVM.
+func (b *Builder) VPUNPCKHDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHDQ", "VPUNPCKHDQ_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKHQDQ_FV appends VPUNPCKHQDQ to the program. See the function VPUNPCKHQDQ_FV.
+func (b *Builder) VPUNPCKHQDQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHQDQ", "VPUNPCKHQDQ_FV", reg, vvvv, rm)
+}
+
+// VPUNPCKHQDQ_RVM appends VPUNPCKHQDQ to the program. See the function VPUNPCKHQDQ_RVM.
+func (b *Builder) VPUNPCKHQDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHQDQ", "VPUNPCKHQDQ_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKHWD_FVM appends VPUNPCKHWD to the program. See the function VPUNPCKHWD_FVM.
+func (b *Builder) VPUNPCKHWD_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHWD", "VPUNPCKHWD_FVM", reg, vvvv, rm)
+}
+
+// VPUNPCKHWD_RVM appends VPUNPCKHWD to the program. See the function VPUNPCKHWD_RVM.
+func (b *Builder) VPUNPCKHWD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKHWD", "VPUNPCKHWD_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKLBW_FVM appends VPUNPCKLBW to the program. See the function VPUNPCKLBW_FVM.
+func (b *Builder) VPUNPCKLBW_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLBW", "VPUNPCKLBW_FVM", reg, vvvv, rm)
+}
+
+// VPUNPCKLBW_RVM appends VPUNPCKLBW to the program. See the function VPUNPCKLBW_RVM.
+func (b *Builder) VPUNPCKLBW_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLBW", "VPUNPCKLBW_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKLDQ_FV appends VPUNPCKLDQ to the program. See the function VPUNPCKLDQ_FV.
+func (b *Builder) VPUNPCKLDQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLDQ", "VPUNPCKLDQ_FV", reg, vvvv, rm)
+}
+
+// VPUNPCKLDQ_RVM appends VPUNPCKLDQ to the program. See the function VPUNPCKLDQ_RVM.
+func (b *Builder) VPUNPCKLDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLDQ", "VPUNPCKLDQ_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKLQDQ_FV appends VPUNPCKLQDQ to the program. See the function VPUNPCKLQDQ_FV.
+func (b *Builder) VPUNPCKLQDQ_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLQDQ", "VPUNPCKLQDQ_FV", reg, vvvv, rm)
+}
+
+// VPUNPCKLQDQ_RVM appends VPUNPCKLQDQ to the program. See the function VPUNPCKLQDQ_RVM.
+func (b *Builder) VPUNPCKLQDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLQDQ", "VPUNPCKLQDQ_RVM", reg, vvvv, rm)
+}
+
+// VPUNPCKLWD_FVM appends VPUNPCKLWD to the program. See the function VPUNPCKLWD_FVM.
+func (b *Builder) VPUNPCKLWD_FVM(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLWD", "VPUNPCKLWD_FVM", reg, vvvv, rm)
+}
+
+// VPUNPCKLWD_RVM appends VPUNPCKLWD to the program. See the function VPUNPCKLWD_RVM.
+func (b *Builder) VPUNPCKLWD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPUNPCKLWD", "VPUNPCKLWD_RVM", reg, vvvv, rm)
+}
+
+// VPXOR appends VPXOR to the program. See the function VPXOR.
+func (b *Builder) VPXOR(reg, vvvv VecReg, rm RegMem) {
+	b.add("VPXOR", "VPXOR", reg, vvvv, rm)
+}
+
+// VPXORD appends VPXORD to the program. See the function VPXORD.
+func (b *Builder) VPXORD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPXORD", "VPXORD", reg, vvvv, rm)
+}
+
+// VPXORQ appends VPXORQ to the program. See the function VPXORQ.
+func (b *Builder) VPXORQ(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VPXORQ", "VPXORQ", reg, vvvv, rm)
+}
+
+// VRANGEPD appends VRANGEPD to the program. See the function VRANGEPD.
+func (b *Builder) VRANGEPD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VRANGEPD", "VRANGEPD", reg, vvvv, rm, imm)
+}
+
+// VRANGEPS appends VRANGEPS to the program. See the function VRANGEPS.
+func (b *Builder) VRANGEPS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VRANGEPS", "VRANGEPS", reg, vvvv, rm, imm)
+}
+
+// VRANGESD appends VRANGESD to the program. See the function VRANGESD.
+func (b *Builder) VRANGESD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VRANGESD", "VRANGESD", reg, vvvv, rm, imm)
+}
+
+// VRANGESS appends VRANGESS to the program. See the function VRANGESS.
+func (b *Builder) VRANGESS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VRANGESS", "VRANGESS", reg, vvvv, rm, imm)
+}
+
+// VRCP14PD appends VRCP14PD to the program. See the function VRCP14PD.
+func (b *Builder) VRCP14PD(reg Register, rm RegMem) {
+	b.add("VRCP14PD", "VRCP14PD", reg, rm)
+}
+
+// VRCP14PS appends VRCP14PS to the program. See the function VRCP14PS.
+func (b *Builder) VRCP14PS(reg Register, rm RegMem) {
+	b.add("VRCP14PS", "VRCP14PS", reg, rm)
+}
+
+// VRCP14SD appends VRCP14SD to the program. See the function VRCP14SD.
+func (b *Builder) VRCP14SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRCP14SD", "VRCP14SD", reg, vvvv, rm)
+}
+
+// VRCP14SS appends VRCP14SS to the program. See the function VRCP14SS.
+func (b *Builder) VRCP14SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRCP14SS", "VRCP14SS", reg, vvvv, rm)
+}
+
+// VRCP28PD appends VRCP28PD to the program. See the function VRCP28PD.
+func (b *Builder) VRCP28PD(reg Register, rm RegMem) {
+	b.add("VRCP28PD", "VRCP28PD", reg, rm)
+}
+
+// VRCP28PS appends VRCP28PS to the program. See the function VRCP28PS.
+func (b *Builder) VRCP28PS(reg Register, rm RegMem) {
+	b.add("VRCP28PS", "VRCP28PS", reg, rm)
+}
+
+// VRCP28SD appends VRCP28SD to the program. See the function VRCP28SD.
+func (b *Builder) VRCP28SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRCP28SD", "VRCP28SD", reg, vvvv, rm)
+}
+
+// VRCP28SS appends VRCP28SS to the program. See the function VRCP28SS.
+func (b *Builder) VRCP28SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRCP28SS", "VRCP28SS", reg, vvvv, rm)
+}
+
+// VRCPPS appends VRCPPS to the program. See the function VRCPPS.
+func (b *Builder) VRCPPS(reg VecReg, rm RegMem) {
+	b.add("VRCPPS", "VRCPPS", reg, rm)
+}
+
+// VRCPSS appends VRCPSS to the program. See the function VRCPSS.
+func (b *Builder) VRCPSS(reg, vvvv VecReg, rm RegMem) {
+	b.add("VRCPSS", "VRCPSS", reg, vvvv, rm)
+}
+
+// VREDUCEPD appends VREDUCEPD to the program. See the function VREDUCEPD.
+func (b *Builder) VREDUCEPD(reg Register, rm RegMem, imm Imm) {
+	b.add("VREDUCEPD", "VREDUCEPD", reg, rm, imm)
+}
+
+// VREDUCEPS appends VREDUCEPS to the program. See the function VREDUCEPS.
+func (b *Builder) VREDUCEPS(reg Register, rm RegMem, imm Imm) {
+	b.add("VREDUCEPS", "VREDUCEPS", reg, rm, imm)
+}
+
+// VREDUCESD appends VREDUCESD to the program. See the function VREDUCESD.
+func (b *Builder) VREDUCESD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VREDUCESD", "VREDUCESD", reg, vvvv, rm, imm)
+}
+
+// VREDUCESS appends VREDUCESS to the program. See the function VREDUCESS.
+func (b *Builder) VREDUCESS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VREDUCESS", "VREDUCESS", reg, vvvv, rm, imm)
+}
+
+// VRNDSCALEPD appends VRNDSCALEPD to the program. See the function VRNDSCALEPD.
+func (b *Builder) VRNDSCALEPD(reg Register, rm RegMem, imm Imm) {
+	b.add("VRNDSCALEPD", "VRNDSCALEPD", reg, rm, imm)
+}
+
+// VRNDSCALEPS appends VRNDSCALEPS to the program. See the function VRNDSCALEPS.
+func (b *Builder) VRNDSCALEPS(reg Register, rm RegMem, imm Imm) {
+	b.add("VRNDSCALEPS", "VRNDSCALEPS", reg, rm, imm)
+}
+
+// VRNDSCALESD appends VRNDSCALESD to the program. See the function VRNDSCALESD.
+func (b *Builder) VRNDSCALESD(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VRNDSCALESD", "VRNDSCALESD", reg, vvvv, rm, imm)
+}
+
+// VRNDSCALESS appends VRNDSCALESS to the program. See the function VRNDSCALESS.
+func (b *Builder) VRNDSCALESS(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VRNDSCALESS", "VRNDSCALESS", reg, vvvv, rm, imm)
+}
+
+// VROUNDPD appends VROUNDPD to the program. See the function VROUNDPD.
+func (b *Builder) VROUNDPD(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VROUNDPD", "VROUNDPD", reg, rm, imm)
+}
+
+// VROUNDPS appends VROUNDPS to the program. See the function VROUNDPS.
+func (b *Builder) VROUNDPS(reg VecReg, rm RegMem, imm Imm) {
+	b.add("VROUNDPS", "VROUNDPS", reg, rm, imm)
+}
+
+// VROUNDSD appends VROUNDSD to the program. See the function VROUNDSD.
+func (b *Builder) VROUNDSD(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VROUNDSD", "VROUNDSD", reg, vvvv, rm, imm)
+}
+
+// VROUNDSS appends VROUNDSS to the program. See the function VROUNDSS.
+func (b *Builder) VROUNDSS(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VROUNDSS", "VROUNDSS", reg, vvvv, rm, imm)
+}
+
+// VRSQRT14PD appends VRSQRT14PD to the program. See the function VRSQRT14PD.
+func (b *Builder) VRSQRT14PD(reg Register, rm RegMem) {
+	b.add("VRSQRT14PD", "VRSQRT14PD", reg, rm)
+}
+
+// VRSQRT14PS appends VRSQRT14PS to the program. See the function VRSQRT14PS.
+func (b *Builder) VRSQRT14PS(reg Register, rm RegMem) {
+	b.add("VRSQRT14PS", "VRSQRT14PS", reg, rm)
+}
+
+// VRSQRT14SD appends VRSQRT14SD to the program. See the function VRSQRT14SD.
+func (b *Builder) VRSQRT14SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRSQRT14SD", "VRSQRT14SD", reg, vvvv, rm)
+}
+
+// VRSQRT14SS appends VRSQRT14SS to the program. See the function VRSQRT14SS.
+func (b *Builder) VRSQRT14SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRSQRT14SS", "VRSQRT14SS", reg, vvvv, rm)
+}
+
+// VRSQRT28PD appends VRSQRT28PD to the program. See the function VRSQRT28PD.
+func (b *Builder) VRSQRT28PD(reg Register, rm RegMem) {
+	b.add("VRSQRT28PD", "VRSQRT28PD", reg, rm)
+}
+
+// VRSQRT28PS appends VRSQRT28PS to the program. See the function VRSQRT28PS.
+func (b *Builder) VRSQRT28PS(reg Register, rm RegMem) {
+	b.add("VRSQRT28PS", "VRSQRT28PS", reg, rm)
+}
+
+// VRSQRT28SD appends VRSQRT28SD to the program. See the function VRSQRT28SD.
+func (b *Builder) VRSQRT28SD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRSQRT28SD", "VRSQRT28SD", reg, vvvv, rm)
+}
+
+// VRSQRT28SS appends VRSQRT28SS to the program. See the function VRSQRT28SS.
+func (b *Builder) VRSQRT28SS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VRSQRT28SS", "VRSQRT28SS", reg, vvvv, rm)
+}
+
+// VRSQRTPS appends VRSQRTPS to the program. See the function VRSQRTPS.
+func (b *Builder) VRSQRTPS(reg VecReg, rm RegMem) {
+	b.add("VRSQRTPS", "VRSQRTPS", reg, rm)
+}
+
+// VRSQRTSS appends VRSQRTSS to the program. See the function VRSQRTSS.
+func (b *Builder) VRSQRTSS(reg, vvvv VecReg, rm RegMem) {
+	b.add("VRSQRTSS", "VRSQRTSS", reg, vvvv, rm)
+}
+
+// VSCALEFPD appends VSCALEFPD to the program. See the function VSCALEFPD.
+func (b *Builder) VSCALEFPD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSCALEFPD", "VSCALEFPD", reg, vvvv, rm)
+}
+
+// VSCALEFPS appends VSCALEFPS to the program. See the function VSCALEFPS.
+func (b *Builder) VSCALEFPS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSCALEFPS", "VSCALEFPS", reg, vvvv, rm)
+}
+
+// VSCALEFSD appends VSCALEFSD to the program. See the function VSCALEFSD.
+func (b *Builder) VSCALEFSD(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSCALEFSD", "VSCALEFSD", reg, vvvv, rm)
+}
+
+// VSCALEFSS appends VSCALEFSS to the program. See the function VSCALEFSS.
+func (b *Builder) VSCALEFSS(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSCALEFSS", "VSCALEFSS", reg, vvvv, rm)
+}
+
+// VSCATTERDPD appends VSCATTERDPD to the program. See the function VSCATTERDPD.
+func (b *Builder) VSCATTERDPD(vsib RegMem, reg VecReg) {
+	b.add("VSCATTERDPD", "VSCATTERDPD", vsib, reg)
+}
+
+// VSCATTERDPS appends VSCATTERDPS to the program. See the function VSCATTERDPS.
+func (b *Builder) VSCATTERDPS(vsib RegMem, reg VecReg) {
+	b.add("VSCATTERDPS", "VSCATTERDPS", vsib, reg)
+}
+
+// VSCATTERPF0DPD appends VSCATTERPF0DPD to the program. See the function VSCATTERPF0DPD.
+func (b *Builder) VSCATTERPF0DPD(vsib RegMem) {
+	b.add("VSCATTERPF0DPD", "VSCATTERPF0DPD", vsib)
+}
+
+// VSCATTERPF0DPS appends VSCATTERPF0DPS to the program. See the function VSCATTERPF0DPS.
+func (b *Builder) VSCATTERPF0DPS(vsib RegMem) {
+	b.add("VSCATTERPF0DPS", "VSCATTERPF0DPS", vsib)
+}
+
+// VSCATTERPF0QPD appends VSCATTERPF0QPD to the program. See the function VSCATTERPF0QPD.
+func (b *Builder) VSCATTERPF0QPD(vsib RegMem) {
+	b.add("VSCATTERPF0QPD", "VSCATTERPF0QPD", vsib)
+}
+
+// VSCATTERPF0QPS appends VSCATTERPF0QPS to the program. See the function VSCATTERPF0QPS.
+func (b *Builder) VSCATTERPF0QPS(vsib RegMem) {
+	b.add("VSCATTERPF0QPS", "VSCATTERPF0QPS", vsib)
+}
+
+// VSCATTERPF1DPD appends VSCATTERPF1DPD to the program. See the function VSCATTERPF1DPD.
+func (b *Builder) VSCATTERPF1DPD(vsib RegMem) {
+	b.add("VSCATTERPF1DPD", "VSCATTERPF1DPD", vsib)
+}
+
+// VSCATTERPF1DPS appends VSCATTERPF1DPS to the program. See the function VSCATTERPF1DPS.
+func (b *Builder) VSCATTERPF1DPS(vsib RegMem) {
+	b.add("VSCATTERPF1DPS", "VSCATTERPF1DPS", vsib)
+}
+
+// VSCATTERPF1QPD appends VSCATTERPF1QPD to the program. See the function VSCATTERPF1QPD.
+func (b *Builder) VSCATTERPF1QPD(vsib RegMem) {
+	b.add("VSCATTERPF1QPD", "VSCATTERPF1QPD", vsib)
+}
+
+// VSCATTERPF1QPS appends VSCATTERPF1QPS to the program. See the function VSCATTERPF1QPS.
+func (b *Builder) VSCATTERPF1QPS(vsib RegMem) {
+	b.add("VSCATTERPF1QPS", "VSCATTERPF1QPS", vsib)
+}
+
+// VSCATTERQPD appends VSCATTERQPD to the program. See the function VSCATTERQPD.
+func (b *Builder) VSCATTERQPD(vsib RegMem, reg VecReg) {
+	b.add("VSCATTERQPD", "VSCATTERQPD", vsib, reg)
+}
+
+// VSCATTERQPS appends VSCATTERQPS to the program. See the function VSCATTERQPS.
+func (b *Builder) VSCATTERQPS(vsib RegMem, reg VecReg) {
+	b.add("VSCATTERQPS", "VSCATTERQPS", vsib, reg)
+}
+
+// VSHUFF32X4 appends VSHUFF32X4 to the program. See the function VSHUFF32X4.
+func (b *Builder) VSHUFF32X4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFF32X4", "VSHUFF32X4", reg, vvvv, rm, imm)
+}
+
+// VSHUFF32x4 appends VSHUFF32X4 to the program. See the function VSHUFF32x4.
+func (b *Builder) VSHUFF32x4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFF32X4", "VSHUFF32x4", reg, vvvv, rm, imm)
+}
+
+// VSHUFF64X2 appends VSHUFF64X2 to the program. See the function VSHUFF64X2.
+func (b *Builder) VSHUFF64X2(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFF64X2", "VSHUFF64X2", reg, vvvv, rm, imm)
+}
+
+// VSHUFF64x2 appends VSHUFF64X2 to the program. See the function VSHUFF64x2.
+func (b *Builder) VSHUFF64x2(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFF64X2", "VSHUFF64x2", reg, vvvv, rm, imm)
+}
+
+// VSHUFI32X4 appends VSHUFI32X4 to the program. See the function VSHUFI32X4.
+func (b *Builder) VSHUFI32X4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFI32X4", "VSHUFI32X4", reg, vvvv, rm, imm)
+}
+
+// VSHUFI32x4 appends VSHUFI32X4 to the program. See the function VSHUFI32x4.
+func (b *Builder) VSHUFI32x4(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFI32X4", "VSHUFI32x4", reg, vvvv, rm, imm)
+}
+
+// VSHUFI64X2 appends VSHUFI64X2 to the program. See the function VSHUFI64X2.
+func (b *Builder) VSHUFI64X2(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFI64X2", "VSHUFI64X2", reg, vvvv, rm, imm)
+}
+
+// VSHUFI64x2 appends VSHUFI64X2 to the program. See the function VSHUFI64x2.
+func (b *Builder) VSHUFI64x2(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFI64X2", "VSHUFI64x2", reg, vvvv, rm, imm)
+}
+
+// VSHUFPD_FV appends VSHUFPD to the program. See the function VSHUFPD_FV.
+func (b *Builder) VSHUFPD_FV(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFPD", "VSHUFPD_FV", reg, vvvv, rm, imm)
+}
+
+// VSHUFPD_RVMI appends VSHUFPD to the program. See the function VSHUFPD_RVMI.
+func (b *Builder) VSHUFPD_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFPD", "VSHUFPD_RVMI", reg, vvvv, rm, imm)
+}
+
+// VSHUFPS_FV appends VSHUFPS to the program. See the function VSHUFPS_FV.
+func (b *Builder) VSHUFPS_FV(reg Register, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFPS", "VSHUFPS_FV", reg, vvvv, rm, imm)
+}
+
+// VSHUFPS_RVMI appends VSHUFPS to the program. See the function VSHUFPS_RVMI.
+func (b *Builder) VSHUFPS_RVMI(reg, vvvv VecReg, rm RegMem, imm Imm) {
+	b.add("VSHUFPS", "VSHUFPS_RVMI", reg, vvvv, rm, imm)
+}
+
+// VSQRTPD_FV appends VSQRTPD to the program. See the function VSQRTPD_FV.
+func (b *Builder) VSQRTPD_FV(reg Register, rm RegMem) {
+	b.add("VSQRTPD", "VSQRTPD_FV", reg, rm)
+}
+
+// VSQRTPD_RM appends VSQRTPD to the program. See the function VSQRTPD_RM.
+func (b *Builder) VSQRTPD_RM(reg VecReg, rm RegMem) {
+	b.add("VSQRTPD", "VSQRTPD_RM", reg, rm)
+}
+
+// VSQRTPS_FV appends VSQRTPS to the program. See the function VSQRTPS_FV.
+func (b *Builder) VSQRTPS_FV(reg Register, rm RegMem) {
+	b.add("VSQRTPS", "VSQRTPS_FV", reg, rm)
+}
+
+// VSQRTPS_RM appends VSQRTPS to the program. See the function VSQRTPS_RM.
+func (b *Builder) VSQRTPS_RM(reg VecReg, rm RegMem) {
+	b.add("VSQRTPS", "VSQRTPS_RM", reg, rm)
+}
+
+// VSQRTSD_RVM appends VSQRTSD to the program. See the function VSQRTSD_RVM.
+func (b *Builder) VSQRTSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VSQRTSD", "VSQRTSD_RVM", reg, vvvv, rm)
+}
+
+// VSQRTSD_T1S appends VSQRTSD to the program. See the function VSQRTSD_T1S.
+func (b *Builder) VSQRTSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSQRTSD", "VSQRTSD_T1S", reg, vvvv, rm)
+}
+
+// VSQRTSS_RVM appends VSQRTSS to the program. See the function VSQRTSS_RVM.
+func (b *Builder) VSQRTSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VSQRTSS", "VSQRTSS_RVM", reg, vvvv, rm)
+}
+
+// VSQRTSS_T1S appends VSQRTSS to the program. See the function VSQRTSS_T1S.
+func (b *Builder) VSQRTSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSQRTSS", "VSQRTSS_T1S", reg, vvvv, rm)
+}
+
+// VSTMXCSR appends VSTMXCSR to the program. See the function VSTMXCSR.
+func (b *Builder) VSTMXCSR(rm Mem) {
+	b.add("VSTMXCSR", "VSTMXCSR", rm)
+}
+
+// VSUBPD_FV appends VSUBPD to the program. See the function VSUBPD_FV.
+func (b *Builder) VSUBPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSUBPD", "VSUBPD_FV", reg, vvvv, rm)
+}
+
+// VSUBPD_RVM appends VSUBPD to the program. See the function VSUBPD_RVM.
+func (b *Builder) VSUBPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VSUBPD", "VSUBPD_RVM", reg, vvvv, rm)
+}
+
+// VSUBPS_FV appends VSUBPS to the program. See the function VSUBPS_FV.
+func (b *Builder) VSUBPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSUBPS", "VSUBPS_FV", reg, vvvv, rm)
+}
+
+// VSUBPS_RVM appends VSUBPS to the program. See the function VSUBPS_RVM.
+func (b *Builder) VSUBPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VSUBPS", "VSUBPS_RVM", reg, vvvv, rm)
+}
+
+// VSUBSD_RVM appends VSUBSD to the program. See the function VSUBSD_RVM.
+func (b *Builder) VSUBSD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VSUBSD", "VSUBSD_RVM", reg, vvvv, rm)
+}
+
+// VSUBSD_T1S appends VSUBSD to the program. See the function VSUBSD_T1S.
+func (b *Builder) VSUBSD_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSUBSD", "VSUBSD_T1S", reg, vvvv, rm)
+}
+
+// VSUBSS_RVM appends VSUBSS to the program. See the function VSUBSS_RVM.
+func (b *Builder) VSUBSS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VSUBSS", "VSUBSS_RVM", reg, vvvv, rm)
+}
+
+// VSUBSS_T1S appends VSUBSS to the program. See the function VSUBSS_T1S.
+func (b *Builder) VSUBSS_T1S(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VSUBSS", "VSUBSS_T1S", reg, vvvv, rm)
+}
+
+// VTESTPD appends VTESTPD to the program. See the function VTESTPD.
+func (b *Builder) VTESTPD(reg VecReg, rm RegMem) {
+	b.add("VTESTPD", "VTESTPD", reg, rm)
+}
+
+// VTESTPS appends VTESTPS to the program. See the function VTESTPS.
+func (b *Builder) VTESTPS(reg VecReg, rm RegMem) {
+	b.add("VTESTPS", "VTESTPS", reg, rm)
+}
+
+// VUCOMISD_RM appends VUCOMISD to the program. See the function VUCOMISD_RM.
+func (b *Builder) VUCOMISD_RM(reg VecReg, rm RegMem) {
+	b.add("VUCOMISD", "VUCOMISD_RM", reg, rm)
+}
+
+// VUCOMISD_T1S appends VUCOMISD to the program. See the function VUCOMISD_T1S.
+func (b *Builder) VUCOMISD_T1S(reg VecReg, rm RegMem) {
+	b.add("VUCOMISD", "VUCOMISD_T1S", reg, rm)
+}
+
+// VUCOMISS_RM appends VUCOMISS to the program. See the function VUCOMISS_RM.
+func (b *Builder) VUCOMISS_RM(reg VecReg, rm RegMem) {
+	b.add("VUCOMISS", "VUCOMISS_RM", reg, rm)
+}
+
+// VUCOMISS_T1S appends VUCOMISS to the program. See the function VUCOMISS_T1S.
+func (b *Builder) VUCOMISS_T1S(reg VecReg, rm RegMem) {
+	b.add("VUCOMISS", "VUCOMISS_T1S", reg, rm)
+}
+
+// VUNPCKHPD_FV appends VUNPCKHPD to the program. See the function VUNPCKHPD_FV.
+func (b *Builder) VUNPCKHPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKHPD", "VUNPCKHPD_FV", reg, vvvv, rm)
+}
+
+// VUNPCKHPD_RVM appends VUNPCKHPD to the program. See the function VUNPCKHPD_RVM.
+func (b *Builder) VUNPCKHPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKHPD", "VUNPCKHPD_RVM", reg, vvvv, rm)
+}
+
+// VUNPCKHPD__RVM appends VUNPCKHPD to the program. See the function VUNPCKHPD__RVM.
+func (b *Builder) VUNPCKHPD__RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKHPD", "VUNPCKHPD__RVM", reg, vvvv, rm)
+}
+
+// VUNPCKHPS_FV appends VUNPCKHPS to the program. See the function VUNPCKHPS_FV.
+func (b *Builder) VUNPCKHPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKHPS", "VUNPCKHPS_FV", reg, vvvv, rm)
+}
+
+// VUNPCKHPS_RVM appends VUNPCKHPS to the program. See the function VUNPCKHPS_RVM.
+func (b *Builder) VUNPCKHPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKHPS", "VUNPCKHPS_RVM", reg, vvvv, rm)
+}
+
+// VUNPCKHPS__RVM appends VUNPCKHPS to the program. See the function VUNPCKHPS__RVM.
+func (b *Builder) VUNPCKHPS__RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKHPS", "VUNPCKHPS__RVM", reg, vvvv, rm)
+}
+
+// VUNPCKLPD_FV appends VUNPCKLPD to the program. See the function VUNPCKLPD_FV.
+func (b *Builder) VUNPCKLPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKLPD", "VUNPCKLPD_FV", reg, vvvv, rm)
+}
+
+// VUNPCKLPD_RVM appends VUNPCKLPD to the program. See the function VUNPCKLPD_RVM.
+func (b *Builder) VUNPCKLPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKLPD", "VUNPCKLPD_RVM", reg, vvvv, rm)
+}
+
+// VUNPCKLPD__RVM appends VUNPCKLPD to the program. See the function VUNPCKLPD__RVM.
+func (b *Builder) VUNPCKLPD__RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKLPD", "VUNPCKLPD__RVM", reg, vvvv, rm)
+}
+
+// VUNPCKLPS_FV appends VUNPCKLPS to the program. See the function VUNPCKLPS_FV.
+func (b *Builder) VUNPCKLPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKLPS", "VUNPCKLPS_FV", reg, vvvv, rm)
+}
+
+// VUNPCKLPS_RVM appends VUNPCKLPS to the program. See the function VUNPCKLPS_RVM.
+func (b *Builder) VUNPCKLPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKLPS", "VUNPCKLPS_RVM", reg, vvvv, rm)
+}
+
+// VUNPCKLPS__RVM appends VUNPCKLPS to the program. See the function VUNPCKLPS__RVM.
+func (b *Builder) VUNPCKLPS__RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VUNPCKLPS", "VUNPCKLPS__RVM", reg, vvvv, rm)
+}
+
+// VXORPD_FV appends VXORPD to the program. See the function VXORPD_FV.
+func (b *Builder) VXORPD_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VXORPD", "VXORPD_FV", reg, vvvv, rm)
+}
+
+// VXORPD_RVM appends VXORPD to the program. See the function VXORPD_RVM.
+func (b *Builder) VXORPD_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VXORPD", "VXORPD_RVM", reg, vvvv, rm)
+}
+
+// VXORPD__RVM appends VXORPD to the program. See the function VXORPD__RVM.
+func (b *Builder) VXORPD__RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VXORPD", "VXORPD__RVM", reg, vvvv, rm)
+}
+
+// VXORPS_FV appends VXORPS to the program. See the function VXORPS_FV.
+func (b *Builder) VXORPS_FV(reg Register, vvvv VecReg, rm RegMem) {
+	b.add("VXORPS", "VXORPS_FV", reg, vvvv, rm)
+}
+
+// VXORPS_RVM appends VXORPS to the program. See the function VXORPS_RVM.
+func (b *Builder) VXORPS_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VXORPS", "VXORPS_RVM", reg, vvvv, rm)
+}
+
+// VZEROALL appends VZEROALL to the program. See the function VZEROALL.
+func (b *Builder) VZEROALL() {
+	b.add("VZEROALL", "VZEROALL")
+}
+
+// VZEROUPPER appends VZEROUPPER to the program. See the function VZEROUPPER.
+func (b *Builder) VZEROUPPER() {
+	b.add("VZEROUPPER", "VZEROUPPER")
+}
+
+// WAIT appends WAIT to the program. See the function WAIT.
+func (b *Builder) WAIT() {
+	b.add("WAIT", "WAIT")
+}
+
+// WBINVD appends WBINVD to the program. See the function WBINVD.
+func (b *Builder) WBINVD() {
+	b.add("WBINVD", "WBINVD")
+}
+
+// WRFSBASE appends WRFSBASE to the program. See the function WRFSBASE.
+func (b *Builder) WRFSBASE(rm Reg) {
+	b.add("WRFSBASE", "WRFSBASE", rm)
+}
+
+// WRGSBASE appends WRGSBASE to the program. See the function WRGSBASE.
+func (b *Builder) WRGSBASE(rm Reg) {
+	b.add("WRGSBASE", "WRGSBASE", rm)
+}
+
+// WRMSR appends WRMSR to the program. See the function WRMSR.
+func (b *Builder) WRMSR() {
+	b.add("WRMSR", "WRMSR")
+}
+
+// WRPKRU appends WRPKRU to the program. See the function WRPKRU.
+func (b *Builder) WRPKRU() {
+	b.add("WRPKRU", "WRPKRU")
+}
+
+// XABORT appends XABORT to the program. See the function XABORT.
+func (b *Builder) XABORT(imm Imm) {
+	b.add("XABORT", "XABORT", imm)
+}
+
+// XACQUIRE appends XACQUIRE to the program. See the function XACQUIRE.
+func (b *Builder) XACQUIRE() {
+	b.add("XACQUIRE", "XACQUIRE")
+}
+
+// XADD appends XADD to the program. See the function XADD.
+func (b *Builder) XADD(rm RegMem, reg Reg) {
+	b.add("XADD", "XADD", rm, reg)
+}
+
+// XBEGIN appends XBEGIN to the program. See the function XBEGIN.
+func (b *Builder) XBEGIN(rel Target) {
+	b.add("XBEGIN", "XBEGIN", rel)
+}
+
+// XCHG_MR appends XCHG to the program. See the function XCHG_MR.
+func (b *Builder) XCHG_MR(rm RegMem, reg Reg) {
+	b.add("XCHG", "XCHG_MR", rm, reg)
+}
+
+// XCHG_O appends XCHG to the program. See the function XCHG_O.
+func (b *Builder) XCHG_O(arg, arg2 Reg) {
+	b.add("XCHG", "XCHG_O", arg, arg2)
+}
+
+// XCHG_RM appends XCHG to the program. See the function XCHG_RM.
+func (b *Builder) XCHG_RM(reg Reg, rm RegMem) {
+	b.add("XCHG", "XCHG_RM", reg, rm)
+}
+
+// XEND appends XEND to the program. See the function XEND.
+func (b *Builder) XEND() {
+	b.add("XEND", "XEND")
+}
+
+// XGETBV appends XGETBV to the program. See the function XGETBV.
+func (b *Builder) XGETBV() {
+	b.add("XGETBV", "XGETBV")
+}
+
+// XLATB appends XLATB to the program. See the function XLATB.
+func (b *Builder) XLATB() {
+	b.add("XLATB", "XLATB")
+}
+
+// XORPD appends XORPD to the program. See the function XORPD.
+func (b *Builder) XORPD(reg VecReg, rm RegMem) {
+	b.add("XORPD", "XORPD", reg, rm)
+}
+
+// XORPS appends XORPS to the program. See the function XORPS.
+func (b *Builder) XORPS(reg VecReg, rm RegMem) {
+	b.add("XORPS", "XORPS", reg, rm)
+}
+
+// XOR_I appends XOR to the program. See the function XOR_I.
+func (b *Builder) XOR_I(al Reg, imm Imm) {
+	b.add("XOR", "XOR_I", al, imm)
+}
+
+// XOR_MI appends XOR to the program. See the function XOR_MI.
+func (b *Builder) XOR_MI(rm RegMem, imm Imm) {
+	b.add("XOR", "XOR_MI", rm, imm)
+}
+
+// XOR_MR appends XOR to the program. See the function XOR_MR.
+func (b *Builder) XOR_MR(rm RegMem, reg Reg) {
+	b.add("XOR", "XOR_MR", rm, reg)
+}
+
+// XOR_RM appends XOR to the program. See the function XOR_RM.
+func (b *Builder) XOR_RM(reg Reg, rm RegMem) {
+	b.add("XOR", "XOR_RM", reg, rm)
+}
+
+// XRELEASE appends XRELEASE to the program. See the function XRELEASE.
+func (b *Builder) XRELEASE() {
+	b.add("XRELEASE", "XRELEASE")
+}
+
+// XRSTOR appends XRSTOR to the program. See the function XRSTOR.
+func (b *Builder) XRSTOR(rm Mem) {
+	b.add("XRSTOR", "XRSTOR", rm)
+}
+
+// XRSTOR64 appends XRSTOR64 to the program. See the function XRSTOR64.
+func (b *Builder) XRSTOR64(rm Mem) {
+	b.add("XRSTOR64", "XRSTOR64", rm)
+}
+
+// XRSTORS appends XRSTORS to the program. See the function XRSTORS.
+func (b *Builder) XRSTORS(rm Mem) {
+	b.add("XRSTORS", "XRSTORS", rm)
+}
+
+// XRSTORS64 appends XRSTORS64 to the program. See the function XRSTORS64.
+func (b *Builder) XRSTORS64(rm Mem) {
+	b.add("XRSTORS64", "XRSTORS64", rm)
+}
+
+// XSAVE appends XSAVE to the program. See the function XSAVE.
+func (b *Builder) XSAVE(rm Mem) {
+	b.add("XSAVE", "XSAVE", rm)
+}
+
+// XSAVE64 appends XSAVE64 to the program. See the function XSAVE64.
+func (b *Builder) XSAVE64(rm Mem) {
+	b.add("XSAVE64", "XSAVE64", rm)
+}
+
+// XSAVEC appends XSAVEC to the program. See the function XSAVEC.
+func (b *Builder) XSAVEC(rm Mem) {
+	b.add("XSAVEC", "XSAVEC", rm)
+}
+
+// XSAVEC64 appends XSAVEC64 to the program. See the function XSAVEC64.
+func (b *Builder) XSAVEC64(rm Mem) {
+	b.add("XSAVEC64", "XSAVEC64", rm)
+}
+
+// XSAVEOPT appends XSAVEOPT to the program. See the function XSAVEOPT.
+func (b *Builder) XSAVEOPT(rm Mem) {
+	b.add("XSAVEOPT", "XSAVEOPT", rm)
+}
+
+// XSAVEOPT64 appends XSAVEOPT64 to the program. See the function XSAVEOPT64.
+func (b *Builder) XSAVEOPT64(rm Mem) {
+	b.add("XSAVEOPT64", "XSAVEOPT64", rm)
+}
+
+// XSAVES appends XSAVES to the program. See the function XSAVES.
+func (b *Builder) XSAVES(rm Mem) {
+	b.add("XSAVES", "XSAVES", rm)
+}
+
+// XSAVES64 appends XSAVES64 to the program. See the function XSAVES64.
+func (b *Builder) XSAVES64(rm Mem) {
+	b.add("XSAVES64", "XSAVES64", rm)
+}
+
+// XSETBV appends XSETBV to the program. See the function XSETBV.
+func (b *Builder) XSETBV() {
+	b.add("XSETBV", "XSETBV")
+}
+
+// XTEST appends XTEST to the program. See the function XTEST.
+func (b *Builder) XTEST() {
+	b.add("XTEST", "XTEST")
+}
+
+// ADCB_I appends ADC to the program with a 8-bit operand size. See the function ADCB_I.
+func (b *Builder) ADCB_I(al Reg, imm Imm) {
+	b.add("ADC", "ADCB_I", al, imm)
+}
+
+// ADCB_MI appends ADC to the program with a 8-bit operand size. See the function ADCB_MI.
+func (b *Builder) ADCB_MI(rm RegMem, imm Imm) {
+	b.add("ADC", "ADCB_MI", rm, imm)
+}
+
+// ADCB_MR appends ADC to the program with a 8-bit operand size. See the function ADCB_MR.
+func (b *Builder) ADCB_MR(rm RegMem, reg Reg) {
+	b.add("ADC", "ADCB_MR", rm, reg)
+}
+
+// ADCB_RM appends ADC to the program with a 8-bit operand size. See the function ADCB_RM.
+func (b *Builder) ADCB_RM(reg Reg, rm RegMem) {
+	b.add("ADC", "ADCB_RM", reg, rm)
+}
+
+// ADCL_I appends ADC to the program with a 32-bit operand size. See the function ADCL_I.
+func (b *Builder) ADCL_I(al Reg, imm Imm) {
+	b.add("ADC", "ADCL_I", al, imm)
+}
+
+// ADCL_MI appends ADC to the program with a 32-bit operand size. See the function ADCL_MI.
+func (b *Builder) ADCL_MI(rm RegMem, imm Imm) {
+	b.add("ADC", "ADCL_MI", rm, imm)
+}
+
+// ADCL_MR appends ADC to the program with a 32-bit operand size. See the function ADCL_MR.
+func (b *Builder) ADCL_MR(rm RegMem, reg Reg) {
+	b.add("ADC", "ADCL_MR", rm, reg)
+}
+
+// ADCL_RM appends ADC to the program with a 32-bit operand size. See the function ADCL_RM.
+func (b *Builder) ADCL_RM(reg Reg, rm RegMem) {
+	b.add("ADC", "ADCL_RM", reg, rm)
+}
+
+// ADCQ_I appends ADC to the program with a 64-bit operand size. See the function ADCQ_I.
+func (b *Builder) ADCQ_I(al Reg, imm Imm) {
+	b.add("ADC", "ADCQ_I", al, imm)
+}
+
+// ADCQ_MI appends ADC to the program with a 64-bit operand size. See the function ADCQ_MI.
+func (b *Builder) ADCQ_MI(rm RegMem, imm Imm) {
+	b.add("ADC", "ADCQ_MI", rm, imm)
+}
+
+// ADCQ_MR appends ADC to the program with a 64-bit operand size. See the function ADCQ_MR.
+func (b *Builder) ADCQ_MR(rm RegMem, reg Reg) {
+	b.add("ADC", "ADCQ_MR", rm, reg)
+}
+
+// ADCQ_RM appends ADC to the program with a 64-bit operand size. See the function ADCQ_RM.
+func (b *Builder) ADCQ_RM(reg Reg, rm RegMem) {
+	b.add("ADC", "ADCQ_RM", reg, rm)
+}
+
+// ADCW_I appends ADC to the program with a 16-bit operand size. See the function ADCW_I.
+func (b *Builder) ADCW_I(al Reg, imm Imm) {
+	b.add("ADC", "ADCW_I", al, imm)
+}
+
+// ADCW_MI appends ADC to the program with a 16-bit operand size. See the function ADCW_MI.
+func (b *Builder) ADCW_MI(rm RegMem, imm Imm) {
+	b.add("ADC", "ADCW_MI", rm, imm)
+}
+
+// ADCW_MR appends ADC to the program with a 16-bit operand size. See the function ADCW_MR.
+func (b *Builder) ADCW_MR(rm RegMem, reg Reg) {
+	b.add("ADC", "ADCW_MR", rm, reg)
+}
+
+// ADCW_RM appends ADC to the program with a 16-bit operand size. See the function ADCW_RM.
+func (b *Builder) ADCW_RM(reg Reg, rm RegMem) {
+	b.add("ADC", "ADCW_RM", reg, rm)
+}
+
+// ADCXL appends ADCX to the program with a 32-bit operand size. See the function ADCXL.
+func (b *Builder) ADCXL(reg Reg, rm RegMem) {
+	b.add("ADCX", "ADCXL", reg, rm)
+}
+
+// ADCXQ appends ADCX to the program with a 64-bit operand size. See the function ADCXQ.
+func (b *Builder) ADCXQ(reg Reg, rm RegMem) {
+	b.add("ADCX", "ADCXQ", reg, rm)
+}
+
+// ADDB_I appends ADD to the program with a 8-bit operand size. See the function ADDB_I.
+func (b *Builder) ADDB_I(al Reg, imm Imm) {
+	b.add("ADD", "ADDB_I", al, imm)
+}
+
+// ADDB_MI appends ADD to the program with a 8-bit operand size. See the function ADDB_MI.
+func (b *Builder) ADDB_MI(rm RegMem, imm Imm) {
+	b.add("ADD", "ADDB_MI", rm, imm)
+}
+
+// ADDB_MR appends ADD to the program with a 8-bit operand size. See the function ADDB_MR.
+func (b *Builder) ADDB_MR(rm RegMem, reg Reg) {
+	b.add("ADD", "ADDB_MR", rm, reg)
+}
+
+// ADDB_RM appends ADD to the program with a 8-bit operand size. See the function ADDB_RM.
+func (b *Builder) ADDB_RM(reg Reg, rm RegMem) {
+	b.add("ADD", "ADDB_RM", reg, rm)
+}
+
+// ADDL_I appends ADD to the program with a 32-bit operand size. See the function ADDL_I.
+func (b *Builder) ADDL_I(al Reg, imm Imm) {
+	b.add("ADD", "ADDL_I", al, imm)
+}
+
+// ADDL_MI appends ADD to the program with a 32-bit operand size. See the function ADDL_MI.
+func (b *Builder) ADDL_MI(rm RegMem, imm Imm) {
+	b.add("ADD", "ADDL_MI", rm, imm)
+}
+
+// ADDL_MR appends ADD to the program with a 32-bit operand size. See the function ADDL_MR.
+func (b *Builder) ADDL_MR(rm RegMem, reg Reg) {
+	b.add("ADD", "ADDL_MR", rm, reg)
+}
+
+// ADDL_RM appends ADD to the program with a 32-bit operand size. See the function ADDL_RM.
+func (b *Builder) ADDL_RM(reg Reg, rm RegMem) {
+	b.add("ADD", "ADDL_RM", reg, rm)
+}
+
+// ADDQ_I appends ADD to the program with a 64-bit operand size. See the function ADDQ_I.
+func (b *Builder) ADDQ_I(al Reg, imm Imm) {
+	b.add("ADD", "ADDQ_I", al, imm)
+}
+
+// ADDQ_MI appends ADD to the program with a 64-bit operand size. See the function ADDQ_MI.
+func (b *Builder) ADDQ_MI(rm RegMem, imm Imm) {
+	b.add("ADD", "ADDQ_MI", rm, imm)
+}
+
+// ADDQ_MR appends ADD to the program with a 64-bit operand size. See the function ADDQ_MR.
+func (b *Builder) ADDQ_MR(rm RegMem, reg Reg) {
+	b.add("ADD", "ADDQ_MR", rm, reg)
+}
+
+// ADDQ_RM appends ADD to the program with a 64-bit operand size. See the function ADDQ_RM.
+func (b *Builder) ADDQ_RM(reg Reg, rm RegMem) {
+	b.add("ADD", "ADDQ_RM", reg, rm)
+}
+
+// ADDW_I appends ADD to the program with a 16-bit operand size. See the function ADDW_I.
+func (b *Builder) ADDW_I(al Reg, imm Imm) {
+	b.add("ADD", "ADDW_I", al, imm)
+}
+
+// ADDW_MI appends ADD to the program with a 16-bit operand size. See the function ADDW_MI.
+func (b *Builder) ADDW_MI(rm RegMem, imm Imm) {
+	b.add("ADD", "ADDW_MI", rm, imm)
+}
+
+// ADDW_MR appends ADD to the program with a 16-bit operand size. See the function ADDW_MR.
+func (b *Builder) ADDW_MR(rm RegMem, reg Reg) {
+	b.add("ADD", "ADDW_MR", rm, reg)
+}
+
+// ADDW_RM appends ADD to the program with a 16-bit operand size. See the function ADDW_RM.
+func (b *Builder) ADDW_RM(reg Reg, rm RegMem) {
+	b.add("ADD", "ADDW_RM", reg, rm)
+}
+
+// ADOXL appends ADOX to the program with a 32-bit operand size. See the function ADOXL.
+func (b *Builder) ADOXL(reg Reg, rm RegMem) {
+	b.add("ADOX", "ADOXL", reg, rm)
+}
+
+// ADOXQ appends ADOX to the program with a 64-bit operand size. See the function ADOXQ.
+func (b *Builder) ADOXQ(reg Reg, rm RegMem) {
+	b.add("ADOX", "ADOXQ", reg, rm)
+}
+
+// ANDB_I appends AND to the program with a 8-bit operand size. See the function ANDB_I.
+func (b *Builder) ANDB_I(al Reg, imm Imm) {
+	b.add("AND", "ANDB_I", al, imm)
+}
+
+// ANDB_MI appends AND to the program with a 8-bit operand size. See the function ANDB_MI.
+func (b *Builder) ANDB_MI(rm RegMem, imm Imm) {
+	b.add("AND", "ANDB_MI", rm, imm)
+}
+
+// ANDB_MR appends AND to the program with a 8-bit operand size. See the function ANDB_MR.
+func (b *Builder) ANDB_MR(rm RegMem, reg Reg) {
+	b.add("AND", "ANDB_MR", rm, reg)
+}
+
+// ANDB_RM appends AND to the program with a 8-bit operand size. See the function ANDB_RM.
+func (b *Builder) ANDB_RM(reg Reg, rm RegMem) {
+	b.add("AND", "ANDB_RM", reg, rm)
+}
+
+// ANDL_I appends AND to the program with a 32-bit operand size. See the function ANDL_I.
+func (b *Builder) ANDL_I(al Reg, imm Imm) {
+	b.add("AND", "ANDL_I", al, imm)
+}
+
+// ANDL_MI appends AND to the program with a 32-bit operand size. See the function ANDL_MI.
+func (b *Builder) ANDL_MI(rm RegMem, imm Imm) {
+	b.add("AND", "ANDL_MI", rm, imm)
+}
+
+// ANDL_MR appends AND to the program with a 32-bit operand size. See the function ANDL_MR.
+func (b *Builder) ANDL_MR(rm RegMem, reg Reg) {
+	b.add("AND", "ANDL_MR", rm, reg)
+}
+
+// ANDL_RM appends AND to the program with a 32-bit operand size. See the function ANDL_RM.
+func (b *Builder) ANDL_RM(reg Reg, rm RegMem) {
+	b.add("AND", "ANDL_RM", reg, rm)
+}
+
+// ANDNL appends ANDN to the program with a 32-bit operand size. See the function ANDNL.
+func (b *Builder) ANDNL(reg, vvvv Reg, rm RegMem) {
+	b.add("ANDN", "ANDNL", reg, vvvv, rm)
+}
+
+// ANDNQ appends ANDN to the program with a 64-bit operand size. See the function ANDNQ.
+func (b *Builder) ANDNQ(reg, vvvv Reg, rm RegMem) {
+	b.add("ANDN", "ANDNQ", reg, vvvv, rm)
+}
+
+// ANDQ_I appends AND to the program with a 64-bit operand size. See the function ANDQ_I.
+func (b *Builder) ANDQ_I(al Reg, imm Imm) {
+	b.add("AND", "ANDQ_I", al, imm)
+}
+
+// ANDQ_MI appends AND to the program with a 64-bit operand size. See the function ANDQ_MI.
+func (b *Builder) ANDQ_MI(rm RegMem, imm Imm) {
+	b.add("AND", "ANDQ_MI", rm, imm)
+}
+
+// ANDQ_MR appends AND to the program with a 64-bit operand size. See the function ANDQ_MR.
+func (b *Builder) ANDQ_MR(rm RegMem, reg Reg) {
+	b.add("AND", "ANDQ_MR", rm, reg)
+}
+
+// ANDQ_RM appends AND to the program with a 64-bit operand size. See the function ANDQ_RM.
+func (b *Builder) ANDQ_RM(reg Reg, rm RegMem) {
+	b.add("AND", "ANDQ_RM", reg, rm)
+}
+
+// ANDW_I appends AND to the program with a 16-bit operand size. See the function ANDW_I.
+func (b *Builder) ANDW_I(al Reg, imm Imm) {
+	b.add("AND", "ANDW_I", al, imm)
+}
+
+// ANDW_MI appends AND to the program with a 16-bit operand size. See the function ANDW_MI.
+func (b *Builder) ANDW_MI(rm RegMem, imm Imm) {
+	b.add("AND", "ANDW_MI", rm, imm)
+}
+
+// ANDW_MR appends AND to the program with a 16-bit operand size. See the function ANDW_MR.
+func (b *Builder) ANDW_MR(rm RegMem, reg Reg) {
+	b.add("AND", "ANDW_MR", rm, reg)
+}
+
+// ANDW_RM appends AND to the program with a 16-bit operand size. See the function ANDW_RM.
+func (b *Builder) ANDW_RM(reg Reg, rm RegMem) {
+	b.add("AND", "ANDW_RM", reg, rm)
+}
+
+// BEXTRL appends BEXTR to the program with a 32-bit operand size. See the function BEXTRL.
+func (b *Builder) BEXTRL(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("BEXTR", "BEXTRL", reg, rm, vvvv)
+}
+
+// BEXTRQ appends BEXTR to the program with a 64-bit operand size. See the function BEXTRQ.
+func (b *Builder) BEXTRQ(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("BEXTR", "BEXTRQ", reg, rm, vvvv)
+}
+
+// BLSIL appends BLSI to the program with a 32-bit operand size. See the function BLSIL.
+func (b *Builder) BLSIL(vvvv Reg, rm RegMem) {
+	b.add("BLSI", "BLSIL", vvvv, rm)
+}
+
+// BLSIQ appends BLSI to the program with a 64-bit operand size. See the function BLSIQ.
+func (b *Builder) BLSIQ(vvvv Reg, rm RegMem) {
+	b.add("BLSI", "BLSIQ", vvvv, rm)
+}
+
+// BLSMSKL appends BLSMSK to the program with a 32-bit operand size. See the function BLSMSKL.
+func (b *Builder) BLSMSKL(vvvv Reg, rm RegMem) {
+	b.add("BLSMSK", "BLSMSKL", vvvv, rm)
+}
+
+// BLSMSKQ appends BLSMSK to the program with a 64-bit operand size. See the function BLSMSKQ.
+func (b *Builder) BLSMSKQ(vvvv Reg, rm RegMem) {
+	b.add("BLSMSK", "BLSMSKQ", vvvv, rm)
+}
+
+// BLSRL appends BLSR to the program with a 32-bit operand size. See the function BLSRL.
+func (b *Builder) BLSRL(vvvv Reg, rm RegMem) {
+	b.add("BLSR", "BLSRL", vvvv, rm)
+}
+
+// BLSRQ appends BLSR to the program with a 64-bit operand size. See the function BLSRQ.
+func (b *Builder) BLSRQ(vvvv Reg, rm RegMem) {
+	b.add("BLSR", "BLSRQ", vvvv, rm)
+}
+
+// BOUNDL appends BOUND to the program with a 32-bit operand size. See the function BOUNDL.
+func (b *Builder) BOUNDL(reg Reg, rm Mem) {
+	b.add("BOUND", "BOUNDL", reg, rm)
+}
+
+// BOUNDW appends BOUND to the program with a 16-bit operand size. See the function BOUNDW.
+func (b *Builder) BOUNDW(reg Reg, rm Mem) {
+	b.add("BOUND", "BOUNDW", reg, rm)
+}
+
+// BSFL appends BSF to the program with a 32-bit operand size. See the function BSFL.
+func (b *Builder) BSFL(reg Reg, rm RegMem) {
+	b.add("BSF", "BSFL", reg, rm)
+}
+
+// BSFQ appends BSF to the program with a 64-bit operand size. See the function BSFQ.
+func (b *Builder) BSFQ(reg Reg, rm RegMem) {
+	b.add("BSF", "BSFQ", reg, rm)
+}
+
+// BSFW appends BSF to the program with a 16-bit operand size. See the function BSFW.
+func (b *Builder) BSFW(reg Reg, rm RegMem) {
+	b.add("BSF", "BSFW", reg, rm)
+}
+
+// BSRL appends BSR to the program with a 32-bit operand size. See the function BSRL.
+func (b *Builder) BSRL(reg Reg, rm RegMem) {
+	b.add("BSR", "BSRL", reg, rm)
+}
+
+// BSRQ appends BSR to the program with a 64-bit operand size. See the function BSRQ.
+func (b *Builder) BSRQ(reg Reg, rm RegMem) {
+	b.add("BSR", "BSRQ", reg, rm)
+}
+
+// BSRW appends BSR to the program with a 16-bit operand size. See the function BSRW.
+func (b *Builder) BSRW(reg Reg, rm RegMem) {
+	b.add("BSR", "BSRW", reg, rm)
+}
+
+// BSWAPL appends BSWAP to the program with a 32-bit operand size. See the function BSWAPL.
+func (b *Builder) BSWAPL(opcode Reg) {
+	b.add("BSWAP", "BSWAPL", opcode)
+}
+
+// BSWAPQ appends BSWAP to the program with a 64-bit operand size. See the function BSWAPQ.
+func (b *Builder) BSWAPQ(opcode Reg) {
+	b.add("BSWAP", "BSWAPQ", opcode)
+}
+
+// BSWAPW appends BSWAP to the program with a 16-bit operand size. See the function BSWAPW.
+func (b *Builder) BSWAPW(opcode Reg) {
+	b.add("BSWAP", "BSWAPW", opcode)
+}
+
+// BTCL_MI appends BTC to the program with a 32-bit operand size. See the function BTCL_MI.
+func (b *Builder) BTCL_MI(rm RegMem, imm Imm) {
+	b.add("BTC", "BTCL_MI", rm, imm)
+}
+
+// BTCL_MR appends BTC to the program with a 32-bit operand size. See the function BTCL_MR.
+func (b *Builder) BTCL_MR(rm RegMem, reg Reg) {
+	b.add("BTC", "BTCL_MR", rm, reg)
+}
+
+// BTCQ_MI appends BTC to the program with a 64-bit operand size. See the function BTCQ_MI.
+func (b *Builder) BTCQ_MI(rm RegMem, imm Imm) {
+	b.add("BTC", "BTCQ_MI", rm, imm)
+}
+
+// BTCQ_MR appends BTC to the program with a 64-bit operand size. See the function BTCQ_MR.
+func (b *Builder) BTCQ_MR(rm RegMem, reg Reg) {
+	b.add("BTC", "BTCQ_MR", rm, reg)
+}
+
+// BTCW_MI appends BTC to the program with a 16-bit operand size. See the function BTCW_MI.
+func (b *Builder) BTCW_MI(rm RegMem, imm Imm) {
+	b.add("BTC", "BTCW_MI", rm, imm)
+}
+
+// BTCW_MR appends BTC to the program with a 16-bit operand size. See the function BTCW_MR.
+func (b *Builder) BTCW_MR(rm RegMem, reg Reg) {
+	b.add("BTC", "BTCW_MR", rm, reg)
+}
+
+// BTL_MI appends BT to the program with a 32-bit operand size. See the function BTL_MI.
+func (b *Builder) BTL_MI(rm RegMem, imm Imm) {
+	b.add("BT", "BTL_MI", rm, imm)
+}
+
+// BTL_MR appends BT to the program with a 32-bit operand size. See the function BTL_MR.
+func (b *Builder) BTL_MR(rm RegMem, reg Reg) {
+	b.add("BT", "BTL_MR", rm, reg)
+}
+
+// BTQ_MI appends BT to the program with a 64-bit operand size. See the function BTQ_MI.
+func (b *Builder) BTQ_MI(rm RegMem, imm Imm) {
+	b.add("BT", "BTQ_MI", rm, imm)
+}
+
+// BTQ_MR appends BT to the program with a 64-bit operand size. See the function BTQ_MR.
+func (b *Builder) BTQ_MR(rm RegMem, reg Reg) {
+	b.add("BT", "BTQ_MR", rm, reg)
+}
+
+// BTRL_MI appends BTR to the program with a 32-bit operand size. See the function BTRL_MI.
+func (b *Builder) BTRL_MI(rm RegMem, imm Imm) {
+	b.add("BTR", "BTRL_MI", rm, imm)
+}
+
+// BTRL_MR appends BTR to the program with a 32-bit operand size. See the function BTRL_MR.
+func (b *Builder) BTRL_MR(rm RegMem, reg Reg) {
+	b.add("BTR", "BTRL_MR", rm, reg)
+}
+
+// BTRQ_MI appends BTR to the program with a 64-bit operand size. See the function BTRQ_MI.
+func (b *Builder) BTRQ_MI(rm RegMem, imm Imm) {
+	b.add("BTR", "BTRQ_MI", rm, imm)
+}
+
+// BTRQ_MR appends BTR to the program with a 64-bit operand size. See the function BTRQ_MR.
+func (b *Builder) BTRQ_MR(rm RegMem, reg Reg) {
+	b.add("BTR", "BTRQ_MR", rm, reg)
+}
+
+// BTRW_MI appends BTR to the program with a 16-bit operand size. See the function BTRW_MI.
+func (b *Builder) BTRW_MI(rm RegMem, imm Imm) {
+	b.add("BTR", "BTRW_MI", rm, imm)
+}
+
+// BTRW_MR appends BTR to the program with a 16-bit operand size. See the function BTRW_MR.
+func (b *Builder) BTRW_MR(rm RegMem, reg Reg) {
+	b.add("BTR", "BTRW_MR", rm, reg)
+}
+
+// BTSL_MI appends BTS to the program with a 32-bit operand size. See the function BTSL_MI.
+func (b *Builder) BTSL_MI(rm RegMem, imm Imm) {
+	b.add("BTS", "BTSL_MI", rm, imm)
+}
+
+// BTSL_MR appends BTS to the program with a 32-bit operand size. See the function BTSL_MR.
+func (b *Builder) BTSL_MR(rm RegMem, reg Reg) {
+	b.add("BTS", "BTSL_MR", rm, reg)
+}
+
+// BTSQ_MI appends BTS to the program with a 64-bit operand size. See the function BTSQ_MI.
+func (b *Builder) BTSQ_MI(rm RegMem, imm Imm) {
+	b.add("BTS", "BTSQ_MI", rm, imm)
+}
+
+// BTSQ_MR appends BTS to the program with a 64-bit operand size. See the function BTSQ_MR.
+func (b *Builder) BTSQ_MR(rm RegMem, reg Reg) {
+	b.add("BTS", "BTSQ_MR", rm, reg)
+}
+
+// BTSW_MI appends BTS to the program with a 16-bit operand size. See the function BTSW_MI.
+func (b *Builder) BTSW_MI(rm RegMem, imm Imm) {
+	b.add("BTS", "BTSW_MI", rm, imm)
+}
+
+// BTSW_MR appends BTS to the program with a 16-bit operand size. See the function BTSW_MR.
+func (b *Builder) BTSW_MR(rm RegMem, reg Reg) {
+	b.add("BTS", "BTSW_MR", rm, reg)
+}
+
+// BTW_MI appends BT to the program with a 16-bit operand size. See the function BTW_MI.
+func (b *Builder) BTW_MI(rm RegMem, imm Imm) {
+	b.add("BT", "BTW_MI", rm, imm)
+}
+
+// BTW_MR appends BT to the program with a 16-bit operand size. See the function BTW_MR.
+func (b *Builder) BTW_MR(rm RegMem, reg Reg) {
+	b.add("BT", "BTW_MR", rm, reg)
+}
+
+// BZHIL appends BZHI to the program with a 32-bit operand size. See the function BZHIL.
+func (b *Builder) BZHIL(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("BZHI", "BZHIL", reg, rm, vvvv)
+}
+
+// BZHIQ appends BZHI to the program with a 64-bit operand size. See the function BZHIQ.
+func (b *Builder) BZHIQ(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("BZHI", "BZHIQ", reg, rm, vvvv)
+}
+
+// CMOVLCC appends CMOVAE to the program with a 32-bit operand size. See the function CMOVLCC.
+func (b *Builder) CMOVLCC(reg Reg, rm RegMem) {
+	b.add("CMOVAE", "CMOVLCC", reg, rm)
+}
+
+// CMOVLCS appends CMOVB to the program with a 32-bit operand size. See the function CMOVLCS.
+func (b *Builder) CMOVLCS(reg Reg, rm RegMem) {
+	b.add("CMOVB", "CMOVLCS", reg, rm)
+}
+
+// CMOVLEQ appends CMOVE to the program with a 32-bit operand size. See the function CMOVLEQ.
+func (b *Builder) CMOVLEQ(reg Reg, rm RegMem) {
+	b.add("CMOVE", "CMOVLEQ", reg, rm)
+}
+
+// CMOVLGE appends CMOVGE to the program with a 32-bit operand size. See the function CMOVLGE.
+func (b *Builder) CMOVLGE(reg Reg, rm RegMem) {
+	b.add("CMOVGE", "CMOVLGE", reg, rm)
+}
+
+// CMOVLGT appends CMOVG to the program with a 32-bit operand size. See the function CMOVLGT.
+func (b *Builder) CMOVLGT(reg Reg, rm RegMem) {
+	b.add("CMOVG", "CMOVLGT", reg, rm)
+}
+
+// CMOVLHI appends CMOVA to the program with a 32-bit operand size. See the function CMOVLHI.
+func (b *Builder) CMOVLHI(reg Reg, rm RegMem) {
+	b.add("CMOVA", "CMOVLHI", reg, rm)
+}
+
+// CMOVLLE appends CMOVLE to the program with a 32-bit operand size. See the function CMOVLLE.
+func (b *Builder) CMOVLLE(reg Reg, rm RegMem) {
+	b.add("CMOVLE", "CMOVLLE", reg, rm)
+}
+
+// CMOVLLS appends CMOVBE to the program with a 32-bit operand size. See the function CMOVLLS.
+func (b *Builder) CMOVLLS(reg Reg, rm RegMem) {
+	b.add("CMOVBE", "CMOVLLS", reg, rm)
+}
+
+// CMOVLLT appends CMOVL to the program with a 32-bit operand size. See the function CMOVLLT.
+func (b *Builder) CMOVLLT(reg Reg, rm RegMem) {
+	b.add("CMOVL", "CMOVLLT", reg, rm)
+}
+
+// CMOVLMI appends CMOVS to the program with a 32-bit operand size. See the function CMOVLMI.
+func (b *Builder) CMOVLMI(reg Reg, rm RegMem) {
+	b.add("CMOVS", "CMOVLMI", reg, rm)
+}
+
+// CMOVLNE appends CMOVNE to the program with a 32-bit operand size. See the function CMOVLNE.
+func (b *Builder) CMOVLNE(reg Reg, rm RegMem) {
+	b.add("CMOVNE", "CMOVLNE", reg, rm)
+}
+
+// CMOVLOC appends CMOVNO to the program with a 32-bit operand size. See the function CMOVLOC.
+func (b *Builder) CMOVLOC(reg Reg, rm RegMem) {
+	b.add("CMOVNO", "CMOVLOC", reg, rm)
+}
+
+// CMOVLOS appends CMOVO to the program with a 32-bit operand size. See the function CMOVLOS.
+func (b *Builder) CMOVLOS(reg Reg, rm RegMem) {
+	b.add("CMOVO", "CMOVLOS", reg, rm)
+}
+
+// CMOVLPC appends CMOVNP to the program with a 32-bit operand size. See the function CMOVLPC.
+func (b *Builder) CMOVLPC(reg Reg, rm RegMem) {
+	b.add("CMOVNP", "CMOVLPC", reg, rm)
+}
+
+// CMOVLPL appends CMOVNS to the program with a 32-bit operand size. See the function CMOVLPL.
+func (b *Builder) CMOVLPL(reg Reg, rm RegMem) {
+	b.add("CMOVNS", "CMOVLPL", reg, rm)
+}
+
+// CMOVLPS appends CMOVP to the program with a 32-bit operand size. See the function CMOVLPS.
+func (b *Builder) CMOVLPS(reg Reg, rm RegMem) {
+	b.add("CMOVP", "CMOVLPS", reg, rm)
+}
+
+// CMOVQCC appends CMOVAE to the program with a 64-bit operand size. See the function CMOVQCC.
+func (b *Builder) CMOVQCC(reg Reg, rm RegMem) {
+	b.add("CMOVAE", "CMOVQCC", reg, rm)
+}
+
+// CMOVQCS appends CMOVB to the program with a 64-bit operand size. See the function CMOVQCS.
+func (b *Builder) CMOVQCS(reg Reg, rm RegMem) {
+	b.add("CMOVB", "CMOVQCS", reg, rm)
+}
+
+// CMOVQEQ appends CMOVE to the program with a 64-bit operand size. See the function CMOVQEQ.
+func (b *Builder) CMOVQEQ(reg Reg, rm RegMem) {
+	b.add("CMOVE", "CMOVQEQ", reg, rm)
+}
+
+// CMOVQGE appends CMOVGE to the program with a 64-bit operand size. See the function CMOVQGE.
+func (b *Builder) CMOVQGE(reg Reg, rm RegMem) {
+	b.add("CMOVGE", "CMOVQGE", reg, rm)
+}
+
+// CMOVQGT appends CMOVG to the program with a 64-bit operand size. See the function CMOVQGT.
+func (b *Builder) CMOVQGT(reg Reg, rm RegMem) {
+	b.add("CMOVG", "CMOVQGT", reg, rm)
+}
+
+// CMOVQHI appends CMOVA to the program with a 64-bit operand size. See the function CMOVQHI.
+func (b *Builder) CMOVQHI(reg Reg, rm RegMem) {
+	b.add("CMOVA", "CMOVQHI", reg, rm)
+}
+
+// CMOVQLE appends CMOVLE to the program with a 64-bit operand size. See the function CMOVQLE.
+func (b *Builder) CMOVQLE(reg Reg, rm RegMem) {
+	b.add("CMOVLE", "CMOVQLE", reg, rm)
+}
+
+// CMOVQLS appends CMOVBE to the program with a 64-bit operand size. See the function CMOVQLS.
+func (b *Builder) CMOVQLS(reg Reg, rm RegMem) {
+	b.add("CMOVBE", "CMOVQLS", reg, rm)
+}
+
+// CMOVQLT appends CMOVL to the program with a 64-bit operand size. See the function CMOVQLT.
+func (b *Builder) CMOVQLT(reg Reg, rm RegMem) {
+	b.add("CMOVL", "CMOVQLT", reg, rm)
+}
+
+// CMOVQMI appends CMOVS to the program with a 64-bit operand size. See the function CMOVQMI.
+func (b *Builder) CMOVQMI(reg Reg, rm RegMem) {
+	b.add("CMOVS", "CMOVQMI", reg, rm)
+}
+
+// CMOVQNE appends CMOVNE to the program with a 64-bit operand size. See the function CMOVQNE.
+func (b *Builder) CMOVQNE(reg Reg, rm RegMem) {
+	b.add("CMOVNE", "CMOVQNE", reg, rm)
+}
+
+// CMOVQOC appends CMOVNO to the program with a 64-bit operand size. See the function CMOVQOC.
+func (b *Builder) CMOVQOC(reg Reg, rm RegMem) {
+	b.add("CMOVNO", "CMOVQOC", reg, rm)
+}
+
+// CMOVQOS appends CMOVO to the program with a 64-bit operand size. See the function CMOVQOS.
+func (b *Builder) CMOVQOS(reg Reg, rm RegMem) {
+	b.add("CMOVO", "CMOVQOS", reg, rm)
+}
+
+// CMOVQPC appends CMOVNP to the program with a 64-bit operand size. See the function CMOVQPC.
+func (b *Builder) CMOVQPC(reg Reg, rm RegMem) {
+	b.add("CMOVNP", "CMOVQPC", reg, rm)
+}
+
+// CMOVQPL appends CMOVNS to the program with a 64-bit operand size. See the function CMOVQPL.
+func (b *Builder) CMOVQPL(reg Reg, rm RegMem) {
+	b.add("CMOVNS", "CMOVQPL", reg, rm)
+}
+
+// CMOVQPS appends CMOVP to the program with a 64-bit operand size. See the function CMOVQPS.
+func (b *Builder) CMOVQPS(reg Reg, rm RegMem) {
+	b.add("CMOVP", "CMOVQPS", reg, rm)
+}
+
+// CMOVWCC appends CMOVAE to the program with a 16-bit operand size. See the function CMOVWCC.
+func (b *Builder) CMOVWCC(reg Reg, rm RegMem) {
+	b.add("CMOVAE", "CMOVWCC", reg, rm)
+}
+
+// CMOVWCS appends CMOVB to the program with a 16-bit operand size. See the function CMOVWCS.
+func (b *Builder) CMOVWCS(reg Reg, rm RegMem) {
+	b.add("CMOVB", "CMOVWCS", reg, rm)
+}
+
+// CMOVWEQ appends CMOVE to the program with a 16-bit operand size. See the function CMOVWEQ.
+func (b *Builder) CMOVWEQ(reg Reg, rm RegMem) {
+	b.add("CMOVE", "CMOVWEQ", reg, rm)
+}
+
+// CMOVWGE appends CMOVGE to the program with a 16-bit operand size. See the function CMOVWGE.
+func (b *Builder) CMOVWGE(reg Reg, rm RegMem) {
+	b.add("CMOVGE", "CMOVWGE", reg, rm)
+}
+
+// CMOVWGT appends CMOVG to the program with a 16-bit operand size. See the function CMOVWGT.
+func (b *Builder) CMOVWGT(reg Reg, rm RegMem) {
+	b.add("CMOVG", "CMOVWGT", reg, rm)
+}
+
+// CMOVWHI appends CMOVA to the program with a 16-bit operand size. See the function CMOVWHI.
+func (b *Builder) CMOVWHI(reg Reg, rm RegMem) {
+	b.add("CMOVA", "CMOVWHI", reg, rm)
+}
+
+// CMOVWLE appends CMOVLE to the program with a 16-bit operand size. See the function CMOVWLE.
+func (b *Builder) CMOVWLE(reg Reg, rm RegMem) {
+	b.add("CMOVLE", "CMOVWLE", reg, rm)
+}
+
+// CMOVWLS appends CMOVBE to the program with a 16-bit operand size. See the function CMOVWLS.
+func (b *Builder) CMOVWLS(reg Reg, rm RegMem) {
+	b.add("CMOVBE", "CMOVWLS", reg, rm)
+}
+
+// CMOVWLT appends CMOVL to the program with a 16-bit operand size. See the function CMOVWLT.
+func (b *Builder) CMOVWLT(reg Reg, rm RegMem) {
+	b.add("CMOVL", "CMOVWLT", reg, rm)
+}
+
+// CMOVWMI appends CMOVS to the program with a 16-bit operand size. See the function CMOVWMI.
+func (b *Builder) CMOVWMI(reg Reg, rm RegMem) {
+	b.add("CMOVS", "CMOVWMI", reg, rm)
+}
+
+// CMOVWNE appends CMOVNE to the program with a 16-bit operand size. See the function CMOVWNE.
+func (b *Builder) CMOVWNE(reg Reg, rm RegMem) {
+	b.add("CMOVNE", "CMOVWNE", reg, rm)
+}
+
+// CMOVWOC appends CMOVNO to the program with a 16-bit operand size. See the function CMOVWOC.
+func (b *Builder) CMOVWOC(reg Reg, rm RegMem) {
+	b.add("CMOVNO", "CMOVWOC", reg, rm)
+}
+
+// CMOVWOS appends CMOVO to the program with a 16-bit operand size. See the function CMOVWOS.
+func (b *Builder) CMOVWOS(reg Reg, rm RegMem) {
+	b.add("CMOVO", "CMOVWOS", reg, rm)
+}
+
+// CMOVWPC appends CMOVNP to the program with a 16-bit operand size. See the function CMOVWPC.
+func (b *Builder) CMOVWPC(reg Reg, rm RegMem) {
+	b.add("CMOVNP", "CMOVWPC", reg, rm)
+}
+
+// CMOVWPL appends CMOVNS to the program with a 16-bit operand size. See the function CMOVWPL.
+func (b *Builder) CMOVWPL(reg Reg, rm RegMem) {
+	b.add("CMOVNS", "CMOVWPL", reg, rm)
+}
+
+// CMOVWPS appends CMOVP to the program with a 16-bit operand size. See the function CMOVWPS.
+func (b *Builder) CMOVWPS(reg Reg, rm RegMem) {
+	b.add("CMOVP", "CMOVWPS", reg, rm)
+}
+
+// CMPB_I appends CMP to the program with a 8-bit operand size. See the function CMPB_I.
+func (b *Builder) CMPB_I(al Reg, imm Imm) {
+	b.add("CMP", "CMPB_I", al, imm)
+}
+
+// CMPB_MI appends CMP to the program with a 8-bit operand size. See the function CMPB_MI.
+func (b *Builder) CMPB_MI(rm RegMem, imm Imm) {
+	b.add("CMP", "CMPB_MI", rm, imm)
+}
+
+// CMPB_MR appends CMP to the program with a 8-bit operand size. See the function CMPB_MR.
+func (b *Builder) CMPB_MR(rm RegMem, reg Reg) {
+	b.add("CMP", "CMPB_MR", rm, reg)
+}
+
+// CMPB_RM appends CMP to the program with a 8-bit operand size. See the function CMPB_RM.
+func (b *Builder) CMPB_RM(reg Reg, rm RegMem) {
+	b.add("CMP", "CMPB_RM", reg, rm)
+}
+
+// CMPL_I appends CMP to the program with a 32-bit operand size. See the function CMPL_I.
+func (b *Builder) CMPL_I(al Reg, imm Imm) {
+	b.add("CMP", "CMPL_I", al, imm)
+}
+
+// CMPL_MI appends CMP to the program with a 32-bit operand size. See the function CMPL_MI.
+func (b *Builder) CMPL_MI(rm RegMem, imm Imm) {
+	b.add("CMP", "CMPL_MI", rm, imm)
+}
+
+// CMPL_MR appends CMP to the program with a 32-bit operand size. See the function CMPL_MR.
+func (b *Builder) CMPL_MR(rm RegMem, reg Reg) {
+	b.add("CMP", "CMPL_MR", rm, reg)
+}
+
+// CMPL_RM appends CMP to the program with a 32-bit operand size. See the function CMPL_RM.
+func (b *Builder) CMPL_RM(reg Reg, rm RegMem) {
+	b.add("CMP", "CMPL_RM", reg, rm)
+}
+
+// CMPQ_I appends CMP to the program with a 64-bit operand size. See the function CMPQ_I.
+func (b *Builder) CMPQ_I(al Reg, imm Imm) {
+	b.add("CMP", "CMPQ_I", al, imm)
+}
+
+// CMPQ_MI appends CMP to the program with a 64-bit operand size. See the function CMPQ_MI.
+func (b *Builder) CMPQ_MI(rm RegMem, imm Imm) {
+	b.add("CMP", "CMPQ_MI", rm, imm)
+}
+
+// CMPQ_MR appends CMP to the program with a 64-bit operand size. See the function CMPQ_MR.
+func (b *Builder) CMPQ_MR(rm RegMem, reg Reg) {
+	b.add("CMP", "CMPQ_MR", rm, reg)
+}
+
+// CMPQ_RM appends CMP to the program with a 64-bit operand size. See the function CMPQ_RM.
+func (b *Builder) CMPQ_RM(reg Reg, rm RegMem) {
+	b.add("CMP", "CMPQ_RM", reg, rm)
+}
+
+// CMPW_I appends CMP to the program with a 16-bit operand size. See the function CMPW_I.
+func (b *Builder) CMPW_I(al Reg, imm Imm) {
+	b.add("CMP", "CMPW_I", al, imm)
+}
+
+// CMPW_MI appends CMP to the program with a 16-bit operand size. See the function CMPW_MI.
+func (b *Builder) CMPW_MI(rm RegMem, imm Imm) {
+	b.add("CMP", "CMPW_MI", rm, imm)
+}
+
+// CMPW_MR appends CMP to the program with a 16-bit operand size. See the function CMPW_MR.
+func (b *Builder) CMPW_MR(rm RegMem, reg Reg) {
+	b.add("CMP", "CMPW_MR", rm, reg)
+}
+
+// CMPW_RM appends CMP to the program with a 16-bit operand size. See the function CMPW_RM.
+func (b *Builder) CMPW_RM(reg Reg, rm RegMem) {
+	b.add("CMP", "CMPW_RM", reg, rm)
+}
+
+// CMPXCHGB appends CMPXCHG to the program with a 8-bit operand size. See the function CMPXCHGB.
+func (b *Builder) CMPXCHGB(rm RegMem, reg Reg) {
+	b.add("CMPXCHG", "CMPXCHGB", rm, reg)
+}
+
+// CMPXCHGL appends CMPXCHG to the program with a 32-bit operand size. See the function CMPXCHGL.
+func (b *Builder) CMPXCHGL(rm RegMem, reg Reg) {
+	b.add("CMPXCHG", "CMPXCHGL", rm, reg)
+}
+
+// CMPXCHGQ appends CMPXCHG to the program with a 64-bit operand size. See the function CMPXCHGQ.
+func (b *Builder) CMPXCHGQ(rm RegMem, reg Reg) {
+	b.add("CMPXCHG", "CMPXCHGQ", rm, reg)
+}
+
+// CMPXCHGW appends CMPXCHG to the program with a 16-bit operand size. See the function CMPXCHGW.
+func (b *Builder) CMPXCHGW(rm RegMem, reg Reg) {
+	b.add("CMPXCHG", "CMPXCHGW", rm, reg)
+}
+
+// CRC32B appends CRC32 to the program with a 8-bit operand size. See the function CRC32B.
+func (b *Builder) CRC32B(reg Reg, rm RegMem) {
+	b.add("CRC32", "CRC32B", reg, rm)
+}
+
+// CRC32L appends CRC32 to the program with a 32-bit operand size. See the function CRC32L.
+func (b *Builder) CRC32L(reg Reg, rm RegMem) {
+	b.add("CRC32", "CRC32L", reg, rm)
+}
+
+// CRC32Q appends CRC32 to the program with a 64-bit operand size. See the function CRC32Q.
+func (b *Builder) CRC32Q(reg Reg, rm RegMem) {
+	b.add("CRC32", "CRC32Q", reg, rm)
+}
+
+// CRC32W appends CRC32 to the program with a 16-bit operand size. See the function CRC32W.
+func (b *Builder) CRC32W(reg Reg, rm RegMem) {
+	b.add("CRC32", "CRC32W", reg, rm)
+}
+
+// CVTSD2SL appends CVTSD2SI to the program with a 32-bit operand size. See the function CVTSD2SL.
+func (b *Builder) CVTSD2SL(reg Reg, rm RegMem) {
+	b.add("CVTSD2SI", "CVTSD2SL", reg, rm)
+}
+
+// CVTSD2SQ appends CVTSD2SI to the program with a 64-bit operand size. See the function CVTSD2SQ.
+func (b *Builder) CVTSD2SQ(reg Reg, rm RegMem) {
+	b.add("CVTSD2SI", "CVTSD2SQ", reg, rm)
+}
+
+// CVTSL2SD appends CVTSI2SD to the program with a 32-bit operand size. See the function CVTSL2SD.
+func (b *Builder) CVTSL2SD(reg VecReg, rm RegMem) {
+	b.add("CVTSI2SD", "CVTSL2SD", reg, rm)
+}
+
+// CVTSL2SS appends CVTSI2SS to the program with a 32-bit operand size. See the function CVTSL2SS.
+func (b *Builder) CVTSL2SS(reg VecReg, rm RegMem) {
+	b.add("CVTSI2SS", "CVTSL2SS", reg, rm)
+}
+
+// CVTSQ2SD appends CVTSI2SD to the program with a 64-bit operand size. See the function CVTSQ2SD.
+func (b *Builder) CVTSQ2SD(reg VecReg, rm RegMem) {
+	b.add("CVTSI2SD", "CVTSQ2SD", reg, rm)
+}
+
+// CVTSQ2SS appends CVTSI2SS to the program with a 64-bit operand size. See the function CVTSQ2SS.
+func (b *Builder) CVTSQ2SS(reg VecReg, rm RegMem) {
+	b.add("CVTSI2SS", "CVTSQ2SS", reg, rm)
+}
+
+// CVTSS2SL appends CVTSS2SI to the program with a 32-bit operand size. See the function CVTSS2SL.
+func (b *Builder) CVTSS2SL(reg Reg, rm RegMem) {
+	b.add("CVTSS2SI", "CVTSS2SL", reg, rm)
+}
+
+// CVTSS2SQ appends CVTSS2SI to the program with a 64-bit operand size. See the function CVTSS2SQ.
+func (b *Builder) CVTSS2SQ(reg Reg, rm RegMem) {
+	b.add("CVTSS2SI", "CVTSS2SQ", reg, rm)
+}
+
+// CVTTSD2SL appends CVTTSD2SI to the program with a 32-bit operand size. See the function CVTTSD2SL.
+func (b *Builder) CVTTSD2SL(reg Reg, rm RegMem) {
+	b.add("CVTTSD2SI", "CVTTSD2SL", reg, rm)
+}
+
+// CVTTSD2SQ appends CVTTSD2SI to the program with a 64-bit operand size. See the function CVTTSD2SQ.
+func (b *Builder) CVTTSD2SQ(reg Reg, rm RegMem) {
+	b.add("CVTTSD2SI", "CVTTSD2SQ", reg, rm)
+}
+
+// CVTTSS2SL appends CVTTSS2SI to the program with a 32-bit operand size. See the function CVTTSS2SL.
+func (b *Builder) CVTTSS2SL(reg Reg, rm RegMem) {
+	b.add("CVTTSS2SI", "CVTTSS2SL", reg, rm)
+}
+
+// CVTTSS2SQ appends CVTTSS2SI to the program with a 64-bit operand size. See the function CVTTSS2SQ.
+func (b *Builder) CVTTSS2SQ(reg Reg, rm RegMem) {
+	b.add("CVTTSS2SI", "CVTTSS2SQ", reg, rm)
+}
+
+// DECB_M appends DEC to the program with a 8-bit operand size. See the function DECB_M.
+func (b *Builder) DECB_M(rm RegMem) {
+	b.add("DEC", "DECB_M", rm)
+}
+
+// DECL_M appends DEC to the program with a 32-bit operand size. See the function DECL_M.
+func (b *Builder) DECL_M(rm RegMem) {
+	b.add("DEC", "DECL_M", rm)
+}
+
+// DECL_O appends DEC to the program with a 32-bit operand size. See the function DECL_O.
+func (b *Builder) DECL_O(opcode Reg) {
+	b.add("DEC", "DECL_O", opcode)
+}
+
+// DECQ_M appends DEC to the program with a 64-bit operand size. See the function DECQ_M.
+func (b *Builder) DECQ_M(rm RegMem) {
+	b.add("DEC", "DECQ_M", rm)
+}
+
+// DECW_M appends DEC to the program with a 16-bit operand size. See the function DECW_M.
+func (b *Builder) DECW_M(rm RegMem) {
+	b.add("DEC", "DECW_M", rm)
+}
+
+// DECW_O appends DEC to the program with a 16-bit operand size. See the function DECW_O.
+func (b *Builder) DECW_O(opcode Reg) {
+	b.add("DEC", "DECW_O", opcode)
+}
+
+// DIVB appends DIV to the program with a 8-bit operand size. See the function DIVB.
+func (b *Builder) DIVB(rm RegMem) {
+	b.add("DIV", "DIVB", rm)
+}
+
+// DIVL appends DIV to the program with a 32-bit operand size. See the function DIVL.
+func (b *Builder) DIVL(rm RegMem) {
+	b.add("DIV", "DIVL", rm)
+}
+
+// DIVQ appends DIV to the program with a 64-bit operand size. See the function DIVQ.
+func (b *Builder) DIVQ(rm RegMem) {
+	b.add("DIV", "DIVQ", rm)
+}
+
+// DIVW appends DIV to the program with a 16-bit operand size. See the function DIVW.
+func (b *Builder) DIVW(rm RegMem) {
+	b.add("DIV", "DIVW", rm)
+}
+
+// FADDD_M appends FADD to the program with a 64-bit operand size. See the function FADDD_M.
+func (b *Builder) FADDD_M(rm Mem) {
+	b.add("FADD", "FADDD_M", rm)
+}
+
+// FADDF_M appends FADD to the program with a 32-bit operand size. See the function FADDF_M.
+func (b *Builder) FADDF_M(rm Mem) {
+	b.add("FADD", "FADDF_M", rm)
+}
+
+// FCOMD_M appends FCOM to the program with a 64-bit operand size. See the function FCOMD_M.
+func (b *Builder) FCOMD_M(rm Mem) {
+	b.add("FCOM", "FCOMD_M", rm)
+}
+
+// FCOMFP_M appends FCOMP to the program with a 32-bit operand size. See the function FCOMFP_M.
+func (b *Builder) FCOMFP_M(rm Mem) {
+	b.add("FCOMP", "FCOMFP_M", rm)
+}
+
+// FCOMF_M appends FCOM to the program with a 32-bit operand size. See the function FCOMF_M.
+func (b *Builder) FCOMF_M(rm Mem) {
+	b.add("FCOM", "FCOMF_M", rm)
+}
+
+// FCOMPL_M appends FCOMP to the program with a 64-bit operand size. See the function FCOMPL_M.
+func (b *Builder) FCOMPL_M(rm Mem) {
+	b.add("FCOMP", "FCOMPL_M", rm)
+}
+
+// FDIVD_M appends FDIV to the program with a 64-bit operand size. See the function FDIVD_M.
+func (b *Builder) FDIVD_M(rm Mem) {
+	b.add("FDIV", "FDIVD_M", rm)
+}
+
+// FDIVFR_M appends FDIVR to the program with a 32-bit operand size. See the function FDIVFR_M.
+func (b *Builder) FDIVFR_M(rm Mem) {
+	b.add("FDIVR", "FDIVFR_M", rm)
+}
+
+// FDIVF_M appends FDIV to the program with a 32-bit operand size. See the function FDIVF_M.
+func (b *Builder) FDIVF_M(rm Mem) {
+	b.add("FDIV", "FDIVF_M", rm)
+}
+
+// FDIVRL_M appends FDIVR to the program with a 64-bit operand size. See the function FDIVRL_M.
+func (b *Builder) FDIVRL_M(rm Mem) {
+	b.add("FDIVR", "FDIVRL_M", rm)
+}
+
+// FILDL appends FILD to the program with a 32-bit operand size. See the function FILDL.
+func (b *Builder) FILDL(rm Mem) {
+	b.add("FILD", "FILDL", rm)
+}
+
+// FILDLL appends FILD to the program with a 64-bit operand size. See the function FILDLL.
+func (b *Builder) FILDLL(rm Mem) {
+	b.add("FILD", "FILDLL", rm)
+}
+
+// FISTPL appends FISTP to the program with a 32-bit operand size. See the function FISTPL.
+func (b *Builder) FISTPL(rm Mem) {
+	b.add("FISTP", "FISTPL", rm)
+}
+
+// FISTPLL appends FISTP to the program with a 64-bit operand size. See the function FISTPLL.
+func (b *Builder) FISTPLL(rm Mem) {
+	b.add("FISTP", "FISTPLL", rm)
+}
+
+// FISTTPL appends FISTTP to the program with a 32-bit operand size. See the function FISTTPL.
+func (b *Builder) FISTTPL(rm Mem) {
+	b.add("FISTTP", "FISTTPL", rm)
+}
+
+// FISTTPLL appends FISTTP to the program with a 64-bit operand size. See the function FISTTPLL.
+func (b *Builder) FISTTPLL(rm Mem) {
+	b.add("FISTTP", "FISTTPLL", rm)
+}
+
+// FLDL appends FLD to the program with a 64-bit operand size. See the function FLDL.
+func (b *Builder) FLDL(arg Operand) {
+	b.add("FLD", "FLDL", arg)
+}
+
+// FLDS appends FLD to the program with a 32-bit operand size. See the function FLDS.
+func (b *Builder) FLDS(arg Operand) {
+	b.add("FLD", "FLDS", arg)
+}
+
+// FLDT appends FLD to the program with a 80-bit operand size. See the function FLDT.
+func (b *Builder) FLDT(arg Operand) {
+	b.add("FLD", "FLDT", arg)
+}
+
+// FMULL_M appends FMUL to the program with a 64-bit operand size. See the function FMULL_M.
+func (b *Builder) FMULL_M(rm Mem) {
+	b.add("FMUL", "FMULL_M", rm)
+}
+
+// FMULS_M appends FMUL to the program with a 32-bit operand size. See the function FMULS_M.
+func (b *Builder) FMULS_M(rm Mem) {
+	b.add("FMUL", "FMULS_M", rm)
+}
+
+// FSTL appends FST to the program with a 64-bit operand size. See the function FSTL.
+func (b *Builder) FSTL(arg Operand) {
+	b.add("FST", "FSTL", arg)
+}
+
+// FSTPL appends FSTP to the program with a 64-bit operand size. See the function FSTPL.
+func (b *Builder) FSTPL(arg Operand) {
+	b.add("FSTP", "FSTPL", arg)
+}
+
+// FSTPS appends FSTP to the program with a 32-bit operand size. See the function FSTPS.
+func (b *Builder) FSTPS(arg Operand) {
+	b.add("FSTP", "FSTPS", arg)
+}
+
+// FSTPT appends FSTP to the program with a 80-bit operand size. See the function FSTPT.
+func (b *Builder) FSTPT(arg Operand) {
+	b.add("FSTP", "FSTPT", arg)
+}
+
+// FSTS appends FST to the program with a 32-bit operand size. See the function FSTS.
+func (b *Builder) FSTS(arg Operand) {
+	b.add("FST", "FSTS", arg)
+}
+
+// FSUBL_M appends FSUB to the program with a 64-bit operand size. See the function FSUBL_M.
+func (b *Builder) FSUBL_M(rm Mem) {
+	b.add("FSUB", "FSUBL_M", rm)
+}
+
+// FSUBRL_M appends FSUBR to the program with a 64-bit operand size. See the function FSUBRL_M.
+func (b *Builder) FSUBRL_M(rm Mem) {
+	b.add("FSUBR", "FSUBRL_M", rm)
+}
+
+// FSUBRS_M appends FSUBR to the program with a 32-bit operand size. See the function FSUBRS_M.
+func (b *Builder) FSUBRS_M(rm Mem) {
+	b.add("FSUBR", "FSUBRS_M", rm)
+}
+
+// FSUBS_M appends FSUB to the program with a 32-bit operand size. See the function FSUBS_M.
+func (b *Builder) FSUBS_M(rm Mem) {
+	b.add("FSUB", "FSUBS_M", rm)
+}
+
+// IDIVB appends IDIV to the program with a 8-bit operand size. See the function IDIVB.
+func (b *Builder) IDIVB(rm RegMem) {
+	b.add("IDIV", "IDIVB", rm)
+}
+
+// IDIVL appends IDIV to the program with a 32-bit operand size. See the function IDIVL.
+func (b *Builder) IDIVL(rm RegMem) {
+	b.add("IDIV", "IDIVL", rm)
+}
+
+// IDIVQ appends IDIV to the program with a 64-bit operand size. See the function IDIVQ.
+func (b *Builder) IDIVQ(rm RegMem) {
+	b.add("IDIV", "IDIVQ", rm)
+}
+
+// IDIVW appends IDIV to the program with a 16-bit operand size. See the function IDIVW.
+func (b *Builder) IDIVW(rm RegMem) {
+	b.add("IDIV", "IDIVW", rm)
+}
+
+// IMULB_M appends IMUL to the program with a 8-bit operand size. See the function IMULB_M.
+func (b *Builder) IMULB_M(rm RegMem) {
+	b.add("IMUL", "IMULB_M", rm)
+}
+
+// IMULL_M appends IMUL to the program with a 32-bit operand size. See the function IMULL_M.
+func (b *Builder) IMULL_M(rm RegMem) {
+	b.add("IMUL", "IMULL_M", rm)
+}
+
+// IMULL_RM appends IMUL to the program with a 32-bit operand size. See the function IMULL_RM.
+func (b *Builder) IMULL_RM(reg Reg, rm RegMem) {
+	b.add("IMUL", "IMULL_RM", reg, rm)
+}
+
+// IMULL_RMI appends IMUL to the program with a 32-bit operand size. See the function IMULL_RMI.
+func (b *Builder) IMULL_RMI(reg Reg, rm RegMem, imm Imm) {
+	b.add("IMUL", "IMULL_RMI", reg, rm, imm)
+}
+
+// IMULQ_M appends IMUL to the program with a 64-bit operand size. See the function IMULQ_M.
+func (b *Builder) IMULQ_M(rm RegMem) {
+	b.add("IMUL", "IMULQ_M", rm)
+}
+
+// IMULQ_RM appends IMUL to the program with a 64-bit operand size. See the function IMULQ_RM.
+func (b *Builder) IMULQ_RM(reg Reg, rm RegMem) {
+	b.add("IMUL", "IMULQ_RM", reg, rm)
+}
+
+// IMULQ_RMI appends IMUL to the program with a 64-bit operand size. See the function IMULQ_RMI.
+func (b *Builder) IMULQ_RMI(reg Reg, rm RegMem, imm Imm) {
+	b.add("IMUL", "IMULQ_RMI", reg, rm, imm)
+}
+
+// IMULW_M appends IMUL to the program with a 16-bit operand size. See the function IMULW_M.
+func (b *Builder) IMULW_M(rm RegMem) {
+	b.add("IMUL", "IMULW_M", rm)
+}
+
+// IMULW_RM appends IMUL to the program with a 16-bit operand size. See the function IMULW_RM.
+func (b *Builder) IMULW_RM(reg Reg, rm RegMem) {
+	b.add("IMUL", "IMULW_RM", reg, rm)
+}
+
+// IMULW_RMI appends IMUL to the program with a 16-bit operand size. See the function IMULW_RMI.
+func (b *Builder) IMULW_RMI(reg Reg, rm RegMem, imm Imm) {
+	b.add("IMUL", "IMULW_RMI", reg, rm, imm)
+}
+
+// INB_I appends IN to the program with a 8-bit operand size. See the function INB_I.
+func (b *Builder) INB_I(al Reg, imm Imm) {
+	b.add("IN", "INB_I", al, imm)
+}
+
+// INB_NP appends IN to the program with a 8-bit operand size. See the function INB_NP.
+func (b *Builder) INB_NP(al, dx Reg) {
+	b.add("IN", "INB_NP", al, dx)
+}
+
+// INCB_M appends INC to the program with a 8-bit operand size. See the function INCB_M.
+func (b *Builder) INCB_M(rm RegMem) {
+	b.add("INC", "INCB_M", rm)
+}
+
+// INCL_M appends INC to the program with a 32-bit operand size. See the function INCL_M.
+func (b *Builder) INCL_M(rm RegMem) {
+	b.add("INC", "INCL_M", rm)
+}
+
+// INCL_O appends INC to the program with a 32-bit operand size. See the function INCL_O.
+func (b *Builder) INCL_O(opcode Reg) {
+	b.add("INC", "INCL_O", opcode)
+}
+
+// INCQ_M appends INC to the program with a 64-bit operand size. See the function INCQ_M.
+func (b *Builder) INCQ_M(rm RegMem) {
+	b.add("INC", "INCQ_M", rm)
+}
+
+// INCW_M appends INC to the program with a 16-bit operand size. See the function INCW_M.
+func (b *Builder) INCW_M(rm RegMem) {
+	b.add("INC", "INCW_M", rm)
+}
+
+// INCW_O appends INC to the program with a 16-bit operand size. See the function INCW_O.
+func (b *Builder) INCW_O(opcode Reg) {
+	b.add("INC", "INCW_O", opcode)
+}
+
+// INL_I appends IN to the program with a 32-bit operand size. See the function INL_I.
+func (b *Builder) INL_I(al Reg, imm Imm) {
+	b.add("IN", "INL_I", al, imm)
+}
+
+// INL_NP appends IN to the program with a 32-bit operand size. See the function INL_NP.
+func (b *Builder) INL_NP(al, dx Reg) {
+	b.add("IN", "INL_NP", al, dx)
+}
+
+// INW_I appends IN to the program with a 16-bit operand size. See the function INW_I.
+func (b *Builder) INW_I(al Reg, imm Imm) {
+	b.add("IN", "INW_I", al, imm)
+}
+
+// INW_NP appends IN to the program with a 16-bit operand size. See the function INW_NP.
+func (b *Builder) INW_NP(al, dx Reg) {
+	b.add("IN", "INW_NP", al, dx)
+}
+
+// LARL appends LAR to the program with a 32-bit operand size. See the function LARL.
+func (b *Builder) LARL(reg Reg, rm RegMem) {
+	b.add("LAR", "LARL", reg, rm)
+}
+
+// LARQ appends LAR to the program with a 64-bit operand size. See the function LARQ.
+func (b *Builder) LARQ(reg Reg, rm RegMem) {
+	b.add("LAR", "LARQ", reg, rm)
+}
+
+// LARW appends LAR to the program with a 16-bit operand size. See the function LARW.
+func (b *Builder) LARW(reg Reg, rm RegMem) {
+	b.add("LAR", "LARW", reg, rm)
+}
+
+// LDSL appends LDS to the program with a 32-bit operand size. See the function LDSL.
+func (b *Builder) LDSL(reg Reg, rm Mem) {
+	b.add("LDS", "LDSL", reg, rm)
+}
+
+// LDSW appends LDS to the program with a 16-bit operand size. See the function LDSW.
+func (b *Builder) LDSW(reg Reg, rm Mem) {
+	b.add("LDS", "LDSW", reg, rm)
+}
+
+// LEAL appends LEA to the program with a 32-bit operand size. See the function LEAL.
+func (b *Builder) LEAL(reg Reg, rm Mem) {
+	b.add("LEA", "LEAL", reg, rm)
+}
+
+// LEAQ appends LEA to the program with a 64-bit operand size. See the function LEAQ.
+func (b *Builder) LEAQ(reg Reg, rm Mem) {
+	b.add("LEA", "LEAQ", reg, rm)
+}
+
+// LEAW appends LEA to the program with a 16-bit operand size. See the function LEAW.
+func (b *Builder) LEAW(reg Reg, rm Mem) {
+	b.add("LEA", "LEAW", reg, rm)
+}
+
+// LESL appends LES to the program with a 32-bit operand size. See the function LESL.
+func (b *Builder) LESL(reg Reg, rm Mem) {
+	b.add("LES", "LESL", reg, rm)
+}
+
+// LESW appends LES to the program with a 16-bit operand size. See the function LESW.
+func (b *Builder) LESW(reg Reg, rm Mem) {
+	b.add("LES", "LESW", reg, rm)
+}
+
+// LFSL appends LFS to the program with a 32-bit operand size. See the function LFSL.
+func (b *Builder) LFSL(reg Reg, rm Mem) {
+	b.add("LFS", "LFSL", reg, rm)
+}
+
+// LFSQ appends LFS to the program with a 64-bit operand size. See the function LFSQ.
+func (b *Builder) LFSQ(reg Reg, rm Mem) {
+	b.add("LFS", "LFSQ", reg, rm)
+}
+
+// LFSW appends LFS to the program with a 16-bit operand size. See the function LFSW.
+func (b *Builder) LFSW(reg Reg, rm Mem) {
+	b.add("LFS", "LFSW", reg, rm)
+}
+
+// LGSL appends LGS to the program with a 32-bit operand size. See the function LGSL.
+func (b *Builder) LGSL(reg Reg, rm Mem) {
+	b.add("LGS", "LGSL", reg, rm)
+}
+
+// LGSQ appends LGS to the program with a 64-bit operand size. See the function LGSQ.
+func (b *Builder) LGSQ(reg Reg, rm Mem) {
+	b.add("LGS", "LGSQ", reg, rm)
+}
+
+// LGSW appends LGS to the program with a 16-bit operand size. See the function LGSW.
+func (b *Builder) LGSW(reg Reg, rm Mem) {
+	b.add("LGS", "LGSW", reg, rm)
+}
+
+// LSLL appends LSL to the program with a 32-bit operand size. See the function LSLL.
+func (b *Builder) LSLL(reg Reg, rm RegMem) {
+	b.add("LSL", "LSLL", reg, rm)
+}
+
+// LSLQ appends LSL to the program with a 64-bit operand size. See the function LSLQ.
+func (b *Builder) LSLQ(reg Reg, rm RegMem) {
+	b.add("LSL", "LSLQ", reg, rm)
+}
+
+// LSLW appends LSL to the program with a 16-bit operand size. See the function LSLW.
+func (b *Builder) LSLW(reg Reg, rm RegMem) {
+	b.add("LSL", "LSLW", reg, rm)
+}
+
+// LSSL appends LSS to the program with a 32-bit operand size. See the function LSSL.
+func (b *Builder) LSSL(reg Reg, rm Mem) {
+	b.add("LSS", "LSSL", reg, rm)
+}
+
+// LSSQ appends LSS to the program with a 64-bit operand size. See the function LSSQ.
+func (b *Builder) LSSQ(reg Reg, rm Mem) {
+	b.add("LSS", "LSSQ", reg, rm)
+}
+
+// LSSW appends LSS to the program with a 16-bit operand size. See the function LSSW.
+func (b *Builder) LSSW(reg Reg, rm Mem) {
+	b.add("LSS", "LSSW", reg, rm)
+}
+
+// LZCNTL appends LZCNT to the program with a 32-bit operand size. See the function LZCNTL.
+func (b *Builder) LZCNTL(reg Reg, rm RegMem) {
+	b.add("LZCNT", "LZCNTL", reg, rm)
+}
+
+// LZCNTQ appends LZCNT to the program with a 64-bit operand size. See the function LZCNTQ.
+func (b *Builder) LZCNTQ(reg Reg, rm RegMem) {
+	b.add("LZCNT", "LZCNTQ", reg, rm)
+}
+
+// LZCNTW appends LZCNT to the program with a 16-bit operand size. See the function LZCNTW.
+func (b *Builder) LZCNTW(reg Reg, rm RegMem) {
+	b.add("LZCNT", "LZCNTW", reg, rm)
+}
+
+// MOVBELL_MR appends MOVBE to the program with a 32-bit operand size. See the function MOVBELL_MR.
+func (b *Builder) MOVBELL_MR(rm Mem, reg Reg) {
+	b.add("MOVBE", "MOVBELL_MR", rm, reg)
+}
+
+// MOVBELL_RM appends MOVBE to the program with a 32-bit operand size. See the function MOVBELL_RM.
+func (b *Builder) MOVBELL_RM(reg Reg, rm Mem) {
+	b.add("MOVBE", "MOVBELL_RM", reg, rm)
+}
+
+// MOVBEQQ_MR appends MOVBE to the program with a 64-bit operand size. See the function MOVBEQQ_MR.
+func (b *Builder) MOVBEQQ_MR(rm Mem, reg Reg) {
+	b.add("MOVBE", "MOVBEQQ_MR", rm, reg)
+}
+
+// MOVBEQQ_RM appends MOVBE to the program with a 64-bit operand size. See the function MOVBEQQ_RM.
+func (b *Builder) MOVBEQQ_RM(reg Reg, rm Mem) {
+	b.add("MOVBE", "MOVBEQQ_RM", reg, rm)
+}
+
+// MOVBEWW_MR appends MOVBE to the program with a 16-bit operand size. See the function MOVBEWW_MR.
+func (b *Builder) MOVBEWW_MR(rm Mem, reg Reg) {
+	b.add("MOVBE", "MOVBEWW_MR", rm, reg)
+}
+
+// MOVBEWW_RM appends MOVBE to the program with a 16-bit operand size. See the function MOVBEWW_RM.
+func (b *Builder) MOVBEWW_RM(reg Reg, rm Mem) {
+	b.add("MOVBE", "MOVBEWW_RM", reg, rm)
+}
+
+// MOVB_MI appends MOV to the program with a 8-bit operand size. See the function MOVB_MI.
+func (b *Builder) MOVB_MI(rm RegMem, imm Imm) {
+	b.add("MOV", "MOVB_MI", rm, imm)
+}
+
+// MOVB_MR appends MOV to the program with a 8-bit operand size. See the function MOVB_MR.
+func (b *Builder) MOVB_MR(rm RegMem, reg Register) {
+	b.add("MOV", "MOVB_MR", rm, reg)
+}
+
+// MOVB_OI appends MOV to the program with a 8-bit operand size. See the function MOVB_OI.
+func (b *Builder) MOVB_OI(opcode Reg, imm Imm) {
+	b.add("MOV", "MOVB_OI", opcode, imm)
+}
+
+// MOVB_RM appends MOV to the program with a 8-bit operand size. See the function MOVB_RM.
+func (b *Builder) MOVB_RM(arg Register, rm RegMem) {
+	b.add("MOV", "MOVB_RM", arg, rm)
+}
+
+// MOVLQSX appends MOVSXD to the program with a 32-bit operand size. See the function MOVLQSX.
+func (b *Builder) MOVLQSX(reg Reg, rm RegMem) {
+	b.add("MOVSXD", "MOVLQSX", reg, rm)
+}
+
+// MOVL_FD appends MOV to the program with a 32-bit operand size. See the function MOVL_FD.
+func (b *Builder) MOVL_FD(arg Reg, arg2 Operand) {
+	b.add("MOV", "MOVL_FD", arg, arg2)
+}
+
+// MOVL_MI appends MOV to the program with a 32-bit operand size. See the function MOVL_MI.
+func (b *Builder) MOVL_MI(rm RegMem, imm Imm) {
+	b.add("MOV", "MOVL_MI", rm, imm)
+}
+
+// MOVL_MR appends MOV to the program with a 32-bit operand size. See the function MOVL_MR.
+func (b *Builder) MOVL_MR(rm RegMem, reg Register) {
+	b.add("MOV", "MOVL_MR", rm, reg)
+}
+
+// MOVL_OI appends MOV to the program with a 32-bit operand size. See the function MOVL_OI.
+func (b *Builder) MOVL_OI(opcode Reg, imm Imm) {
+	b.add("MOV", "MOVL_OI", opcode, imm)
+}
+
+// MOVL_RM appends MOV to the program with a 32-bit operand size. See the function MOVL_RM.
+func (b *Builder) MOVL_RM(arg Register, rm RegMem) {
+	b.add("MOV", "MOVL_RM", arg, rm)
+}
+
+// MOVL_TD appends MOV to the program with a 32-bit operand size. See the function MOVL_TD.
+func (b *Builder) MOVL_TD(moffs Mem, ax Reg) {
+	b.add("MOV", "MOVL_TD", moffs, ax)
+}
+
+// MOVNTIL appends MOVNTI to the program with a 32-bit operand size. See the function MOVNTIL.
+func (b *Builder) MOVNTIL(rm Mem, reg Reg) {
+	b.add("MOVNTI", "MOVNTIL", rm, reg)
+}
+
+// MOVNTIQ appends MOVNTI to the program with a 64-bit operand size. See the function MOVNTIQ.
+func (b *Builder) MOVNTIQ(rm Mem, reg Reg) {
+	b.add("MOVNTI", "MOVNTIQ", rm, reg)
+}
+
+// MOVQ_FD appends MOV to the program with a 64-bit operand size. See the function MOVQ_FD.
+func (b *Builder) MOVQ_FD(arg Reg, arg2 Operand) {
+	b.add("MOV", "MOVQ_FD", arg, arg2)
+}
+
+// MOVQ_MI appends MOV to the program with a 64-bit operand size. See the function MOVQ_MI.
+func (b *Builder) MOVQ_MI(rm RegMem, imm Imm) {
+	b.add("MOV", "MOVQ_MI", rm, imm)
+}
+
+// MOVQ_OI appends MOV to the program with a 64-bit operand size. See the function MOVQ_OI.
+func (b *Builder) MOVQ_OI(opcode Reg, imm Imm) {
+	b.add("MOV", "MOVQ_OI", opcode, imm)
+}
+
+// MOVQ_TD appends MOV to the program with a 64-bit operand size. See the function MOVQ_TD.
+func (b *Builder) MOVQ_TD(moffs Mem, ax Reg) {
+	b.add("MOV", "MOVQ_TD", moffs, ax)
+}
+
+// MOVWQSX appends MOVSXD to the program with a 16-bit operand size. See the function MOVWQSX.
+func (b *Builder) MOVWQSX(reg Reg, rm RegMem) {
+	b.add("MOVSXD", "MOVWQSX", reg, rm)
+}
+
+// MOVW_FD appends MOV to the program with a 16-bit operand size. See the function MOVW_FD.
+func (b *Builder) MOVW_FD(arg Reg, arg2 Operand) {
+	b.add("MOV", "MOVW_FD", arg, arg2)
+}
+
+// MOVW_MI appends MOV to the program with a 16-bit operand size. See the function MOVW_MI.
+func (b *Builder) MOVW_MI(rm RegMem, imm Imm) {
+	b.add("MOV", "MOVW_MI", rm, imm)
+}
+
+// MOVW_MR appends MOV to the program with a 16-bit operand size. See the function MOVW_MR.
+func (b *Builder) MOVW_MR(rm RegMem, reg Register) {
+	b.add("MOV", "MOVW_MR", rm, reg)
+}
+
+// MOVW_OI appends MOV to the program with a 16-bit operand size. See the function MOVW_OI.
+func (b *Builder) MOVW_OI(opcode Reg, imm Imm) {
+	b.add("MOV", "MOVW_OI", opcode, imm)
+}
+
+// MOVW_RM appends MOV to the program with a 16-bit operand size. See the function MOVW_RM.
+func (b *Builder) MOVW_RM(arg Register, rm RegMem) {
+	b.add("MOV", "MOVW_RM", arg, rm)
+}
+
+// MOVW_TD appends MOV to the program with a 16-bit operand size. See the function MOVW_TD.
+func (b *Builder) MOVW_TD(moffs Mem, ax Reg) {
+	b.add("MOV", "MOVW_TD", moffs, ax)
+}
+
+// MULB appends MUL to the program with a 8-bit operand size. See the function MULB.
+func (b *Builder) MULB(rm RegMem) {
+	b.add("MUL", "MULB", rm)
+}
+
+// MULL appends MUL to the program with a 32-bit operand size. See the function MULL.
+func (b *Builder) MULL(rm RegMem) {
+	b.add("MUL", "MULL", rm)
+}
+
+// MULQ appends MUL to the program with a 64-bit operand size. See the function MULQ.
+func (b *Builder) MULQ(rm RegMem) {
+	b.add("MUL", "MULQ", rm)
+}
+
+// MULW appends MUL to the program with a 16-bit operand size. See the function MULW.
+func (b *Builder) MULW(rm RegMem) {
+	b.add("MUL", "MULW", rm)
+}
+
+// MULXL appends MULX to the program with a 32-bit operand size. See the function MULXL.
+func (b *Builder) MULXL(reg, vvvv Reg, rm RegMem) {
+	b.add("MULX", "MULXL", reg, vvvv, rm)
+}
+
+// MULXQ appends MULX to the program with a 64-bit operand size. See the function MULXQ.
+func (b *Builder) MULXQ(reg, vvvv Reg, rm RegMem) {
+	b.add("MULX", "MULXQ", reg, vvvv, rm)
+}
+
+// NEGB appends NEG to the program with a 8-bit operand size. See the function NEGB.
+func (b *Builder) NEGB(rm RegMem) {
+	b.add("NEG", "NEGB", rm)
+}
+
+// NEGL appends NEG to the program with a 32-bit operand size. See the function NEGL.
+func (b *Builder) NEGL(rm RegMem) {
+	b.add("NEG", "NEGL", rm)
+}
+
+// NEGQ appends NEG to the program with a 64-bit operand size. See the function NEGQ.
+func (b *Builder) NEGQ(rm RegMem) {
+	b.add("NEG", "NEGQ", rm)
+}
+
+// NEGW appends NEG to the program with a 16-bit operand size. See the function NEGW.
+func (b *Builder) NEGW(rm RegMem) {
+	b.add("NEG", "NEGW", rm)
+}
+
+// NOPL_M appends NOP to the program with a 32-bit operand size. See the function NOPL_M.
+func (b *Builder) NOPL_M(rm RegMem) {
+	b.add("NOP", "NOPL_M", rm)
+}
+
+// NOPW_M appends NOP to the program with a 16-bit operand size. See the function NOPW_M.
+func (b *Builder) NOPW_M(rm RegMem) {
+	b.add("NOP", "NOPW_M", rm)
+}
+
+// NOTB appends NOT to the program with a 8-bit operand size. See the function NOTB.
+func (b *Builder) NOTB(rm RegMem) {
+	b.add("NOT", "NOTB", rm)
+}
+
+// NOTL appends NOT to the program with a 32-bit operand size. See the function NOTL.
+func (b *Builder) NOTL(rm RegMem) {
+	b.add("NOT", "NOTL", rm)
+}
+
+// NOTQ appends NOT to the program with a 64-bit operand size. See the function NOTQ.
+func (b *Builder) NOTQ(rm RegMem) {
+	b.add("NOT", "NOTQ", rm)
+}
+
+// NOTW appends NOT to the program with a 16-bit operand size. See the function NOTW.
+func (b *Builder) NOTW(rm RegMem) {
+	b.add("NOT", "NOTW", rm)
+}
+
+// ORB_I appends OR to the program with a 8-bit operand size. See the function ORB_I.
+func (b *Builder) ORB_I(al Reg, imm Imm) {
+	b.add("OR", "ORB_I", al, imm)
+}
+
+// ORB_MI appends OR to the program with a 8-bit operand size. See the function ORB_MI.
+func (b *Builder) ORB_MI(rm RegMem, imm Imm) {
+	b.add("OR", "ORB_MI", rm, imm)
+}
+
+// ORB_MR appends OR to the program with a 8-bit operand size. See the function ORB_MR.
+func (b *Builder) ORB_MR(rm RegMem, reg Reg) {
+	b.add("OR", "ORB_MR", rm, reg)
+}
+
+// ORB_RM appends OR to the program with a 8-bit operand size. See the function ORB_RM.
+func (b *Builder) ORB_RM(reg Reg, rm RegMem) {
+	b.add("OR", "ORB_RM", reg, rm)
+}
+
+// ORL_I appends OR to the program with a 32-bit operand size. See the function ORL_I.
+func (b *Builder) ORL_I(al Reg, imm Imm) {
+	b.add("OR", "ORL_I", al, imm)
+}
+
+// ORL_MI appends OR to the program with a 32-bit operand size. See the function ORL_MI.
+func (b *Builder) ORL_MI(rm RegMem, imm Imm) {
+	b.add("OR", "ORL_MI", rm, imm)
+}
+
+// ORL_MR appends OR to the program with a 32-bit operand size. See the function ORL_MR.
+func (b *Builder) ORL_MR(rm RegMem, reg Reg) {
+	b.add("OR", "ORL_MR", rm, reg)
+}
+
+// ORL_RM appends OR to the program with a 32-bit operand size. See the function ORL_RM.
+func (b *Builder) ORL_RM(reg Reg, rm RegMem) {
+	b.add("OR", "ORL_RM", reg, rm)
+}
+
+// ORQ_I appends OR to the program with a 64-bit operand size. See the function ORQ_I.
+func (b *Builder) ORQ_I(al Reg, imm Imm) {
+	b.add("OR", "ORQ_I", al, imm)
+}
+
+// ORQ_MI appends OR to the program with a 64-bit operand size. See the function ORQ_MI.
+func (b *Builder) ORQ_MI(rm RegMem, imm Imm) {
+	b.add("OR", "ORQ_MI", rm, imm)
+}
+
+// ORQ_MR appends OR to the program with a 64-bit operand size. See the function ORQ_MR.
+func (b *Builder) ORQ_MR(rm RegMem, reg Reg) {
+	b.add("OR", "ORQ_MR", rm, reg)
+}
+
+// ORQ_RM appends OR to the program with a 64-bit operand size. See the function ORQ_RM.
+func (b *Builder) ORQ_RM(reg Reg, rm RegMem) {
+	b.add("OR", "ORQ_RM", reg, rm)
+}
+
+// ORW_I appends OR to the program with a 16-bit operand size. See the function ORW_I.
+func (b *Builder) ORW_I(al Reg, imm Imm) {
+	b.add("OR", "ORW_I", al, imm)
+}
+
+// ORW_MI appends OR to the program with a 16-bit operand size. See the function ORW_MI.
+func (b *Builder) ORW_MI(rm RegMem, imm Imm) {
+	b.add("OR", "ORW_MI", rm, imm)
+}
+
+// ORW_MR appends OR to the program with a 16-bit operand size. See the function ORW_MR.
+func (b *Builder) ORW_MR(rm RegMem, reg Reg) {
+	b.add("OR", "ORW_MR", rm, reg)
+}
+
+// ORW_RM appends OR to the program with a 16-bit operand size. See the function ORW_RM.
+func (b *Builder) ORW_RM(reg Reg, rm RegMem) {
+	b.add("OR", "ORW_RM", reg, rm)
+}
+
+// OUTB_I appends OUT to the program with a 8-bit operand size. See the function OUTB_I.
+func (b *Builder) OUTB_I(imm Imm, al Reg) {
+	b.add("OUT", "OUTB_I", imm, al)
+}
+
+// OUTB_NP appends OUT to the program with a 8-bit operand size. See the function OUTB_NP.
+func (b *Builder) OUTB_NP(dx, al Reg) {
+	b.add("OUT", "OUTB_NP", dx, al)
+}
+
+// OUTL_I appends OUT to the program with a 32-bit operand size. See the function OUTL_I.
+func (b *Builder) OUTL_I(imm Imm, al Reg) {
+	b.add("OUT", "OUTL_I", imm, al)
+}
+
+// OUTL_NP appends OUT to the program with a 32-bit operand size. See the function OUTL_NP.
+func (b *Builder) OUTL_NP(dx, al Reg) {
+	b.add("OUT", "OUTL_NP", dx, al)
+}
+
+// OUTW_I appends OUT to the program with a 16-bit operand size. See the function OUTW_I.
+func (b *Builder) OUTW_I(imm Imm, al Reg) {
+	b.add("OUT", "OUTW_I", imm, al)
+}
+
+// OUTW_NP appends OUT to the program with a 16-bit operand size. See the function OUTW_NP.
+func (b *Builder) OUTW_NP(dx, al Reg) {
+	b.add("OUT", "OUTW_NP", dx, al)
+}
+
+// PDEPL appends PDEP to the program with a 32-bit operand size. See the function PDEPL.
+func (b *Builder) PDEPL(reg, vvvv Reg, rm RegMem) {
+	b.add("PDEP", "PDEPL", reg, vvvv, rm)
+}
+
+// PDEPQ appends PDEP to the program with a 64-bit operand size. See the function PDEPQ.
+func (b *Builder) PDEPQ(reg, vvvv Reg, rm RegMem) {
+	b.add("PDEP", "PDEPQ", reg, vvvv, rm)
+}
+
+// PEXTL appends PEXT to the program with a 32-bit operand size. See the function PEXTL.
+func (b *Builder) PEXTL(reg, vvvv Reg, rm RegMem) {
+	b.add("PEXT", "PEXTL", reg, vvvv, rm)
+}
+
+// PEXTQ appends PEXT to the program with a 64-bit operand size. See the function PEXTQ.
+func (b *Builder) PEXTQ(reg, vvvv Reg, rm RegMem) {
+	b.add("PEXT", "PEXTQ", reg, vvvv, rm)
+}
+
+// POPCNTL appends POPCNT to the program with a 32-bit operand size. See the function POPCNTL.
+func (b *Builder) POPCNTL(reg Reg, rm RegMem) {
+	b.add("POPCNT", "POPCNTL", reg, rm)
+}
+
+// POPCNTQ appends POPCNT to the program with a 64-bit operand size. See the function POPCNTQ.
+func (b *Builder) POPCNTQ(reg Reg, rm RegMem) {
+	b.add("POPCNT", "POPCNTQ", reg, rm)
+}
+
+// POPCNTW appends POPCNT to the program with a 16-bit operand size. See the function POPCNTW.
+func (b *Builder) POPCNTW(reg Reg, rm RegMem) {
+	b.add("POPCNT", "POPCNTW", reg, rm)
+}
+
+// POPL_M appends POP to the program with a 32-bit operand size. See the function POPL_M.
+func (b *Builder) POPL_M(rm RegMem) {
+	b.add("POP", "POPL_M", rm)
+}
+
+// POPL_O appends POP to the program with a 32-bit operand size. See the function POPL_O.
+func (b *Builder) POPL_O(opcode Reg) {
+	b.add("POP", "POPL_O", opcode)
+}
+
+// POPQ_M appends POP to the program with a 64-bit operand size. See the function POPQ_M.
+func (b *Builder) POPQ_M(rm RegMem) {
+	b.add("POP", "POPQ_M", rm)
+}
+
+// POPQ_O appends POP to the program with a 64-bit operand size. See the function POPQ_O.
+func (b *Builder) POPQ_O(opcode Reg) {
+	b.add("POP", "POPQ_O", opcode)
+}
+
+// POPW_M appends POP to the program with a 16-bit operand size. See the function POPW_M.
+func (b *Builder) POPW_M(rm RegMem) {
+	b.add("POP", "POPW_M", rm)
+}
+
+// POPW_O appends POP to the program with a 16-bit operand size. See the function POPW_O.
+func (b *Builder) POPW_O(opcode Reg) {
+	b.add("POP", "POPW_O", opcode)
+}
+
+// PUSHL_M appends PUSH to the program with a 32-bit operand size. See the function PUSHL_M.
+func (b *Builder) PUSHL_M(rm RegMem) {
+	b.add("PUSH", "PUSHL_M", rm)
+}
+
+// PUSHL_O appends PUSH to the program with a 32-bit operand size. See the function PUSHL_O.
+func (b *Builder) PUSHL_O(opcode Reg) {
+	b.add("PUSH", "PUSHL_O", opcode)
+}
+
+// PUSHQ_M appends PUSH to the program with a 64-bit operand size. See the function PUSHQ_M.
+func (b *Builder) PUSHQ_M(rm RegMem) {
+	b.add("PUSH", "PUSHQ_M", rm)
+}
+
+// PUSHQ_O appends PUSH to the program with a 64-bit operand size. See the function PUSHQ_O.
+func (b *Builder) PUSHQ_O(opcode Reg) {
+	b.add("PUSH", "PUSHQ_O", opcode)
+}
+
+// PUSHW_M appends PUSH to the program with a 16-bit operand size. See the function PUSHW_M.
+func (b *Builder) PUSHW_M(rm RegMem) {
+	b.add("PUSH", "PUSHW_M", rm)
+}
+
+// PUSHW_O appends PUSH to the program with a 16-bit operand size. See the function PUSHW_O.
+func (b *Builder) PUSHW_O(opcode Reg) {
+	b.add("PUSH", "PUSHW_O", opcode)
+}
+
+// RCLB_M1 appends RCL to the program with a 8-bit operand size. See the function RCLB_M1.
+func (b *Builder) RCLB_M1(rm RegMem, v1 Imm) {
+	b.add("RCL", "RCLB_M1", rm, v1)
+}
+
+// RCLB_MC appends RCL to the program with a 8-bit operand size. See the function RCLB_MC.
+func (b *Builder) RCLB_MC(rm RegMem, cl Reg) {
+	b.add("RCL", "RCLB_MC", rm, cl)
+}
+
+// RCLB_MI appends RCL to the program with a 8-bit operand size. See the function RCLB_MI.
+func (b *Builder) RCLB_MI(rm RegMem, imm Imm) {
+	b.add("RCL", "RCLB_MI", rm, imm)
+}
+
+// RCLL_M1 appends RCL to the program with a 32-bit operand size. See the function RCLL_M1.
+func (b *Builder) RCLL_M1(rm RegMem, v1 Imm) {
+	b.add("RCL", "RCLL_M1", rm, v1)
+}
+
+// RCLL_MC appends RCL to the program with a 32-bit operand size. See the function RCLL_MC.
+func (b *Builder) RCLL_MC(rm RegMem, cl Reg) {
+	b.add("RCL", "RCLL_MC", rm, cl)
+}
+
+// RCLL_MI appends RCL to the program with a 32-bit operand size. See the function RCLL_MI.
+func (b *Builder) RCLL_MI(rm RegMem, imm Imm) {
+	b.add("RCL", "RCLL_MI", rm, imm)
+}
+
+// RCLQ_M1 appends RCL to the program with a 64-bit operand size. See the function RCLQ_M1.
+func (b *Builder) RCLQ_M1(rm RegMem, v1 Imm) {
+	b.add("RCL", "RCLQ_M1", rm, v1)
+}
+
+// RCLQ_MC appends RCL to the program with a 64-bit operand size. See the function RCLQ_MC.
+func (b *Builder) RCLQ_MC(rm RegMem, cl Reg) {
+	b.add("RCL", "RCLQ_MC", rm, cl)
+}
+
+// RCLQ_MI appends RCL to the program with a 64-bit operand size. See the function RCLQ_MI.
+func (b *Builder) RCLQ_MI(rm RegMem, imm Imm) {
+	b.add("RCL", "RCLQ_MI", rm, imm)
+}
+
+// RCLW_M1 appends RCL to the program with a 16-bit operand size. See the function RCLW_M1.
+func (b *Builder) RCLW_M1(rm RegMem, v1 Imm) {
+	b.add("RCL", "RCLW_M1", rm, v1)
+}
+
+// RCLW_MC appends RCL to the program with a 16-bit operand size. See the function RCLW_MC.
+func (b *Builder) RCLW_MC(rm RegMem, cl Reg) {
+	b.add("RCL", "RCLW_MC", rm, cl)
+}
+
+// RCLW_MI appends RCL to the program with a 16-bit operand size. See the function RCLW_MI.
+func (b *Builder) RCLW_MI(rm RegMem, imm Imm) {
+	b.add("RCL", "RCLW_MI", rm, imm)
+}
+
+// RCRB_M1 appends RCR to the program with a 8-bit operand size. See the function RCRB_M1.
+func (b *Builder) RCRB_M1(rm RegMem, v1 Imm) {
+	b.add("RCR", "RCRB_M1", rm, v1)
+}
+
+// RCRB_MC appends RCR to the program with a 8-bit operand size. See the function RCRB_MC.
+func (b *Builder) RCRB_MC(rm RegMem, cl Reg) {
+	b.add("RCR", "RCRB_MC", rm, cl)
+}
+
+// RCRB_MI appends RCR to the program with a 8-bit operand size. See the function RCRB_MI.
+func (b *Builder) RCRB_MI(rm RegMem, imm Imm) {
+	b.add("RCR", "RCRB_MI", rm, imm)
+}
+
+// RCRL_M1 appends RCR to the program with a 32-bit operand size. See the function RCRL_M1.
+func (b *Builder) RCRL_M1(rm RegMem, v1 Imm) {
+	b.add("RCR", "RCRL_M1", rm, v1)
+}
+
+// RCRL_MC appends RCR to the program with a 32-bit operand size. See the function RCRL_MC.
+func (b *Builder) RCRL_MC(rm RegMem, cl Reg) {
+	b.add("RCR", "RCRL_MC", rm, cl)
+}
+
+// RCRL_MI appends RCR to the program with a 32-bit operand size. See the function RCRL_MI.
+func (b *Builder) RCRL_MI(rm RegMem, imm Imm) {
+	b.add("RCR", "RCRL_MI", rm, imm)
+}
+
+// RCRQ_M1 appends RCR to the program with a 64-bit operand size. See the function RCRQ_M1.
+func (b *Builder) RCRQ_M1(rm RegMem, v1 Imm) {
+	b.add("RCR", "RCRQ_M1", rm, v1)
+}
+
+// RCRQ_MC appends RCR to the program with a 64-bit operand size. See the function RCRQ_MC.
+func (b *Builder) RCRQ_MC(rm RegMem, cl Reg) {
+	b.add("RCR", "RCRQ_MC", rm, cl)
+}
+
+// RCRQ_MI appends RCR to the program with a 64-bit operand size. See the function RCRQ_MI.
+func (b *Builder) RCRQ_MI(rm RegMem, imm Imm) {
+	b.add("RCR", "RCRQ_MI", rm, imm)
+}
+
+// RCRW_M1 appends RCR to the program with a 16-bit operand size. See the function RCRW_M1.
+func (b *Builder) RCRW_M1(rm RegMem, v1 Imm) {
+	b.add("RCR", "RCRW_M1", rm, v1)
+}
+
+// RCRW_MC appends RCR to the program with a 16-bit operand size. See the function RCRW_MC.
+func (b *Builder) RCRW_MC(rm RegMem, cl Reg) {
+	b.add("RCR", "RCRW_MC", rm, cl)
+}
+
+// RCRW_MI appends RCR to the program with a 16-bit operand size. See the function RCRW_MI.
+func (b *Builder) RCRW_MI(rm RegMem, imm Imm) {
+	b.add("RCR", "RCRW_MI", rm, imm)
+}
+
+// ROLB_M1 appends ROL to the program with a 8-bit operand size. See the function ROLB_M1.
+func (b *Builder) ROLB_M1(rm RegMem, v1 Imm) {
+	b.add("ROL", "ROLB_M1", rm, v1)
+}
+
+// ROLB_MC appends ROL to the program with a 8-bit operand size. See the function ROLB_MC.
+func (b *Builder) ROLB_MC(rm RegMem, cl Reg) {
+	b.add("ROL", "ROLB_MC", rm, cl)
+}
+
+// ROLB_MI appends ROL to the program with a 8-bit operand size. See the function ROLB_MI.
+func (b *Builder) ROLB_MI(rm RegMem, imm Imm) {
+	b.add("ROL", "ROLB_MI", rm, imm)
+}
+
+// ROLL_M1 appends ROL to the program with a 32-bit operand size. See the function ROLL_M1.
+func (b *Builder) ROLL_M1(rm RegMem, v1 Imm) {
+	b.add("ROL", "ROLL_M1", rm, v1)
+}
+
+// ROLL_MC appends ROL to the program with a 32-bit operand size. See the function ROLL_MC.
+func (b *Builder) ROLL_MC(rm RegMem, cl Reg) {
+	b.add("ROL", "ROLL_MC", rm, cl)
+}
+
+// ROLL_MI appends ROL to the program with a 32-bit operand size. See the function ROLL_MI.
+func (b *Builder) ROLL_MI(rm RegMem, imm Imm) {
+	b.add("ROL", "ROLL_MI", rm, imm)
+}
+
+// ROLQ_M1 appends ROL to the program with a 64-bit operand size. See the function ROLQ_M1.
+func (b *Builder) ROLQ_M1(rm RegMem, v1 Imm) {
+	b.add("ROL", "ROLQ_M1", rm, v1)
+}
+
+// ROLQ_MC appends ROL to the program with a 64-bit operand size. See the function ROLQ_MC.
+func (b *Builder) ROLQ_MC(rm RegMem, cl Reg) {
+	b.add("ROL", "ROLQ_MC", rm, cl)
+}
+
+// ROLQ_MI appends ROL to the program with a 64-bit operand size. See the function ROLQ_MI.
+func (b *Builder) ROLQ_MI(rm RegMem, imm Imm) {
+	b.add("ROL", "ROLQ_MI", rm, imm)
+}
+
+// ROLW_M1 appends ROL to the program with a 16-bit operand size. See the function ROLW_M1.
+func (b *Builder) ROLW_M1(rm RegMem, v1 Imm) {
+	b.add("ROL", "ROLW_M1", rm, v1)
+}
+
+// ROLW_MC appends ROL to the program with a 16-bit operand size. See the function ROLW_MC.
+func (b *Builder) ROLW_MC(rm RegMem, cl Reg) {
+	b.add("ROL", "ROLW_MC", rm, cl)
+}
+
+// ROLW_MI appends ROL to the program with a 16-bit operand size. See the function ROLW_MI.
+func (b *Builder) ROLW_MI(rm RegMem, imm Imm) {
+	b.add("ROL", "ROLW_MI", rm, imm)
+}
+
+// RORB_M1 appends ROR to the program with a 8-bit operand size. See the function RORB_M1.
+func (b *Builder) RORB_M1(rm RegMem, v1 Imm) {
+	b.add("ROR", "RORB_M1", rm, v1)
+}
+
+// RORB_MC appends ROR to the program with a 8-bit operand size. See the function RORB_MC.
+func (b *Builder) RORB_MC(rm RegMem, cl Reg) {
+	b.add("ROR", "RORB_MC", rm, cl)
+}
+
+// RORB_MI appends ROR to the program with a 8-bit operand size. See the function RORB_MI.
+func (b *Builder) RORB_MI(rm RegMem, imm Imm) {
+	b.add("ROR", "RORB_MI", rm, imm)
+}
+
+// RORL_M1 appends ROR to the program with a 32-bit operand size. See the function RORL_M1.
+func (b *Builder) RORL_M1(rm RegMem, v1 Imm) {
+	b.add("ROR", "RORL_M1", rm, v1)
+}
+
+// RORL_MC appends ROR to the program with a 32-bit operand size. See the function RORL_MC.
+func (b *Builder) RORL_MC(rm RegMem, cl Reg) {
+	b.add("ROR", "RORL_MC", rm, cl)
+}
+
+// RORL_MI appends ROR to the program with a 32-bit operand size. See the function RORL_MI.
+func (b *Builder) RORL_MI(rm RegMem, imm Imm) {
+	b.add("ROR", "RORL_MI", rm, imm)
+}
+
+// RORQ_M1 appends ROR to the program with a 64-bit operand size. See the function RORQ_M1.
+func (b *Builder) RORQ_M1(rm RegMem, v1 Imm) {
+	b.add("ROR", "RORQ_M1", rm, v1)
+}
+
+// RORQ_MC appends ROR to the program with a 64-bit operand size. See the function RORQ_MC.
+func (b *Builder) RORQ_MC(rm RegMem, cl Reg) {
+	b.add("ROR", "RORQ_MC", rm, cl)
+}
+
+// RORQ_MI appends ROR to the program with a 64-bit operand size. See the function RORQ_MI.
+func (b *Builder) RORQ_MI(rm RegMem, imm Imm) {
+	b.add("ROR", "RORQ_MI", rm, imm)
+}
+
+// RORW_M1 appends ROR to the program with a 16-bit operand size. See the function RORW_M1.
+func (b *Builder) RORW_M1(rm RegMem, v1 Imm) {
+	b.add("ROR", "RORW_M1", rm, v1)
+}
+
+// RORW_MC appends ROR to the program with a 16-bit operand size. See the function RORW_MC.
+func (b *Builder) RORW_MC(rm RegMem, cl Reg) {
+	b.add("ROR", "RORW_MC", rm, cl)
+}
+
+// RORW_MI appends ROR to the program with a 16-bit operand size. See the function RORW_MI.
+func (b *Builder) RORW_MI(rm RegMem, imm Imm) {
+	b.add("ROR", "RORW_MI", rm, imm)
+}
+
+// RORXL appends RORX to the program with a 32-bit operand size. See the function RORXL.
+func (b *Builder) RORXL(reg Reg, rm RegMem, imm Imm) {
+	b.add("RORX", "RORXL", reg, rm, imm)
+}
+
+// RORXQ appends RORX to the program with a 64-bit operand size. See the function RORXQ.
+func (b *Builder) RORXQ(reg Reg, rm RegMem, imm Imm) {
+	b.add("RORX", "RORXQ", reg, rm, imm)
+}
+
+// SALB_M1 appends SAL to the program with a 8-bit operand size. See the function SALB_M1.
+func (b *Builder) SALB_M1(rm RegMem, v1 Imm) {
+	b.add("SAL", "SALB_M1", rm, v1)
+}
+
+// SALB_MC appends SAL to the program with a 8-bit operand size. See the function SALB_MC.
+func (b *Builder) SALB_MC(rm RegMem, cl Reg) {
+	b.add("SAL", "SALB_MC", rm, cl)
+}
+
+// SALB_MI appends SAL to the program with a 8-bit operand size. See the function SALB_MI.
+func (b *Builder) SALB_MI(rm RegMem, imm Imm) {
+	b.add("SAL", "SALB_MI", rm, imm)
+}
+
+// SALL_M1 appends SAL to the program with a 32-bit operand size. See the function SALL_M1.
+func (b *Builder) SALL_M1(rm RegMem, v1 Imm) {
+	b.add("SAL", "SALL_M1", rm, v1)
+}
+
+// SALL_MC appends SAL to the program with a 32-bit operand size. See the function SALL_MC.
+func (b *Builder) SALL_MC(rm RegMem, cl Reg) {
+	b.add("SAL", "SALL_MC", rm, cl)
+}
+
+// SALL_MI appends SAL to the program with a 32-bit operand size. See the function SALL_MI.
+func (b *Builder) SALL_MI(rm RegMem, imm Imm) {
+	b.add("SAL", "SALL_MI", rm, imm)
+}
+
+// SALQ_M1 appends SAL to the program with a 64-bit operand size. See the function SALQ_M1.
+func (b *Builder) SALQ_M1(rm RegMem, v1 Imm) {
+	b.add("SAL", "SALQ_M1", rm, v1)
+}
+
+// SALQ_MC appends SAL to the program with a 64-bit operand size. See the function SALQ_MC.
+func (b *Builder) SALQ_MC(rm RegMem, cl Reg) {
+	b.add("SAL", "SALQ_MC", rm, cl)
+}
+
+// SALQ_MI appends SAL to the program with a 64-bit operand size. See the function SALQ_MI.
+func (b *Builder) SALQ_MI(rm RegMem, imm Imm) {
+	b.add("SAL", "SALQ_MI", rm, imm)
+}
+
+// SALW_M1 appends SAL to the program with a 16-bit operand size. See the function SALW_M1.
+func (b *Builder) SALW_M1(rm RegMem, v1 Imm) {
+	b.add("SAL", "SALW_M1", rm, v1)
+}
+
+// SALW_MC appends SAL to the program with a 16-bit operand size. See the function SALW_MC.
+func (b *Builder) SALW_MC(rm RegMem, cl Reg) {
+	b.add("SAL", "SALW_MC", rm, cl)
+}
+
+// SALW_MI appends SAL to the program with a 16-bit operand size. See the function SALW_MI.
+func (b *Builder) SALW_MI(rm RegMem, imm Imm) {
+	b.add("SAL", "SALW_MI", rm, imm)
+}
+
+// SARB_M1 appends SAR to the program with a 8-bit operand size. See the function SARB_M1.
+func (b *Builder) SARB_M1(rm RegMem, v1 Imm) {
+	b.add("SAR", "SARB_M1", rm, v1)
+}
+
+// SARB_MC appends SAR to the program with a 8-bit operand size. See the function SARB_MC.
+func (b *Builder) SARB_MC(rm RegMem, cl Reg) {
+	b.add("SAR", "SARB_MC", rm, cl)
+}
+
+// SARB_MI appends SAR to the program with a 8-bit operand size. See the function SARB_MI.
+func (b *Builder) SARB_MI(rm RegMem, imm Imm) {
+	b.add("SAR", "SARB_MI", rm, imm)
+}
+
+// SARL_M1 appends SAR to the program with a 32-bit operand size. See the function SARL_M1.
+func (b *Builder) SARL_M1(rm RegMem, v1 Imm) {
+	b.add("SAR", "SARL_M1", rm, v1)
+}
+
+// SARL_MC appends SAR to the program with a 32-bit operand size. See the function SARL_MC.
+func (b *Builder) SARL_MC(rm RegMem, cl Reg) {
+	b.add("SAR", "SARL_MC", rm, cl)
+}
+
+// SARL_MI appends SAR to the program with a 32-bit operand size. See the function SARL_MI.
+func (b *Builder) SARL_MI(rm RegMem, imm Imm) {
+	b.add("SAR", "SARL_MI", rm, imm)
+}
+
+// SARQ_M1 appends SAR to the program with a 64-bit operand size. See the function SARQ_M1.
+func (b *Builder) SARQ_M1(rm RegMem, v1 Imm) {
+	b.add("SAR", "SARQ_M1", rm, v1)
+}
+
+// SARQ_MC appends SAR to the program with a 64-bit operand size. See the function SARQ_MC.
+func (b *Builder) SARQ_MC(rm RegMem, cl Reg) {
+	b.add("SAR", "SARQ_MC", rm, cl)
+}
+
+// SARQ_MI appends SAR to the program with a 64-bit operand size. See the function SARQ_MI.
+func (b *Builder) SARQ_MI(rm RegMem, imm Imm) {
+	b.add("SAR", "SARQ_MI", rm, imm)
+}
+
+// SARW_M1 appends SAR to the program with a 16-bit operand size. See the function SARW_M1.
+func (b *Builder) SARW_M1(rm RegMem, v1 Imm) {
+	b.add("SAR", "SARW_M1", rm, v1)
+}
+
+// SARW_MC appends SAR to the program with a 16-bit operand size. See the function SARW_MC.
+func (b *Builder) SARW_MC(rm RegMem, cl Reg) {
+	b.add("SAR", "SARW_MC", rm, cl)
+}
+
+// SARW_MI appends SAR to the program with a 16-bit operand size. See the function SARW_MI.
+func (b *Builder) SARW_MI(rm RegMem, imm Imm) {
+	b.add("SAR", "SARW_MI", rm, imm)
+}
+
+// SARXL appends SARX to the program with a 32-bit operand size. See the function SARXL.
+func (b *Builder) SARXL(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SARX", "SARXL", reg, rm, vvvv)
+}
+
+// SARXQ appends SARX to the program with a 64-bit operand size. See the function SARXQ.
+func (b *Builder) SARXQ(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SARX", "SARXQ", reg, rm, vvvv)
+}
+
+// SBBB_I appends SBB to the program with a 8-bit operand size. See the function SBBB_I.
+func (b *Builder) SBBB_I(al Reg, imm Imm) {
+	b.add("SBB", "SBBB_I", al, imm)
+}
+
+// SBBB_MI appends SBB to the program with a 8-bit operand size. See the function SBBB_MI.
+func (b *Builder) SBBB_MI(rm RegMem, imm Imm) {
+	b.add("SBB", "SBBB_MI", rm, imm)
+}
+
+// SBBB_MR appends SBB to the program with a 8-bit operand size. See the function SBBB_MR.
+func (b *Builder) SBBB_MR(rm RegMem, reg Reg) {
+	b.add("SBB", "SBBB_MR", rm, reg)
+}
+
+// SBBB_RM appends SBB to the program with a 8-bit operand size. See the function SBBB_RM.
+func (b *Builder) SBBB_RM(reg Reg, rm RegMem) {
+	b.add("SBB", "SBBB_RM", reg, rm)
+}
+
+// SBBL_I appends SBB to the program with a 32-bit operand size. See the function SBBL_I.
+func (b *Builder) SBBL_I(al Reg, imm Imm) {
+	b.add("SBB", "SBBL_I", al, imm)
+}
+
+// SBBL_MI appends SBB to the program with a 32-bit operand size. See the function SBBL_MI.
+func (b *Builder) SBBL_MI(rm RegMem, imm Imm) {
+	b.add("SBB", "SBBL_MI", rm, imm)
+}
+
+// SBBL_MR appends SBB to the program with a 32-bit operand size. See the function SBBL_MR.
+func (b *Builder) SBBL_MR(rm RegMem, reg Reg) {
+	b.add("SBB", "SBBL_MR", rm, reg)
+}
+
+// SBBL_RM appends SBB to the program with a 32-bit operand size. See the function SBBL_RM.
+func (b *Builder) SBBL_RM(reg Reg, rm RegMem) {
+	b.add("SBB", "SBBL_RM", reg, rm)
+}
+
+// SBBQ_I appends SBB to the program with a 64-bit operand size. See the function SBBQ_I.
+func (b *Builder) SBBQ_I(al Reg, imm Imm) {
+	b.add("SBB", "SBBQ_I", al, imm)
+}
+
+// SBBQ_MI appends SBB to the program with a 64-bit operand size. See the function SBBQ_MI.
+func (b *Builder) SBBQ_MI(rm RegMem, imm Imm) {
+	b.add("SBB", "SBBQ_MI", rm, imm)
+}
+
+// SBBQ_MR appends SBB to the program with a 64-bit operand size. See the function SBBQ_MR.
+func (b *Builder) SBBQ_MR(rm RegMem, reg Reg) {
+	b.add("SBB", "SBBQ_MR", rm, reg)
+}
+
+// SBBQ_RM appends SBB to the program with a 64-bit operand size. See the function SBBQ_RM.
+func (b *Builder) SBBQ_RM(reg Reg, rm RegMem) {
+	b.add("SBB", "SBBQ_RM", reg, rm)
+}
+
+// SBBW_I appends SBB to the program with a 16-bit operand size. See the function SBBW_I.
+func (b *Builder) SBBW_I(al Reg, imm Imm) {
+	b.add("SBB", "SBBW_I", al, imm)
+}
+
+// SBBW_MI appends SBB to the program with a 16-bit operand size. See the function SBBW_MI.
+func (b *Builder) SBBW_MI(rm RegMem, imm Imm) {
+	b.add("SBB", "SBBW_MI", rm, imm)
+}
+
+// SBBW_MR appends SBB to the program with a 16-bit operand size. See the function SBBW_MR.
+func (b *Builder) SBBW_MR(rm RegMem, reg Reg) {
+	b.add("SBB", "SBBW_MR", rm, reg)
+}
+
+// SBBW_RM appends SBB to the program with a 16-bit operand size. See the function SBBW_RM.
+func (b *Builder) SBBW_RM(reg Reg, rm RegMem) {
+	b.add("SBB", "SBBW_RM", reg, rm)
+}
+
+// SHLB_M1 appends SHL to the program with a 8-bit operand size. See the function SHLB_M1.
+func (b *Builder) SHLB_M1(rm RegMem, v1 Imm) {
+	b.add("SHL", "SHLB_M1", rm, v1)
+}
+
+// SHLB_MC appends SHL to the program with a 8-bit operand size. See the function SHLB_MC.
+func (b *Builder) SHLB_MC(rm RegMem, cl Reg) {
+	b.add("SHL", "SHLB_MC", rm, cl)
+}
+
+// SHLB_MI appends SHL to the program with a 8-bit operand size. See the function SHLB_MI.
+func (b *Builder) SHLB_MI(rm RegMem, imm Imm) {
+	b.add("SHL", "SHLB_MI", rm, imm)
+}
+
+// SHLL_M1 appends SHL to the program with a 32-bit operand size. See the function SHLL_M1.
+func (b *Builder) SHLL_M1(rm RegMem, v1 Imm) {
+	b.add("SHL", "SHLL_M1", rm, v1)
+}
+
+// SHLL_MC appends SHL to the program with a 32-bit operand size. See the function SHLL_MC.
+func (b *Builder) SHLL_MC(rm RegMem, cl Reg) {
+	b.add("SHL", "SHLL_MC", rm, cl)
+}
+
+// SHLL_MI appends SHL to the program with a 32-bit operand size. See the function SHLL_MI.
+func (b *Builder) SHLL_MI(rm RegMem, imm Imm) {
+	b.add("SHL", "SHLL_MI", rm, imm)
+}
+
+// SHLL_MRC appends SHLD to the program with a 32-bit operand size. See the function SHLL_MRC.
+func (b *Builder) SHLL_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHLD", "SHLL_MRC", rm, reg, cl)
+}
+
+// SHLL_MRI appends SHLD to the program with a 32-bit operand size. See the function SHLL_MRI.
+func (b *Builder) SHLL_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHLD", "SHLL_MRI", rm, reg, imm)
+}
+
+// SHLQ_M1 appends SHL to the program with a 64-bit operand size. See the function SHLQ_M1.
+func (b *Builder) SHLQ_M1(rm RegMem, v1 Imm) {
+	b.add("SHL", "SHLQ_M1", rm, v1)
+}
+
+// SHLQ_MC appends SHL to the program with a 64-bit operand size. See the function SHLQ_MC.
+func (b *Builder) SHLQ_MC(rm RegMem, cl Reg) {
+	b.add("SHL", "SHLQ_MC", rm, cl)
+}
+
+// SHLQ_MI appends SHL to the program with a 64-bit operand size. See the function SHLQ_MI.
+func (b *Builder) SHLQ_MI(rm RegMem, imm Imm) {
+	b.add("SHL", "SHLQ_MI", rm, imm)
+}
+
+// SHLQ_MRC appends SHLD to the program with a 64-bit operand size. See the function SHLQ_MRC.
+func (b *Builder) SHLQ_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHLD", "SHLQ_MRC", rm, reg, cl)
+}
+
+// SHLQ_MRI appends SHLD to the program with a 64-bit operand size. See the function SHLQ_MRI.
+func (b *Builder) SHLQ_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHLD", "SHLQ_MRI", rm, reg, imm)
+}
+
+// SHLW_M1 appends SHL to the program with a 16-bit operand size. See the function SHLW_M1.
+func (b *Builder) SHLW_M1(rm RegMem, v1 Imm) {
+	b.add("SHL", "SHLW_M1", rm, v1)
+}
+
+// SHLW_MC appends SHL to the program with a 16-bit operand size. See the function SHLW_MC.
+func (b *Builder) SHLW_MC(rm RegMem, cl Reg) {
+	b.add("SHL", "SHLW_MC", rm, cl)
+}
+
+// SHLW_MI appends SHL to the program with a 16-bit operand size. See the function SHLW_MI.
+func (b *Builder) SHLW_MI(rm RegMem, imm Imm) {
+	b.add("SHL", "SHLW_MI", rm, imm)
+}
+
+// SHLW_MRC appends SHLD to the program with a 16-bit operand size. See the function SHLW_MRC.
+func (b *Builder) SHLW_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHLD", "SHLW_MRC", rm, reg, cl)
+}
+
+// SHLW_MRI appends SHLD to the program with a 16-bit operand size. See the function SHLW_MRI.
+func (b *Builder) SHLW_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHLD", "SHLW_MRI", rm, reg, imm)
+}
+
+// SHLXL appends SHLX to the program with a 32-bit operand size. See the function SHLXL.
+func (b *Builder) SHLXL(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SHLX", "SHLXL", reg, rm, vvvv)
+}
+
+// SHLXQ appends SHLX to the program with a 64-bit operand size. See the function SHLXQ.
+func (b *Builder) SHLXQ(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SHLX", "SHLXQ", reg, rm, vvvv)
+}
+
+// SHRB_M1 appends SHR to the program with a 8-bit operand size. See the function SHRB_M1.
+func (b *Builder) SHRB_M1(rm RegMem, v1 Imm) {
+	b.add("SHR", "SHRB_M1", rm, v1)
+}
+
+// SHRB_MC appends SHR to the program with a 8-bit operand size. See the function SHRB_MC.
+func (b *Builder) SHRB_MC(rm RegMem, cl Reg) {
+	b.add("SHR", "SHRB_MC", rm, cl)
+}
+
+// SHRB_MI appends SHR to the program with a 8-bit operand size. See the function SHRB_MI.
+func (b *Builder) SHRB_MI(rm RegMem, imm Imm) {
+	b.add("SHR", "SHRB_MI", rm, imm)
+}
+
+// SHRL_M1 appends SHR to the program with a 32-bit operand size. See the function SHRL_M1.
+func (b *Builder) SHRL_M1(rm RegMem, v1 Imm) {
+	b.add("SHR", "SHRL_M1", rm, v1)
+}
+
+// SHRL_MC appends SHR to the program with a 32-bit operand size. See the function SHRL_MC.
+func (b *Builder) SHRL_MC(rm RegMem, cl Reg) {
+	b.add("SHR", "SHRL_MC", rm, cl)
+}
+
+// SHRL_MI appends SHR to the program with a 32-bit operand size. See the function SHRL_MI.
+func (b *Builder) SHRL_MI(rm RegMem, imm Imm) {
+	b.add("SHR", "SHRL_MI", rm, imm)
+}
+
+// SHRL_MRC appends SHRD to the program with a 32-bit operand size. See the function SHRL_MRC.
+func (b *Builder) SHRL_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHRD", "SHRL_MRC", rm, reg, cl)
+}
+
+// SHRL_MRI appends SHRD to the program with a 32-bit operand size. See the function SHRL_MRI.
+func (b *Builder) SHRL_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHRD", "SHRL_MRI", rm, reg, imm)
+}
+
+// SHRQ_M1 appends SHR to the program with a 64-bit operand size. See the function SHRQ_M1.
+func (b *Builder) SHRQ_M1(rm RegMem, v1 Imm) {
+	b.add("SHR", "SHRQ_M1", rm, v1)
+}
+
+// SHRQ_MC appends SHR to the program with a 64-bit operand size. See the function SHRQ_MC.
+func (b *Builder) SHRQ_MC(rm RegMem, cl Reg) {
+	b.add("SHR", "SHRQ_MC", rm, cl)
+}
+
+// SHRQ_MI appends SHR to the program with a 64-bit operand size. See the function SHRQ_MI.
+func (b *Builder) SHRQ_MI(rm RegMem, imm Imm) {
+	b.add("SHR", "SHRQ_MI", rm, imm)
+}
+
+// SHRQ_MRC appends SHRD to the program with a 64-bit operand size. See the function SHRQ_MRC.
+func (b *Builder) SHRQ_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHRD", "SHRQ_MRC", rm, reg, cl)
+}
+
+// SHRQ_MRI appends SHRD to the program with a 64-bit operand size. See the function SHRQ_MRI.
+func (b *Builder) SHRQ_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHRD", "SHRQ_MRI", rm, reg, imm)
+}
+
+// SHRW_M1 appends SHR to the program with a 16-bit operand size. See the function SHRW_M1.
+func (b *Builder) SHRW_M1(rm RegMem, v1 Imm) {
+	b.add("SHR", "SHRW_M1", rm, v1)
+}
+
+// SHRW_MC appends SHR to the program with a 16-bit operand size. See the function SHRW_MC.
+func (b *Builder) SHRW_MC(rm RegMem, cl Reg) {
+	b.add("SHR", "SHRW_MC", rm, cl)
+}
+
+// SHRW_MI appends SHR to the program with a 16-bit operand size. See the function SHRW_MI.
+func (b *Builder) SHRW_MI(rm RegMem, imm Imm) {
+	b.add("SHR", "SHRW_MI", rm, imm)
+}
+
+// SHRW_MRC appends SHRD to the program with a 16-bit operand size. See the function SHRW_MRC.
+func (b *Builder) SHRW_MRC(rm RegMem, reg, cl Reg) {
+	b.add("SHRD", "SHRW_MRC", rm, reg, cl)
+}
+
+// SHRW_MRI appends SHRD to the program with a 16-bit operand size. See the function SHRW_MRI.
+func (b *Builder) SHRW_MRI(rm RegMem, reg Reg, imm Imm) {
+	b.add("SHRD", "SHRW_MRI", rm, reg, imm)
+}
+
+// SHRXL appends SHRX to the program with a 32-bit operand size. See the function SHRXL.
+func (b *Builder) SHRXL(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SHRX", "SHRXL", reg, rm, vvvv)
+}
+
+// SHRXQ appends SHRX to the program with a 64-bit operand size. See the function SHRXQ.
+func (b *Builder) SHRXQ(reg Reg, rm RegMem, vvvv Reg) {
+	b.add("SHRX", "SHRXQ", reg, rm, vvvv)
+}
+
+// SUBB_I appends SUB to the program with a 8-bit operand size. See the function SUBB_I.
+func (b *Builder) SUBB_I(al Reg, imm Imm) {
+	b.add("SUB", "SUBB_I", al, imm)
+}
+
+// SUBB_MI appends SUB to the program with a 8-bit operand size. See the function SUBB_MI.
+func (b *Builder) SUBB_MI(rm RegMem, imm Imm) {
+	b.add("SUB", "SUBB_MI", rm, imm)
+}
+
+// SUBB_MR appends SUB to the program with a 8-bit operand size. See the function SUBB_MR.
+func (b *Builder) SUBB_MR(rm RegMem, reg Reg) {
+	b.add("SUB", "SUBB_MR", rm, reg)
+}
+
+// SUBB_RM appends SUB to the program with a 8-bit operand size. See the function SUBB_RM.
+func (b *Builder) SUBB_RM(reg Reg, rm RegMem) {
+	b.add("SUB", "SUBB_RM", reg, rm)
+}
+
+// SUBL_I appends SUB to the program with a 32-bit operand size. See the function SUBL_I.
+func (b *Builder) SUBL_I(al Reg, imm Imm) {
+	b.add("SUB", "SUBL_I", al, imm)
+}
+
+// SUBL_MI appends SUB to the program with a 32-bit operand size. See the function SUBL_MI.
+func (b *Builder) SUBL_MI(rm RegMem, imm Imm) {
+	b.add("SUB", "SUBL_MI", rm, imm)
+}
+
+// SUBL_MR appends SUB to the program with a 32-bit operand size. See the function SUBL_MR.
+func (b *Builder) SUBL_MR(rm RegMem, reg Reg) {
+	b.add("SUB", "SUBL_MR", rm, reg)
+}
+
+// SUBL_RM appends SUB to the program with a 32-bit operand size. See the function SUBL_RM.
+func (b *Builder) SUBL_RM(reg Reg, rm RegMem) {
+	b.add("SUB", "SUBL_RM", reg, rm)
+}
+
+// SUBQ_I appends SUB to the program with a 64-bit operand size. See the function SUBQ_I.
+func (b *Builder) SUBQ_I(al Reg, imm Imm) {
+	b.add("SUB", "SUBQ_I", al, imm)
+}
+
+// SUBQ_MI appends SUB to the program with a 64-bit operand size. See the function SUBQ_MI.
+func (b *Builder) SUBQ_MI(rm RegMem, imm Imm) {
+	b.add("SUB", "SUBQ_MI", rm, imm)
+}
+
+// SUBQ_MR appends SUB to the program with a 64-bit operand size. See the function SUBQ_MR.
+func (b *Builder) SUBQ_MR(rm RegMem, reg Reg) {
+	b.add("SUB", "SUBQ_MR", rm, reg)
+}
+
+// SUBQ_RM appends SUB to the program with a 64-bit operand size. See the function SUBQ_RM.
+func (b *Builder) SUBQ_RM(reg Reg, rm RegMem) {
+	b.add("SUB", "SUBQ_RM", reg, rm)
+}
+
+// SUBW_I appends SUB to the program with a 16-bit operand size. See the function SUBW_I.
+func (b *Builder) SUBW_I(al Reg, imm Imm) {
+	b.add("SUB", "SUBW_I", al, imm)
+}
+
+// SUBW_MI appends SUB to the program with a 16-bit operand size. See the function SUBW_MI.
+func (b *Builder) SUBW_MI(rm RegMem, imm Imm) {
+	b.add("SUB", "SUBW_MI", rm, imm)
+}
+
+// SUBW_MR appends SUB to the program with a 16-bit operand size. See the function SUBW_MR.
+func (b *Builder) SUBW_MR(rm RegMem, reg Reg) {
+	b.add("SUB", "SUBW_MR", rm, reg)
+}
+
+// SUBW_RM appends SUB to the program with a 16-bit operand size. See the function SUBW_RM.
+func (b *Builder) SUBW_RM(reg Reg, rm RegMem) {
+	b.add("SUB", "SUBW_RM", reg, rm)
+}
+
+// TESTB_I appends TEST to the program with a 8-bit operand size. See the function TESTB_I.
+func (b *Builder) TESTB_I(al Reg, imm Imm) {
+	b.add("TEST", "TESTB_I", al, imm)
+}
+
+// TESTB_MI appends TEST to the program with a 8-bit operand size. See the function TESTB_MI.
+func (b *Builder) TESTB_MI(rm RegMem, imm Imm) {
+	b.add("TEST", "TESTB_MI", rm, imm)
+}
+
+// TESTB_MR appends TEST to the program with a 8-bit operand size. See the function TESTB_MR.
+func (b *Builder) TESTB_MR(rm RegMem, reg Reg) {
+	b.add("TEST", "TESTB_MR", rm, reg)
+}
+
+// TESTL_I appends TEST to the program with a 32-bit operand size. See the function TESTL_I.
+func (b *Builder) TESTL_I(al Reg, imm Imm) {
+	b.add("TEST", "TESTL_I", al, imm)
+}
+
+// TESTL_MI appends TEST to the program with a 32-bit operand size. See the function TESTL_MI.
+func (b *Builder) TESTL_MI(rm RegMem, imm Imm) {
+	b.add("TEST", "TESTL_MI", rm, imm)
+}
+
+// TESTL_MR appends TEST to the program with a 32-bit operand size. See the function TESTL_MR.
+func (b *Builder) TESTL_MR(rm RegMem, reg Reg) {
+	b.add("TEST", "TESTL_MR", rm, reg)
+}
+
+// TESTQ_I appends TEST to the program with a 64-bit operand size. See the function TESTQ_I.
+func (b *Builder) TESTQ_I(al Reg, imm Imm) {
+	b.add("TEST", "TESTQ_I", al, imm)
+}
+
+// TESTQ_MI appends TEST to the program with a 64-bit operand size. See the function TESTQ_MI.
+func (b *Builder) TESTQ_MI(rm RegMem, imm Imm) {
+	b.add("TEST", "TESTQ_MI", rm, imm)
+}
+
+// TESTQ_MR appends TEST to the program with a 64-bit operand size. See the function TESTQ_MR.
+func (b *Builder) TESTQ_MR(rm RegMem, reg Reg) {
+	b.add("TEST", "TESTQ_MR", rm, reg)
+}
+
+// TESTW_I appends TEST to the program with a 16-bit operand size. See the function TESTW_I.
+func (b *Builder) TESTW_I(al Reg, imm Imm) {
+	b.add("TEST", "TESTW_I", al, imm)
+}
+
+// TESTW_MI appends TEST to the program with a 16-bit operand size. See the function TESTW_MI.
+func (b *Builder) TESTW_MI(rm RegMem, imm Imm) {
+	b.add("TEST", "TESTW_MI", rm, imm)
+}
+
+// TESTW_MR appends TEST to the program with a 16-bit operand size. See the function TESTW_MR.
+func (b *Builder) TESTW_MR(rm RegMem, reg Reg) {
+	b.add("TEST", "TESTW_MR", rm, reg)
+}
+
+// TZCNTL appends TZCNT to the program with a 32-bit operand size. See the function TZCNTL.
+func (b *Builder) TZCNTL(reg Reg, rm RegMem) {
+	b.add("TZCNT", "TZCNTL", reg, rm)
+}
+
+// TZCNTQ appends TZCNT to the program with a 64-bit operand size. See the function TZCNTQ.
+func (b *Builder) TZCNTQ(reg Reg, rm RegMem) {
+	b.add("TZCNT", "TZCNTQ", reg, rm)
+}
+
+// TZCNTW appends TZCNT to the program with a 16-bit operand size. See the function TZCNTW.
+func (b *Builder) TZCNTW(reg Reg, rm RegMem) {
+	b.add("TZCNT", "TZCNTW", reg, rm)
+}
+
+// VCVTPD2DQX appends VCVTPD2DQ to the program with a 128-bit operand size. See the function VCVTPD2DQX.
+func (b *Builder) VCVTPD2DQX(reg Register, rm RegMem) {
+	b.add("VCVTPD2DQ", "VCVTPD2DQX", reg, rm)
+}
+
+// VCVTPD2DQY appends VCVTPD2DQ to the program with a 256-bit operand size. See the function VCVTPD2DQY.
+func (b *Builder) VCVTPD2DQY(reg Register, rm RegMem) {
+	b.add("VCVTPD2DQ", "VCVTPD2DQY", reg, rm)
+}
+
+// VCVTPD2PSX_FV appends VCVTPD2PS to the program with a 128-bit operand size. See the function VCVTPD2PSX_FV.
+func (b *Builder) VCVTPD2PSX_FV(reg Register, rm RegMem) {
+	b.add("VCVTPD2PS", "VCVTPD2PSX_FV", reg, rm)
+}
+
+// VCVTPD2PSX_RM appends VCVTPD2PS to the program with a 128-bit operand size. See the function VCVTPD2PSX_RM.
+func (b *Builder) VCVTPD2PSX_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTPD2PS", "VCVTPD2PSX_RM", reg, rm)
+}
+
+// VCVTPD2PSY_FV appends VCVTPD2PS to the program with a 256-bit operand size. See the function VCVTPD2PSY_FV.
+func (b *Builder) VCVTPD2PSY_FV(reg Register, rm RegMem) {
+	b.add("VCVTPD2PS", "VCVTPD2PSY_FV", reg, rm)
+}
+
+// VCVTPD2PSY_RM appends VCVTPD2PS to the program with a 256-bit operand size. See the function VCVTPD2PSY_RM.
+func (b *Builder) VCVTPD2PSY_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTPD2PS", "VCVTPD2PSY_RM", reg, rm)
+}
+
+// VCVTPD2UDQX appends VCVTPD2UDQ to the program with a 128-bit operand size. See the function VCVTPD2UDQX.
+func (b *Builder) VCVTPD2UDQX(reg Register, rm RegMem) {
+	b.add("VCVTPD2UDQ", "VCVTPD2UDQX", reg, rm)
+}
+
+// VCVTPD2UDQY appends VCVTPD2UDQ to the program with a 256-bit operand size. See the function VCVTPD2UDQY.
+func (b *Builder) VCVTPD2UDQY(reg Register, rm RegMem) {
+	b.add("VCVTPD2UDQ", "VCVTPD2UDQY", reg, rm)
+}
+
+// VCVTQQ2PSX appends VCVTQQ2PS to the program with a 128-bit operand size. See the function VCVTQQ2PSX.
+func (b *Builder) VCVTQQ2PSX(reg Register, rm RegMem) {
+	b.add("VCVTQQ2PS", "VCVTQQ2PSX", reg, rm)
+}
+
+// VCVTQQ2PSY appends VCVTQQ2PS to the program with a 256-bit operand size. See the function VCVTQQ2PSY.
+func (b *Builder) VCVTQQ2PSY(reg Register, rm RegMem) {
+	b.add("VCVTQQ2PS", "VCVTQQ2PSY", reg, rm)
+}
+
+// VCVTSD2SIQ_RM appends VCVTSD2SI to the program with a 64-bit operand size. See the function VCVTSD2SIQ_RM.
+func (b *Builder) VCVTSD2SIQ_RM(reg Reg, rm RegMem) {
+	b.add("VCVTSD2SI", "VCVTSD2SIQ_RM", reg, rm)
+}
+
+// VCVTSD2SIQ_T1F appends VCVTSD2SI to the program with a 64-bit operand size. See the function VCVTSD2SIQ_T1F.
+func (b *Builder) VCVTSD2SIQ_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTSD2SI", "VCVTSD2SIQ_T1F", reg, rm)
+}
+
+// VCVTSD2USIL appends VCVTSD2USI to the program with a 32-bit operand size. See the function VCVTSD2USIL.
+func (b *Builder) VCVTSD2USIL(reg Reg, rm RegMem) {
+	b.add("VCVTSD2USI", "VCVTSD2USIL", reg, rm)
+}
+
+// VCVTSD2USIQ appends VCVTSD2USI to the program with a 64-bit operand size. See the function VCVTSD2USIQ.
+func (b *Builder) VCVTSD2USIQ(reg Reg, rm RegMem) {
+	b.add("VCVTSD2USI", "VCVTSD2USIQ", reg, rm)
+}
+
+// VCVTSI2SDL_RVM appends VCVTSI2SD to the program with a 32-bit operand size. See the function VCVTSI2SDL_RVM.
+func (b *Builder) VCVTSI2SDL_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SD", "VCVTSI2SDL_RVM", reg, vvvv, rm)
+}
+
+// VCVTSI2SDL_T1S appends VCVTSI2SD to the program with a 32-bit operand size. See the function VCVTSI2SDL_T1S.
+func (b *Builder) VCVTSI2SDL_T1S(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SD", "VCVTSI2SDL_T1S", reg, vvvv, rm)
+}
+
+// VCVTSI2SDQ_RVM appends VCVTSI2SD to the program with a 64-bit operand size. See the function VCVTSI2SDQ_RVM.
+func (b *Builder) VCVTSI2SDQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SD", "VCVTSI2SDQ_RVM", reg, vvvv, rm)
+}
+
+// VCVTSI2SDQ_T1S appends VCVTSI2SD to the program with a 64-bit operand size. See the function VCVTSI2SDQ_T1S.
+func (b *Builder) VCVTSI2SDQ_T1S(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SD", "VCVTSI2SDQ_T1S", reg, vvvv, rm)
+}
+
+// VCVTSI2SSL_RVM appends VCVTSI2SS to the program with a 32-bit operand size. See the function VCVTSI2SSL_RVM.
+func (b *Builder) VCVTSI2SSL_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SS", "VCVTSI2SSL_RVM", reg, vvvv, rm)
+}
+
+// VCVTSI2SSL_T1S appends VCVTSI2SS to the program with a 32-bit operand size. See the function VCVTSI2SSL_T1S.
+func (b *Builder) VCVTSI2SSL_T1S(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SS", "VCVTSI2SSL_T1S", reg, vvvv, rm)
+}
+
+// VCVTSI2SSQ_RVM appends VCVTSI2SS to the program with a 64-bit operand size. See the function VCVTSI2SSQ_RVM.
+func (b *Builder) VCVTSI2SSQ_RVM(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SS", "VCVTSI2SSQ_RVM", reg, vvvv, rm)
+}
+
+// VCVTSI2SSQ_T1S appends VCVTSI2SS to the program with a 64-bit operand size. See the function VCVTSI2SSQ_T1S.
+func (b *Builder) VCVTSI2SSQ_T1S(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTSI2SS", "VCVTSI2SSQ_T1S", reg, vvvv, rm)
+}
+
+// VCVTSS2SIQ_RM appends VCVTSS2SI to the program with a 64-bit operand size. See the function VCVTSS2SIQ_RM.
+func (b *Builder) VCVTSS2SIQ_RM(reg Reg, rm RegMem) {
+	b.add("VCVTSS2SI", "VCVTSS2SIQ_RM", reg, rm)
+}
+
+// VCVTSS2SIQ_T1F appends VCVTSS2SI to the program with a 64-bit operand size. See the function VCVTSS2SIQ_T1F.
+func (b *Builder) VCVTSS2SIQ_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTSS2SI", "VCVTSS2SIQ_T1F", reg, rm)
+}
+
+// VCVTSS2USIL appends VCVTSS2USI to the program with a 32-bit operand size. See the function VCVTSS2USIL.
+func (b *Builder) VCVTSS2USIL(reg Reg, rm RegMem) {
+	b.add("VCVTSS2USI", "VCVTSS2USIL", reg, rm)
+}
+
+// VCVTSS2USIQ appends VCVTSS2USI to the program with a 64-bit operand size. See the function VCVTSS2USIQ.
+func (b *Builder) VCVTSS2USIQ(reg Reg, rm RegMem) {
+	b.add("VCVTSS2USI", "VCVTSS2USIQ", reg, rm)
+}
+
+// VCVTTPD2DQX_FV appends VCVTTPD2DQ to the program with a 128-bit operand size. See the function VCVTTPD2DQX_FV.
+func (b *Builder) VCVTTPD2DQX_FV(reg Register, rm RegMem) {
+	b.add("VCVTTPD2DQ", "VCVTTPD2DQX_FV", reg, rm)
+}
+
+// VCVTTPD2DQX_RM appends VCVTTPD2DQ to the program with a 128-bit operand size. See the function VCVTTPD2DQX_RM.
+func (b *Builder) VCVTTPD2DQX_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTTPD2DQ", "VCVTTPD2DQX_RM", reg, rm)
+}
+
+// VCVTTPD2DQY_FV appends VCVTTPD2DQ to the program with a 256-bit operand size. See the function VCVTTPD2DQY_FV.
+func (b *Builder) VCVTTPD2DQY_FV(reg Register, rm RegMem) {
+	b.add("VCVTTPD2DQ", "VCVTTPD2DQY_FV", reg, rm)
+}
+
+// VCVTTPD2DQY_RM appends VCVTTPD2DQ to the program with a 256-bit operand size. See the function VCVTTPD2DQY_RM.
+func (b *Builder) VCVTTPD2DQY_RM(reg VecReg, rm RegMem) {
+	b.add("VCVTTPD2DQ", "VCVTTPD2DQY_RM", reg, rm)
+}
+
+// VCVTTPD2UDQX appends VCVTTPD2UDQ to the program with a 128-bit operand size. See the function VCVTTPD2UDQX.
+func (b *Builder) VCVTTPD2UDQX(reg Register, rm RegMem) {
+	b.add("VCVTTPD2UDQ", "VCVTTPD2UDQX", reg, rm)
+}
+
+// VCVTTPD2UDQY appends VCVTTPD2UDQ to the program with a 256-bit operand size. See the function VCVTTPD2UDQY.
+func (b *Builder) VCVTTPD2UDQY(reg Register, rm RegMem) {
+	b.add("VCVTTPD2UDQ", "VCVTTPD2UDQY", reg, rm)
+}
+
+// VCVTTSD2SIQ_RM appends VCVTTSD2SI to the program with a 64-bit operand size. See the function VCVTTSD2SIQ_RM.
+func (b *Builder) VCVTTSD2SIQ_RM(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2SI", "VCVTTSD2SIQ_RM", reg, rm)
+}
+
+// VCVTTSD2SIQ_T1F appends VCVTTSD2SI to the program with a 64-bit operand size. See the function VCVTTSD2SIQ_T1F.
+func (b *Builder) VCVTTSD2SIQ_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2SI", "VCVTTSD2SIQ_T1F", reg, rm)
+}
+
+// VCVTTSD2USIL appends VCVTTSD2USI to the program with a 32-bit operand size. See the function VCVTTSD2USIL.
+func (b *Builder) VCVTTSD2USIL(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2USI", "VCVTTSD2USIL", reg, rm)
+}
+
+// VCVTTSD2USIQ appends VCVTTSD2USI to the program with a 64-bit operand size. See the function VCVTTSD2USIQ.
+func (b *Builder) VCVTTSD2USIQ(reg Reg, rm RegMem) {
+	b.add("VCVTTSD2USI", "VCVTTSD2USIQ", reg, rm)
+}
+
+// VCVTTSS2SIQ_RM appends VCVTTSS2SI to the program with a 64-bit operand size. See the function VCVTTSS2SIQ_RM.
+func (b *Builder) VCVTTSS2SIQ_RM(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2SI", "VCVTTSS2SIQ_RM", reg, rm)
+}
+
+// VCVTTSS2SIQ_T1F appends VCVTTSS2SI to the program with a 64-bit operand size. See the function VCVTTSS2SIQ_T1F.
+func (b *Builder) VCVTTSS2SIQ_T1F(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2SI", "VCVTTSS2SIQ_T1F", reg, rm)
+}
+
+// VCVTTSS2USIL appends VCVTTSS2USI to the program with a 32-bit operand size. See the function VCVTTSS2USIL.
+func (b *Builder) VCVTTSS2USIL(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2USI", "VCVTTSS2USIL", reg, rm)
+}
+
+// VCVTTSS2USIQ appends VCVTTSS2USI to the program with a 64-bit operand size. See the function VCVTTSS2USIQ.
+func (b *Builder) VCVTTSS2USIQ(reg Reg, rm RegMem) {
+	b.add("VCVTTSS2USI", "VCVTTSS2USIQ", reg, rm)
+}
+
+// VCVTUQQ2PSX appends VCVTUQQ2PS to the program with a 128-bit operand size. See the function VCVTUQQ2PSX.
+func (b *Builder) VCVTUQQ2PSX(reg Register, rm RegMem) {
+	b.add("VCVTUQQ2PS", "VCVTUQQ2PSX", reg, rm)
+}
+
+// VCVTUQQ2PSY appends VCVTUQQ2PS to the program with a 256-bit operand size. See the function VCVTUQQ2PSY.
+func (b *Builder) VCVTUQQ2PSY(reg Register, rm RegMem) {
+	b.add("VCVTUQQ2PS", "VCVTUQQ2PSY", reg, rm)
+}
+
+// VCVTUSI2SDL appends VCVTUSI2SD to the program with a 32-bit operand size. See the function VCVTUSI2SDL.
+func (b *Builder) VCVTUSI2SDL(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTUSI2SD", "VCVTUSI2SDL", reg, vvvv, rm)
+}
+
+// VCVTUSI2SDQ appends VCVTUSI2SD to the program with a 64-bit operand size. See the function VCVTUSI2SDQ.
+func (b *Builder) VCVTUSI2SDQ(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTUSI2SD", "VCVTUSI2SDQ", reg, vvvv, rm)
+}
+
+// VCVTUSI2SSL appends VCVTUSI2SS to the program with a 32-bit operand size. See the function VCVTUSI2SSL.
+func (b *Builder) VCVTUSI2SSL(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTUSI2SS", "VCVTUSI2SSL", reg, vvvv, rm)
+}
+
+// VCVTUSI2SSQ appends VCVTUSI2SS to the program with a 64-bit operand size. See the function VCVTUSI2SSQ.
+func (b *Builder) VCVTUSI2SSQ(reg, vvvv VecReg, rm RegMem) {
+	b.add("VCVTUSI2SS", "VCVTUSI2SSQ", reg, vvvv, rm)
+}
+
+// VFPCLASSPDX appends VFPCLASSPD to the program with a 128-bit operand size. See the function VFPCLASSPDX.
+func (b *Builder) VFPCLASSPDX(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPD", "VFPCLASSPDX", reg, rm, imm)
+}
+
+// VFPCLASSPDY appends VFPCLASSPD to the program with a 256-bit operand size. See the function VFPCLASSPDY.
+func (b *Builder) VFPCLASSPDY(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPD", "VFPCLASSPDY", reg, rm, imm)
+}
+
+// VFPCLASSPDZ appends VFPCLASSPD to the program with a 512-bit operand size. See the function VFPCLASSPDZ.
+func (b *Builder) VFPCLASSPDZ(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPD", "VFPCLASSPDZ", reg, rm, imm)
+}
+
+// VFPCLASSPSX appends VFPCLASSPS to the program with a 128-bit operand size. See the function VFPCLASSPSX.
+func (b *Builder) VFPCLASSPSX(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPS", "VFPCLASSPSX", reg, rm, imm)
+}
+
+// VFPCLASSPSY appends VFPCLASSPS to the program with a 256-bit operand size. See the function VFPCLASSPSY.
+func (b *Builder) VFPCLASSPSY(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPS", "VFPCLASSPSY", reg, rm, imm)
+}
+
+// VFPCLASSPSZ appends VFPCLASSPS to the program with a 512-bit operand size. See the function VFPCLASSPSZ.
+func (b *Builder) VFPCLASSPSZ(reg Register, rm RegMem, imm Imm) {
+	b.add("VFPCLASSPS", "VFPCLASSPSZ", reg, rm, imm)
+}
+
+// XADDB appends XADD to the program with a 8-bit operand size. See the function XADDB.
+func (b *Builder) XADDB(rm RegMem, reg Reg) {
+	b.add("XADD", "XADDB", rm, reg)
+}
+
+// XADDL appends XADD to the program with a 32-bit operand size. See the function XADDL.
+func (b *Builder) XADDL(rm RegMem, reg Reg) {
+	b.add("XADD", "XADDL", rm, reg)
+}
+
+// XADDQ appends XADD to the program with a 64-bit operand size. See the function XADDQ.
+func (b *Builder) XADDQ(rm RegMem, reg Reg) {
+	b.add("XADD", "XADDQ", rm, reg)
+}
+
+// XADDW appends XADD to the program with a 16-bit operand size. See the function XADDW.
+func (b *Builder) XADDW(rm RegMem, reg Reg) {
+	b.add("XADD", "XADDW", rm, reg)
+}
+
+// XCHGB_MR appends XCHG to the program with a 8-bit operand size. See the function XCHGB_MR.
+func (b *Builder) XCHGB_MR(rm RegMem, reg Reg) {
+	b.add("XCHG", "XCHGB_MR", rm, reg)
+}
+
+// XCHGB_RM appends XCHG to the program with a 8-bit operand size. See the function XCHGB_RM.
+func (b *Builder) XCHGB_RM(reg Reg, rm RegMem) {
+	b.add("XCHG", "XCHGB_RM", reg, rm)
+}
+
+// XCHGL_MR appends XCHG to the program with a 32-bit operand size. See the function XCHGL_MR.
+func (b *Builder) XCHGL_MR(rm RegMem, reg Reg) {
+	b.add("XCHG", "XCHGL_MR", rm, reg)
+}
+
+// XCHGL_O appends XCHG to the program with a 32-bit operand size. See the function XCHGL_O.
+func (b *Builder) XCHGL_O(arg, arg2 Reg) {
+	b.add("XCHG", "XCHGL_O", arg, arg2)
+}
+
+// XCHGL_RM appends XCHG to the program with a 32-bit operand size. See the function XCHGL_RM.
+func (b *Builder) XCHGL_RM(reg Reg, rm RegMem) {
+	b.add("XCHG", "XCHGL_RM", reg, rm)
+}
+
+// XCHGQ_MR appends XCHG to the program with a 64-bit operand size. See the function XCHGQ_MR.
+func (b *Builder) XCHGQ_MR(rm RegMem, reg Reg) {
+	b.add("XCHG", "XCHGQ_MR", rm, reg)
+}
+
+// XCHGQ_O appends XCHG to the program with a 64-bit operand size. See the function XCHGQ_O.
+func (b *Builder) XCHGQ_O(arg, arg2 Reg) {
+	b.add("XCHG", "XCHGQ_O", arg, arg2)
+}
+
+// XCHGQ_RM appends XCHG to the program with a 64-bit operand size. See the function XCHGQ_RM.
+func (b *Builder) XCHGQ_RM(reg Reg, rm RegMem) {
+	b.add("XCHG", "XCHGQ_RM", reg, rm)
+}
+
+// XCHGW_MR appends XCHG to the program with a 16-bit operand size. See the function XCHGW_MR.
+func (b *Builder) XCHGW_MR(rm RegMem, reg Reg) {
+	b.add("XCHG", "XCHGW_MR", rm, reg)
+}
+
+// XCHGW_O appends XCHG to the program with a 16-bit operand size. See the function XCHGW_O.
+func (b *Builder) XCHGW_O(arg, arg2 Reg) {
+	b.add("XCHG", "XCHGW_O", arg, arg2)
+}
+
+// XCHGW_RM appends XCHG to the program with a 16-bit operand size. See the function XCHGW_RM.
+func (b *Builder) XCHGW_RM(reg Reg, rm RegMem) {
+	b.add("XCHG", "XCHGW_RM", reg, rm)
+}
+
+// XORB_I appends XOR to the program with a 8-bit operand size. See the function XORB_I.
+func (b *Builder) XORB_I(al Reg, imm Imm) {
+	b.add("XOR", "XORB_I", al, imm)
+}
+
+// XORB_MI appends XOR to the program with a 8-bit operand size. See the function XORB_MI.
+func (b *Builder) XORB_MI(rm RegMem, imm Imm) {
+	b.add("XOR", "XORB_MI", rm, imm)
+}
+
+// XORB_MR appends XOR to the program with a 8-bit operand size. See the function XORB_MR.
+func (b *Builder) XORB_MR(rm RegMem, reg Reg) {
+	b.add("XOR", "XORB_MR", rm, reg)
+}
+
+// XORB_RM appends XOR to the program with a 8-bit operand size. See the function XORB_RM.
+func (b *Builder) XORB_RM(reg Reg, rm RegMem) {
+	b.add("XOR", "XORB_RM", reg, rm)
+}
+
+// XORL_I appends XOR to the program with a 32-bit operand size. See the function XORL_I.
+func (b *Builder) XORL_I(al Reg, imm Imm) {
+	b.add("XOR", "XORL_I", al, imm)
+}
+
+// XORL_MI appends XOR to the program with a 32-bit operand size. See the function XORL_MI.
+func (b *Builder) XORL_MI(rm RegMem, imm Imm) {
+	b.add("XOR", "XORL_MI", rm, imm)
+}
+
+// XORL_MR appends XOR to the program with a 32-bit operand size. See the function XORL_MR.
+func (b *Builder) XORL_MR(rm RegMem, reg Reg) {
+	b.add("XOR", "XORL_MR", rm, reg)
+}
+
+// XORL_RM appends XOR to the program with a 32-bit operand size. See the function XORL_RM.
+func (b *Builder) XORL_RM(reg Reg, rm RegMem) {
+	b.add("XOR", "XORL_RM", reg, rm)
+}
+
+// XORQ_I appends XOR to the program with a 64-bit operand size. See the function XORQ_I.
+func (b *Builder) XORQ_I(al Reg, imm Imm) {
+	b.add("XOR", "XORQ_I", al, imm)
+}
+
+// XORQ_MI appends XOR to the program with a 64-bit operand size. See the function XORQ_MI.
+func (b *Builder) XORQ_MI(rm RegMem, imm Imm) {
+	b.add("XOR", "XORQ_MI", rm, imm)
+}
+
+// XORQ_MR appends XOR to the program with a 64-bit operand size. See the function XORQ_MR.
+func (b *Builder) XORQ_MR(rm RegMem, reg Reg) {
+	b.add("XOR", "XORQ_MR", rm, reg)
+}
+
+// XORQ_RM appends XOR to the program with a 64-bit operand size. See the function XORQ_RM.
+func (b *Builder) XORQ_RM(reg Reg, rm RegMem) {
+	b.add("XOR", "XORQ_RM", reg, rm)
+}
+
+// XORW_I appends XOR to the program with a 16-bit operand size. See the function XORW_I.
+func (b *Builder) XORW_I(al Reg, imm Imm) {
+	b.add("XOR", "XORW_I", al, imm)
+}
+
+// XORW_MI appends XOR to the program with a 16-bit operand size. See the function XORW_MI.
+func (b *Builder) XORW_MI(rm RegMem, imm Imm) {
+	b.add("XOR", "XORW_MI", rm, imm)
+}
+
+// XORW_MR appends XOR to the program with a 16-bit operand size. See the function XORW_MR.
+func (b *Builder) XORW_MR(rm RegMem, reg Reg) {
+	b.add("XOR", "XORW_MR", rm, reg)
+}
+
+// XORW_RM appends XOR to the program with a 16-bit operand size. See the function XORW_RM.
+func (b *Builder) XORW_RM(reg Reg, rm RegMem) {
+	b.add("XOR", "XORW_RM", reg, rm)
+}
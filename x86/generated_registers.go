@@ -0,0 +1,435 @@
+package x86
+
+// Registers, as described by x86spec.RegisterFiles.
+const (
+	AL    Reg      = 1
+	CL    Reg      = 2
+	DL    Reg      = 3
+	BL    Reg      = 4
+	SPL   Reg      = 5
+	BPL   Reg      = 6
+	SIL   Reg      = 7
+	DIL   Reg      = 8
+	R8B   Reg      = 9
+	R9B   Reg      = 10
+	R10B  Reg      = 11
+	R11B  Reg      = 12
+	R12B  Reg      = 13
+	R13B  Reg      = 14
+	R14B  Reg      = 15
+	R15B  Reg      = 16
+	AH    Reg      = 17
+	CH    Reg      = 18
+	DH    Reg      = 19
+	BH    Reg      = 20
+	AX    Reg      = 21
+	CX    Reg      = 22
+	DX    Reg      = 23
+	BX    Reg      = 24
+	SP    Reg      = 25
+	BP    Reg      = 26
+	SI    Reg      = 27
+	DI    Reg      = 28
+	R8W   Reg      = 29
+	R9W   Reg      = 30
+	R10W  Reg      = 31
+	R11W  Reg      = 32
+	R12W  Reg      = 33
+	R13W  Reg      = 34
+	R14W  Reg      = 35
+	R15W  Reg      = 36
+	EAX   Reg      = 37
+	ECX   Reg      = 38
+	EDX   Reg      = 39
+	EBX   Reg      = 40
+	ESP   Reg      = 41
+	EBP   Reg      = 42
+	ESI   Reg      = 43
+	EDI   Reg      = 44
+	R8D   Reg      = 45
+	R9D   Reg      = 46
+	R10D  Reg      = 47
+	R11D  Reg      = 48
+	R12D  Reg      = 49
+	R13D  Reg      = 50
+	R14D  Reg      = 51
+	R15D  Reg      = 52
+	RAX   Reg      = 53
+	RCX   Reg      = 54
+	RDX   Reg      = 55
+	RBX   Reg      = 56
+	RSP   Reg      = 57
+	RBP   Reg      = 58
+	RSI   Reg      = 59
+	RDI   Reg      = 60
+	R8    Reg      = 61
+	R9    Reg      = 62
+	R10   Reg      = 63
+	R11   Reg      = 64
+	R12   Reg      = 65
+	R13   Reg      = 66
+	R14   Reg      = 67
+	R15   Reg      = 68
+	EIP   Reg      = 69
+	RIP   Reg      = 70
+	XMM0  VecReg   = 71
+	XMM1  VecReg   = 72
+	XMM2  VecReg   = 73
+	XMM3  VecReg   = 74
+	XMM4  VecReg   = 75
+	XMM5  VecReg   = 76
+	XMM6  VecReg   = 77
+	XMM7  VecReg   = 78
+	XMM8  VecReg   = 79
+	XMM9  VecReg   = 80
+	XMM10 VecReg   = 81
+	XMM11 VecReg   = 82
+	XMM12 VecReg   = 83
+	XMM13 VecReg   = 84
+	XMM14 VecReg   = 85
+	XMM15 VecReg   = 86
+	XMM16 VecReg   = 87
+	XMM17 VecReg   = 88
+	XMM18 VecReg   = 89
+	XMM19 VecReg   = 90
+	XMM20 VecReg   = 91
+	XMM21 VecReg   = 92
+	XMM22 VecReg   = 93
+	XMM23 VecReg   = 94
+	XMM24 VecReg   = 95
+	XMM25 VecReg   = 96
+	XMM26 VecReg   = 97
+	XMM27 VecReg   = 98
+	XMM28 VecReg   = 99
+	XMM29 VecReg   = 100
+	XMM30 VecReg   = 101
+	XMM31 VecReg   = 102
+	YMM0  VecReg   = 103
+	YMM1  VecReg   = 104
+	YMM2  VecReg   = 105
+	YMM3  VecReg   = 106
+	YMM4  VecReg   = 107
+	YMM5  VecReg   = 108
+	YMM6  VecReg   = 109
+	YMM7  VecReg   = 110
+	YMM8  VecReg   = 111
+	YMM9  VecReg   = 112
+	YMM10 VecReg   = 113
+	YMM11 VecReg   = 114
+	YMM12 VecReg   = 115
+	YMM13 VecReg   = 116
+	YMM14 VecReg   = 117
+	YMM15 VecReg   = 118
+	YMM16 VecReg   = 119
+	YMM17 VecReg   = 120
+	YMM18 VecReg   = 121
+	YMM19 VecReg   = 122
+	YMM20 VecReg   = 123
+	YMM21 VecReg   = 124
+	YMM22 VecReg   = 125
+	YMM23 VecReg   = 126
+	YMM24 VecReg   = 127
+	YMM25 VecReg   = 128
+	YMM26 VecReg   = 129
+	YMM27 VecReg   = 130
+	YMM28 VecReg   = 131
+	YMM29 VecReg   = 132
+	YMM30 VecReg   = 133
+	YMM31 VecReg   = 134
+	ZMM0  VecReg   = 135
+	ZMM1  VecReg   = 136
+	ZMM2  VecReg   = 137
+	ZMM3  VecReg   = 138
+	ZMM4  VecReg   = 139
+	ZMM5  VecReg   = 140
+	ZMM6  VecReg   = 141
+	ZMM7  VecReg   = 142
+	ZMM8  VecReg   = 143
+	ZMM9  VecReg   = 144
+	ZMM10 VecReg   = 145
+	ZMM11 VecReg   = 146
+	ZMM12 VecReg   = 147
+	ZMM13 VecReg   = 148
+	ZMM14 VecReg   = 149
+	ZMM15 VecReg   = 150
+	ZMM16 VecReg   = 151
+	ZMM17 VecReg   = 152
+	ZMM18 VecReg   = 153
+	ZMM19 VecReg   = 154
+	ZMM20 VecReg   = 155
+	ZMM21 VecReg   = 156
+	ZMM22 VecReg   = 157
+	ZMM23 VecReg   = 158
+	ZMM24 VecReg   = 159
+	ZMM25 VecReg   = 160
+	ZMM26 VecReg   = 161
+	ZMM27 VecReg   = 162
+	ZMM28 VecReg   = 163
+	ZMM29 VecReg   = 164
+	ZMM30 VecReg   = 165
+	ZMM31 VecReg   = 166
+	K0    MaskReg  = 167
+	K1    MaskReg  = 168
+	K2    MaskReg  = 169
+	K3    MaskReg  = 170
+	K4    MaskReg  = 171
+	K5    MaskReg  = 172
+	K6    MaskReg  = 173
+	K7    MaskReg  = 174
+	MM0   MMXReg   = 175
+	MM1   MMXReg   = 176
+	MM2   MMXReg   = 177
+	MM3   MMXReg   = 178
+	MM4   MMXReg   = 179
+	MM5   MMXReg   = 180
+	MM6   MMXReg   = 181
+	MM7   MMXReg   = 182
+	ST0   X87Reg   = 183
+	ST1   X87Reg   = 184
+	ST2   X87Reg   = 185
+	ST3   X87Reg   = 186
+	ST4   X87Reg   = 187
+	ST5   X87Reg   = 188
+	ST6   X87Reg   = 189
+	ST7   X87Reg   = 190
+	ES    SegReg   = 191
+	CS    SegReg   = 192
+	SS    SegReg   = 193
+	DS    SegReg   = 194
+	FS    SegReg   = 195
+	GS    SegReg   = 196
+	CR0   CtrlReg  = 197
+	CR2   CtrlReg  = 198
+	CR3   CtrlReg  = 199
+	CR4   CtrlReg  = 200
+	CR8   CtrlReg  = 201
+	DR0   DebugReg = 202
+	DR1   DebugReg = 203
+	DR2   DebugReg = 204
+	DR3   DebugReg = 205
+	DR4   DebugReg = 206
+	DR5   DebugReg = 207
+	DR6   DebugReg = 208
+	DR7   DebugReg = 209
+	BND0  BndReg   = 210
+	BND1  BndReg   = 211
+	BND2  BndReg   = 212
+	BND3  BndReg   = 213
+)
+
+var registers = [...]RegInfo{
+	0:     {},
+	AL:    {Name: "AL", Class: ClassGP, Width: 8, Num: 0},
+	CL:    {Name: "CL", Class: ClassGP, Width: 8, Num: 1},
+	DL:    {Name: "DL", Class: ClassGP, Width: 8, Num: 2},
+	BL:    {Name: "BL", Class: ClassGP, Width: 8, Num: 3},
+	SPL:   {Name: "SPL", Class: ClassGP, Width: 8, Num: 4, REX: true},
+	BPL:   {Name: "BPL", Class: ClassGP, Width: 8, Num: 5, REX: true},
+	SIL:   {Name: "SIL", Class: ClassGP, Width: 8, Num: 6, REX: true},
+	DIL:   {Name: "DIL", Class: ClassGP, Width: 8, Num: 7, REX: true},
+	R8B:   {Name: "R8B", Class: ClassGP, Width: 8, Num: 8, REX: true},
+	R9B:   {Name: "R9B", Class: ClassGP, Width: 8, Num: 9, REX: true},
+	R10B:  {Name: "R10B", Class: ClassGP, Width: 8, Num: 10, REX: true},
+	R11B:  {Name: "R11B", Class: ClassGP, Width: 8, Num: 11, REX: true},
+	R12B:  {Name: "R12B", Class: ClassGP, Width: 8, Num: 12, REX: true},
+	R13B:  {Name: "R13B", Class: ClassGP, Width: 8, Num: 13, REX: true},
+	R14B:  {Name: "R14B", Class: ClassGP, Width: 8, Num: 14, REX: true},
+	R15B:  {Name: "R15B", Class: ClassGP, Width: 8, Num: 15, REX: true},
+	AH:    {Name: "AH", Class: ClassGP, Width: 8, Num: 4, NoREX: true},
+	CH:    {Name: "CH", Class: ClassGP, Width: 8, Num: 5, NoREX: true},
+	DH:    {Name: "DH", Class: ClassGP, Width: 8, Num: 6, NoREX: true},
+	BH:    {Name: "BH", Class: ClassGP, Width: 8, Num: 7, NoREX: true},
+	AX:    {Name: "AX", Class: ClassGP, Width: 16, Num: 0},
+	CX:    {Name: "CX", Class: ClassGP, Width: 16, Num: 1},
+	DX:    {Name: "DX", Class: ClassGP, Width: 16, Num: 2},
+	BX:    {Name: "BX", Class: ClassGP, Width: 16, Num: 3},
+	SP:    {Name: "SP", Class: ClassGP, Width: 16, Num: 4},
+	BP:    {Name: "BP", Class: ClassGP, Width: 16, Num: 5},
+	SI:    {Name: "SI", Class: ClassGP, Width: 16, Num: 6},
+	DI:    {Name: "DI", Class: ClassGP, Width: 16, Num: 7},
+	R8W:   {Name: "R8W", Class: ClassGP, Width: 16, Num: 8, REX: true},
+	R9W:   {Name: "R9W", Class: ClassGP, Width: 16, Num: 9, REX: true},
+	R10W:  {Name: "R10W", Class: ClassGP, Width: 16, Num: 10, REX: true},
+	R11W:  {Name: "R11W", Class: ClassGP, Width: 16, Num: 11, REX: true},
+	R12W:  {Name: "R12W", Class: ClassGP, Width: 16, Num: 12, REX: true},
+	R13W:  {Name: "R13W", Class: ClassGP, Width: 16, Num: 13, REX: true},
+	R14W:  {Name: "R14W", Class: ClassGP, Width: 16, Num: 14, REX: true},
+	R15W:  {Name: "R15W", Class: ClassGP, Width: 16, Num: 15, REX: true},
+	EAX:   {Name: "EAX", Class: ClassGP, Width: 32, Num: 0},
+	ECX:   {Name: "ECX", Class: ClassGP, Width: 32, Num: 1},
+	EDX:   {Name: "EDX", Class: ClassGP, Width: 32, Num: 2},
+	EBX:   {Name: "EBX", Class: ClassGP, Width: 32, Num: 3},
+	ESP:   {Name: "ESP", Class: ClassGP, Width: 32, Num: 4},
+	EBP:   {Name: "EBP", Class: ClassGP, Width: 32, Num: 5},
+	ESI:   {Name: "ESI", Class: ClassGP, Width: 32, Num: 6},
+	EDI:   {Name: "EDI", Class: ClassGP, Width: 32, Num: 7},
+	R8D:   {Name: "R8D", Class: ClassGP, Width: 32, Num: 8, REX: true},
+	R9D:   {Name: "R9D", Class: ClassGP, Width: 32, Num: 9, REX: true},
+	R10D:  {Name: "R10D", Class: ClassGP, Width: 32, Num: 10, REX: true},
+	R11D:  {Name: "R11D", Class: ClassGP, Width: 32, Num: 11, REX: true},
+	R12D:  {Name: "R12D", Class: ClassGP, Width: 32, Num: 12, REX: true},
+	R13D:  {Name: "R13D", Class: ClassGP, Width: 32, Num: 13, REX: true},
+	R14D:  {Name: "R14D", Class: ClassGP, Width: 32, Num: 14, REX: true},
+	R15D:  {Name: "R15D", Class: ClassGP, Width: 32, Num: 15, REX: true},
+	RAX:   {Name: "RAX", Class: ClassGP, Width: 64, Num: 0},
+	RCX:   {Name: "RCX", Class: ClassGP, Width: 64, Num: 1},
+	RDX:   {Name: "RDX", Class: ClassGP, Width: 64, Num: 2},
+	RBX:   {Name: "RBX", Class: ClassGP, Width: 64, Num: 3},
+	RSP:   {Name: "RSP", Class: ClassGP, Width: 64, Num: 4},
+	RBP:   {Name: "RBP", Class: ClassGP, Width: 64, Num: 5},
+	RSI:   {Name: "RSI", Class: ClassGP, Width: 64, Num: 6},
+	RDI:   {Name: "RDI", Class: ClassGP, Width: 64, Num: 7},
+	R8:    {Name: "R8", Class: ClassGP, Width: 64, Num: 8, REX: true},
+	R9:    {Name: "R9", Class: ClassGP, Width: 64, Num: 9, REX: true},
+	R10:   {Name: "R10", Class: ClassGP, Width: 64, Num: 10, REX: true},
+	R11:   {Name: "R11", Class: ClassGP, Width: 64, Num: 11, REX: true},
+	R12:   {Name: "R12", Class: ClassGP, Width: 64, Num: 12, REX: true},
+	R13:   {Name: "R13", Class: ClassGP, Width: 64, Num: 13, REX: true},
+	R14:   {Name: "R14", Class: ClassGP, Width: 64, Num: 14, REX: true},
+	R15:   {Name: "R15", Class: ClassGP, Width: 64, Num: 15, REX: true},
+	EIP:   {Name: "EIP", Class: ClassIP, Width: 32, Num: 0},
+	RIP:   {Name: "RIP", Class: ClassIP, Width: 64, Num: 0},
+	XMM0:  {Name: "XMM0", Class: ClassVec, Width: 128, Num: 0},
+	XMM1:  {Name: "XMM1", Class: ClassVec, Width: 128, Num: 1},
+	XMM2:  {Name: "XMM2", Class: ClassVec, Width: 128, Num: 2},
+	XMM3:  {Name: "XMM3", Class: ClassVec, Width: 128, Num: 3},
+	XMM4:  {Name: "XMM4", Class: ClassVec, Width: 128, Num: 4},
+	XMM5:  {Name: "XMM5", Class: ClassVec, Width: 128, Num: 5},
+	XMM6:  {Name: "XMM6", Class: ClassVec, Width: 128, Num: 6},
+	XMM7:  {Name: "XMM7", Class: ClassVec, Width: 128, Num: 7},
+	XMM8:  {Name: "XMM8", Class: ClassVec, Width: 128, Num: 8},
+	XMM9:  {Name: "XMM9", Class: ClassVec, Width: 128, Num: 9},
+	XMM10: {Name: "XMM10", Class: ClassVec, Width: 128, Num: 10},
+	XMM11: {Name: "XMM11", Class: ClassVec, Width: 128, Num: 11},
+	XMM12: {Name: "XMM12", Class: ClassVec, Width: 128, Num: 12},
+	XMM13: {Name: "XMM13", Class: ClassVec, Width: 128, Num: 13},
+	XMM14: {Name: "XMM14", Class: ClassVec, Width: 128, Num: 14},
+	XMM15: {Name: "XMM15", Class: ClassVec, Width: 128, Num: 15},
+	XMM16: {Name: "XMM16", Class: ClassVec, Width: 128, Num: 16, EVEX: true},
+	XMM17: {Name: "XMM17", Class: ClassVec, Width: 128, Num: 17, EVEX: true},
+	XMM18: {Name: "XMM18", Class: ClassVec, Width: 128, Num: 18, EVEX: true},
+	XMM19: {Name: "XMM19", Class: ClassVec, Width: 128, Num: 19, EVEX: true},
+	XMM20: {Name: "XMM20", Class: ClassVec, Width: 128, Num: 20, EVEX: true},
+	XMM21: {Name: "XMM21", Class: ClassVec, Width: 128, Num: 21, EVEX: true},
+	XMM22: {Name: "XMM22", Class: ClassVec, Width: 128, Num: 22, EVEX: true},
+	XMM23: {Name: "XMM23", Class: ClassVec, Width: 128, Num: 23, EVEX: true},
+	XMM24: {Name: "XMM24", Class: ClassVec, Width: 128, Num: 24, EVEX: true},
+	XMM25: {Name: "XMM25", Class: ClassVec, Width: 128, Num: 25, EVEX: true},
+	XMM26: {Name: "XMM26", Class: ClassVec, Width: 128, Num: 26, EVEX: true},
+	XMM27: {Name: "XMM27", Class: ClassVec, Width: 128, Num: 27, EVEX: true},
+	XMM28: {Name: "XMM28", Class: ClassVec, Width: 128, Num: 28, EVEX: true},
+	XMM29: {Name: "XMM29", Class: ClassVec, Width: 128, Num: 29, EVEX: true},
+	XMM30: {Name: "XMM30", Class: ClassVec, Width: 128, Num: 30, EVEX: true},
+	XMM31: {Name: "XMM31", Class: ClassVec, Width: 128, Num: 31, EVEX: true},
+	YMM0:  {Name: "YMM0", Class: ClassVec, Width: 256, Num: 0},
+	YMM1:  {Name: "YMM1", Class: ClassVec, Width: 256, Num: 1},
+	YMM2:  {Name: "YMM2", Class: ClassVec, Width: 256, Num: 2},
+	YMM3:  {Name: "YMM3", Class: ClassVec, Width: 256, Num: 3},
+	YMM4:  {Name: "YMM4", Class: ClassVec, Width: 256, Num: 4},
+	YMM5:  {Name: "YMM5", Class: ClassVec, Width: 256, Num: 5},
+	YMM6:  {Name: "YMM6", Class: ClassVec, Width: 256, Num: 6},
+	YMM7:  {Name: "YMM7", Class: ClassVec, Width: 256, Num: 7},
+	YMM8:  {Name: "YMM8", Class: ClassVec, Width: 256, Num: 8},
+	YMM9:  {Name: "YMM9", Class: ClassVec, Width: 256, Num: 9},
+	YMM10: {Name: "YMM10", Class: ClassVec, Width: 256, Num: 10},
+	YMM11: {Name: "YMM11", Class: ClassVec, Width: 256, Num: 11},
+	YMM12: {Name: "YMM12", Class: ClassVec, Width: 256, Num: 12},
+	YMM13: {Name: "YMM13", Class: ClassVec, Width: 256, Num: 13},
+	YMM14: {Name: "YMM14", Class: ClassVec, Width: 256, Num: 14},
+	YMM15: {Name: "YMM15", Class: ClassVec, Width: 256, Num: 15},
+	YMM16: {Name: "YMM16", Class: ClassVec, Width: 256, Num: 16, EVEX: true},
+	YMM17: {Name: "YMM17", Class: ClassVec, Width: 256, Num: 17, EVEX: true},
+	YMM18: {Name: "YMM18", Class: ClassVec, Width: 256, Num: 18, EVEX: true},
+	YMM19: {Name: "YMM19", Class: ClassVec, Width: 256, Num: 19, EVEX: true},
+	YMM20: {Name: "YMM20", Class: ClassVec, Width: 256, Num: 20, EVEX: true},
+	YMM21: {Name: "YMM21", Class: ClassVec, Width: 256, Num: 21, EVEX: true},
+	YMM22: {Name: "YMM22", Class: ClassVec, Width: 256, Num: 22, EVEX: true},
+	YMM23: {Name: "YMM23", Class: ClassVec, Width: 256, Num: 23, EVEX: true},
+	YMM24: {Name: "YMM24", Class: ClassVec, Width: 256, Num: 24, EVEX: true},
+	YMM25: {Name: "YMM25", Class: ClassVec, Width: 256, Num: 25, EVEX: true},
+	YMM26: {Name: "YMM26", Class: ClassVec, Width: 256, Num: 26, EVEX: true},
+	YMM27: {Name: "YMM27", Class: ClassVec, Width: 256, Num: 27, EVEX: true},
+	YMM28: {Name: "YMM28", Class: ClassVec, Width: 256, Num: 28, EVEX: true},
+	YMM29: {Name: "YMM29", Class: ClassVec, Width: 256, Num: 29, EVEX: true},
+	YMM30: {Name: "YMM30", Class: ClassVec, Width: 256, Num: 30, EVEX: true},
+	YMM31: {Name: "YMM31", Class: ClassVec, Width: 256, Num: 31, EVEX: true},
+	ZMM0:  {Name: "ZMM0", Class: ClassVec, Width: 512, Num: 0, EVEX: true},
+	ZMM1:  {Name: "ZMM1", Class: ClassVec, Width: 512, Num: 1, EVEX: true},
+	ZMM2:  {Name: "ZMM2", Class: ClassVec, Width: 512, Num: 2, EVEX: true},
+	ZMM3:  {Name: "ZMM3", Class: ClassVec, Width: 512, Num: 3, EVEX: true},
+	ZMM4:  {Name: "ZMM4", Class: ClassVec, Width: 512, Num: 4, EVEX: true},
+	ZMM5:  {Name: "ZMM5", Class: ClassVec, Width: 512, Num: 5, EVEX: true},
+	ZMM6:  {Name: "ZMM6", Class: ClassVec, Width: 512, Num: 6, EVEX: true},
+	ZMM7:  {Name: "ZMM7", Class: ClassVec, Width: 512, Num: 7, EVEX: true},
+	ZMM8:  {Name: "ZMM8", Class: ClassVec, Width: 512, Num: 8, EVEX: true},
+	ZMM9:  {Name: "ZMM9", Class: ClassVec, Width: 512, Num: 9, EVEX: true},
+	ZMM10: {Name: "ZMM10", Class: ClassVec, Width: 512, Num: 10, EVEX: true},
+	ZMM11: {Name: "ZMM11", Class: ClassVec, Width: 512, Num: 11, EVEX: true},
+	ZMM12: {Name: "ZMM12", Class: ClassVec, Width: 512, Num: 12, EVEX: true},
+	ZMM13: {Name: "ZMM13", Class: ClassVec, Width: 512, Num: 13, EVEX: true},
+	ZMM14: {Name: "ZMM14", Class: ClassVec, Width: 512, Num: 14, EVEX: true},
+	ZMM15: {Name: "ZMM15", Class: ClassVec, Width: 512, Num: 15, EVEX: true},
+	ZMM16: {Name: "ZMM16", Class: ClassVec, Width: 512, Num: 16, EVEX: true},
+	ZMM17: {Name: "ZMM17", Class: ClassVec, Width: 512, Num: 17, EVEX: true},
+	ZMM18: {Name: "ZMM18", Class: ClassVec, Width: 512, Num: 18, EVEX: true},
+	ZMM19: {Name: "ZMM19", Class: ClassVec, Width: 512, Num: 19, EVEX: true},
+	ZMM20: {Name: "ZMM20", Class: ClassVec, Width: 512, Num: 20, EVEX: true},
+	ZMM21: {Name: "ZMM21", Class: ClassVec, Width: 512, Num: 21, EVEX: true},
+	ZMM22: {Name: "ZMM22", Class: ClassVec, Width: 512, Num: 22, EVEX: true},
+	ZMM23: {Name: "ZMM23", Class: ClassVec, Width: 512, Num: 23, EVEX: true},
+	ZMM24: {Name: "ZMM24", Class: ClassVec, Width: 512, Num: 24, EVEX: true},
+	ZMM25: {Name: "ZMM25", Class: ClassVec, Width: 512, Num: 25, EVEX: true},
+	ZMM26: {Name: "ZMM26", Class: ClassVec, Width: 512, Num: 26, EVEX: true},
+	ZMM27: {Name: "ZMM27", Class: ClassVec, Width: 512, Num: 27, EVEX: true},
+	ZMM28: {Name: "ZMM28", Class: ClassVec, Width: 512, Num: 28, EVEX: true},
+	ZMM29: {Name: "ZMM29", Class: ClassVec, Width: 512, Num: 29, EVEX: true},
+	ZMM30: {Name: "ZMM30", Class: ClassVec, Width: 512, Num: 30, EVEX: true},
+	ZMM31: {Name: "ZMM31", Class: ClassVec, Width: 512, Num: 31, EVEX: true},
+	K0:    {Name: "K0", Class: ClassMask, Width: 64, Num: 0},
+	K1:    {Name: "K1", Class: ClassMask, Width: 64, Num: 1},
+	K2:    {Name: "K2", Class: ClassMask, Width: 64, Num: 2},
+	K3:    {Name: "K3", Class: ClassMask, Width: 64, Num: 3},
+	K4:    {Name: "K4", Class: ClassMask, Width: 64, Num: 4},
+	K5:    {Name: "K5", Class: ClassMask, Width: 64, Num: 5},
+	K6:    {Name: "K6", Class: ClassMask, Width: 64, Num: 6},
+	K7:    {Name: "K7", Class: ClassMask, Width: 64, Num: 7},
+	MM0:   {Name: "MM0", Class: ClassMMX, Width: 64, Num: 0},
+	MM1:   {Name: "MM1", Class: ClassMMX, Width: 64, Num: 1},
+	MM2:   {Name: "MM2", Class: ClassMMX, Width: 64, Num: 2},
+	MM3:   {Name: "MM3", Class: ClassMMX, Width: 64, Num: 3},
+	MM4:   {Name: "MM4", Class: ClassMMX, Width: 64, Num: 4},
+	MM5:   {Name: "MM5", Class: ClassMMX, Width: 64, Num: 5},
+	MM6:   {Name: "MM6", Class: ClassMMX, Width: 64, Num: 6},
+	MM7:   {Name: "MM7", Class: ClassMMX, Width: 64, Num: 7},
+	ST0:   {Name: "ST(0)", Class: ClassX87, Width: 80, Num: 0},
+	ST1:   {Name: "ST(1)", Class: ClassX87, Width: 80, Num: 1},
+	ST2:   {Name: "ST(2)", Class: ClassX87, Width: 80, Num: 2},
+	ST3:   {Name: "ST(3)", Class: ClassX87, Width: 80, Num: 3},
+	ST4:   {Name: "ST(4)", Class: ClassX87, Width: 80, Num: 4},
+	ST5:   {Name: "ST(5)", Class: ClassX87, Width: 80, Num: 5},
+	ST6:   {Name: "ST(6)", Class: ClassX87, Width: 80, Num: 6},
+	ST7:   {Name: "ST(7)", Class: ClassX87, Width: 80, Num: 7},
+	ES:    {Name: "ES", Class: ClassSeg, Width: 16, Num: 0},
+	CS:    {Name: "CS", Class: ClassSeg, Width: 16, Num: 1},
+	SS:    {Name: "SS", Class: ClassSeg, Width: 16, Num: 2},
+	DS:    {Name: "DS", Class: ClassSeg, Width: 16, Num: 3},
+	FS:    {Name: "FS", Class: ClassSeg, Width: 16, Num: 4},
+	GS:    {Name: "GS", Class: ClassSeg, Width: 16, Num: 5},
+	CR0:   {Name: "CR0", Class: ClassCtrl, Width: 64, Num: 0},
+	CR2:   {Name: "CR2", Class: ClassCtrl, Width: 64, Num: 2},
+	CR3:   {Name: "CR3", Class: ClassCtrl, Width: 64, Num: 3},
+	CR4:   {Name: "CR4", Class: ClassCtrl, Width: 64, Num: 4},
+	CR8:   {Name: "CR8", Class: ClassCtrl, Width: 64, Num: 8, REX: true},
+	DR0:   {Name: "DR0", Class: ClassDebug, Width: 64, Num: 0},
+	DR1:   {Name: "DR1", Class: ClassDebug, Width: 64, Num: 1},
+	DR2:   {Name: "DR2", Class: ClassDebug, Width: 64, Num: 2},
+	DR3:   {Name: "DR3", Class: ClassDebug, Width: 64, Num: 3},
+	DR4:   {Name: "DR4", Class: ClassDebug, Width: 64, Num: 4},
+	DR5:   {Name: "DR5", Class: ClassDebug, Width: 64, Num: 5},
+	DR6:   {Name: "DR6", Class: ClassDebug, Width: 64, Num: 6},
+	DR7:   {Name: "DR7", Class: ClassDebug, Width: 64, Num: 7},
+	BND0:  {Name: "BND0", Class: ClassBnd, Width: 128, Num: 0},
+	BND1:  {Name: "BND1", Class: ClassBnd, Width: 128, Num: 1},
+	BND2:  {Name: "BND2", Class: ClassBnd, Width: 128, Num: 2},
+	BND3:  {Name: "BND3", Class: ClassBnd, Width: 128, Num: 3},
+}
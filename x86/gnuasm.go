@@ -0,0 +1,298 @@
+package x86
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GnuAsm describes a GNU assembler source file, in AT&T syntax, of
+// functions built with a Builder. The functions are global symbols, called
+// with whatever convention their code follows, e.g. the System V ABI for
+// functions called from C.
+type GnuAsm struct {
+	Funcs []GnuFunc
+	Data  []GnuData
+}
+
+// GnuFunc is a function of a GnuAsm file. The labels of its code are local
+// to the function. When the mode of its code differs from the function's
+// before it, or from 64-bit mode for the first, a .code16, .code32 or
+// .code64 directive switches the assembler to it.
+type GnuFunc struct {
+	Name string   // symbol name, e.g. "add_avx2"
+	Code *Builder // the body, which must end in a RET or a jump
+}
+
+// GnuData is a read-only symbol of a GnuAsm file, aligned to 16 bytes, e.g.
+// a table of constants referenced from code with Sym.
+type GnuData struct {
+	Name  string
+	Bytes []byte
+}
+
+// WriteAsm writes the assembler source.
+func (a *GnuAsm) WriteAsm(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("# Code generated by github.com/dave/asm/x86. DO NOT EDIT.\n")
+	if len(a.Data) > 0 {
+		buf.WriteString("\n\t.section .rodata\n")
+	}
+	for _, d := range a.Data {
+		fmt.Fprintf(&buf, "\t.balign 16\n\t.type %s, @object\n\t.size %s, %d\n%s:\n", d.Name, d.Name, len(d.Bytes), d.Name)
+		for off := 0; off < len(d.Bytes); off += 16 {
+			line := d.Bytes[off:]
+			if len(line) > 16 {
+				line = line[:16]
+			}
+			s := make([]string, len(line))
+			for n, b := range line {
+				s[n] = fmt.Sprintf("0x%02x", b)
+			}
+			fmt.Fprintf(&buf, "\t.byte %s\n", strings.Join(s, ", "))
+		}
+	}
+	buf.WriteString("\n\t.text\n")
+	code := Mode64 // the mode of the .code directive in force
+	for _, f := range a.Funcs {
+		if f.Code != nil && f.Code.mode() != code {
+			code = f.Code.mode()
+			fmt.Fprintf(&buf, "\n\t.code%d\n", int(code))
+		}
+		fmt.Fprintf(&buf, "\n\t.globl %s\n\t.type %s, @function\n%s:\n", f.Name, f.Name, f.Name)
+		if err := f.Code.writeGnu(&buf, ".L"+f.Name+"_"); err != nil {
+			return fmt.Errorf("x86: %s: %v", f.Name, err)
+		}
+		fmt.Fprintf(&buf, "\t.size %s, .-%s\n", f.Name, f.Name)
+	}
+	buf.WriteString("\n\t.section .note.GNU-stack,\"\",@progbits\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// GnuSyntax returns the instruction in GNU assembler AT&T syntax, e.g.
+// "addq %rbx, %rax".
+func (i Instruction) GnuSyntax() (string, error) {
+	return i.gnuSyntax(target{mode: Mode64}, "")
+}
+
+// writeGnu writes the program, with the names of its labels prefixed.
+func (b *Builder) writeGnu(w io.Writer, prefix string) error {
+	if b == nil {
+		return fmt.Errorf("no code")
+	}
+	if b.err != nil {
+		return b.err
+	}
+	t := b.target()
+	at := b.labelsAt()
+	for n := 0; n <= len(b.instructions); n++ {
+		for _, l := range at[n] {
+			fmt.Fprintf(w, "%s%s:\n", prefix, l)
+		}
+		if n == len(b.instructions) {
+			break
+		}
+		s, err := b.instructions[n].gnuSyntax(t, prefix)
+		if err != nil {
+			return fmt.Errorf("instruction %d: %v", n, err)
+		}
+		fmt.Fprintf(w, "\t%s\n", s)
+	}
+	return nil
+}
+
+func (i Instruction) gnuSyntax(t target, prefix string) (string, error) {
+	c, err := i.form(t)
+	if err != nil {
+		return "", err
+	}
+	if gnuRaw(c, i.Args) {
+		return i.gnuBytes(t)
+	}
+	mode := t.mode
+	op, order := syntaxOrder(c, c.form.GnuSyntax)
+	indirect := strings.HasSuffix(op, "*")
+	op = mnemonicIn(mode, op, c, i.Args)
+	if g, ok := gnuMnemonics[op]; ok {
+		op = g
+	}
+	for k, a := range c.args {
+		if _, ok := i.Args[k].(Mem); !ok {
+			continue
+		}
+		if a.memSize == "m16int" && strings.HasPrefix(op, "fi") {
+			op += "s"
+		}
+		if op == "movl" || op == "movq" {
+			// A segment register is stored to memory as a word.
+			for _, o := range i.Args {
+				if r, ok := o.(Register); ok && r.Info().Class == ClassSeg {
+					op = "movw"
+				}
+			}
+		}
+	}
+	args := make([]string, len(order))
+	for n, k := range order {
+		switch a := i.Args[k].(type) {
+		case Label:
+			args[n] = prefix + string(a)
+		case Rel:
+			// "." is the address of the instruction, not of the next one.
+			code, err := i.encode(t)
+			if err != nil {
+				return "", err
+			}
+			args[n] = fmt.Sprintf(".%+#x", int64(a)+int64(len(code)))
+		default:
+			if args[n], err = gnuOperand(mode, a); err != nil {
+				return "", fmt.Errorf("%s: %v", i, err)
+			}
+		}
+	}
+	if indirect {
+		args[0] = "*" + args[0]
+	}
+	args = gnuRounding(args, order, i.Args)
+	if len(args) == 0 {
+		return op, nil
+	}
+	return op + " " + strings.Join(args, ", "), nil
+}
+
+// gnuMnemonics renames the mnemonics of x86spec's GNU syntax, which follows
+// the output of objdump, that GNU as does not accept.
+var gnuMnemonics = map[string]string{
+	"movbeww": "movbew",
+	"movbell": "movbel",
+	"movbeqq": "movbeq",
+	"movsww":  "movsxw",
+	"movzww":  "movzxw",
+	"movsxdl": "movsxd",
+	"movsxdw": "movsxd",
+	"sysexit": "sysexitl",
+}
+
+// gnuRaw reports whether GNU as rejects an instruction or its operands:
+// BSWAP of a word, far pointer loads into a 64-bit register, LSL of a
+// 32-bit register into a 64-bit one, far branches through a 64-bit
+// pointer, ICEBP, and UD1 without operands.
+func gnuRaw(c *compiledForm, ops []Operand) bool {
+	switch c.form.Mnemonic() {
+	case "ICEBP":
+		return true
+	case "UD1":
+		return len(ops) == 0
+	case "CALL_FAR", "JMP_FAR":
+		return c.enc.REXW
+	case "BSWAP":
+		return c.args[0].width == 16
+	case "LFS", "LGS", "LSS":
+		return c.args[0].width == 64
+	case "LSL":
+		_, reg := ops[1].(Register)
+		return c.args[0].width == 64 && reg
+	}
+	return false
+}
+
+// gnuBytes returns the instruction as a .byte directive, followed by its
+// Intel syntax as a comment.
+func (i Instruction) gnuBytes(t target) (string, error) {
+	code, err := i.encode(t)
+	if err != nil {
+		return "", err
+	}
+	s := make([]string, len(code))
+	for n, b := range code {
+		s[n] = fmt.Sprintf("0x%02x", b)
+	}
+	return ".byte " + strings.Join(s, ", ") + " # " + i.String(), nil
+}
+
+// gnuRounding inserts the rounding mode of an instruction, which GNU as
+// takes as an operand of its own, before its first vector register.
+func gnuRounding(args []string, order []int, ops []Operand) []string {
+	var mode Rounding
+	found := false
+	for _, op := range ops {
+		if r, ok := op.(Rounded); ok {
+			mode, found = r.Mode, true
+		}
+	}
+	if !found {
+		return args
+	}
+	at := len(args)
+	for n, k := range order {
+		if r, ok := ops[k].(Register); ok && r.Info().Class == ClassVec {
+			at = n
+			break
+		}
+	}
+	out := append([]string(nil), args[:at]...)
+	out = append(out, "{"+mode.String()+"}")
+	return append(out, args[at:]...)
+}
+
+// gnuOperand returns a register, immediate or memory operand in AT&T syntax
+// for mode.
+func gnuOperand(mode Mode, op Operand) (string, error) {
+	switch op := op.(type) {
+	case Masked:
+		s, err := gnuOperand(mode, op.Dest)
+		if err != nil {
+			return "", err
+		}
+		s += "{%" + strings.ToLower(op.Mask.String()) + "}"
+		if op.Zero {
+			s += "{z}"
+		}
+		return s, nil
+	case Rounded:
+		// The rounding mode is an operand of its own; see gnuRounding.
+		return gnuOperand(mode, op.Reg)
+	case Register:
+		return "%" + strings.ToLower(op.String()), nil
+	case Imm:
+		return fmt.Sprintf("$%#x", int64(op)), nil
+	case Mem:
+		return gnuMem(mode, op)
+	}
+	return "", fmt.Errorf("operand %s has no GNU assembler syntax", op)
+}
+
+// gnuMem returns a memory operand in AT&T syntax. A symbol is addressed
+// relative to RIP in 64-bit mode, and absolutely in the others.
+func gnuMem(mode Mode, m Mem) (string, error) {
+	s := ""
+	if m.Segment != 0 {
+		s = "%" + strings.ToLower(m.Segment.String()) + ":"
+	}
+	if m.Symbol != "" && mode != Mode64 {
+		return s + m.Symbol + goOffset(m.Disp), nil
+	}
+	if m.Symbol != "" {
+		return s + m.Symbol + goOffset(m.Disp) + "(%rip)", nil
+	}
+	if m.Disp != 0 || m.Base == 0 && !m.HasIndex() {
+		s += fmt.Sprintf("%#x", m.Disp)
+	}
+	if m.Base == 0 && !m.HasIndex() {
+		return s, nil
+	}
+	s += "("
+	if m.Base != 0 {
+		s += "%" + strings.ToLower(m.Base.String())
+	}
+	if m.HasIndex() {
+		s += fmt.Sprintf(",%%%s,%d", strings.ToLower(m.Index.String()), m.Scale)
+	}
+	s += ")"
+	if m.Broadcast != 0 {
+		s += fmt.Sprintf("{1to%d}", m.Broadcast)
+	}
+	return s, nil
+}
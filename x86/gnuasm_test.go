@@ -0,0 +1,145 @@
+package x86
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGnuSyntax(t *testing.T) {
+	tests := []struct {
+		inst Instruction
+		want string
+	}{
+		{Instruction{"ADD", "ADD_MR", []Operand{RAX, RBX}}, "addq %rbx, %rax"},
+		{Instruction{"ADD", "ADD_MI", []Operand{Mem{Base: RBX, Index: RCX, Scale: 8, Disp: 16, Size: M32}, Imm(-1)}}, "addl $-0x1, 0x10(%rbx,%rcx,8)"},
+		{Instruction{"MOV", "MOV_RM", []Operand{ECX, Mem{Segment: GS, Disp: 0x1000}}}, "movl %gs:0x1000, %ecx"},
+		{Instruction{"MOV", "MOV_RM", []Operand{RAX, Mem{Base: RIP, Disp: 0x20}}}, "movq 0x20(%rip), %rax"},
+		{Instruction{"MOVZX", "MOVZX", []Operand{EAX, SPL}}, "movzbl %spl, %eax"},
+		{Instruction{"CALL", "CALL_M", []Operand{R9}}, "callq *%r9"},
+		{Instruction{"JMP", "JMP_D", []Operand{Rel(-2)}}, "jmp .+0x0"},
+		{Instruction{"JNZ", "JNZ", []Operand{Label("loop")}}, "jnz loop"},
+		{Instruction{"FADD", "FADD", []Operand{ST0, ST3}}, "fadd %st(3), %st(0)"},
+		{Instruction{"FIADD", "FIADD", []Operand{Mem{Base: RAX, Size: M16Int}}}, "fiadds (%rax)"},
+		{Instruction{"MOVBE", "MOVBE_RM", []Operand{R9, Mem{Base: R13, Disp: 64}}}, "movbeq 0x40(%r13), %r9"},
+		{Instruction{"VADDPS", "VADDPS_RVM", []Operand{YMM1, YMM12, Mem{Base: R8, Disp: 64}}}, "vaddps 0x40(%r8), %ymm12, %ymm1"},
+		{Instruction{"MOVUPS", "MOVUPS_RM", []Operand{XMM0, Sym("masks", 16)}}, "movups masks+16(%rip), %xmm0"},
+		{Instruction{"BSWAP", "BSWAP", []Operand{R9W}}, ".byte 0x66, 0x41, 0x0f, 0xc9 # BSWAP R9W"},
+		{Instruction{"ICEBP", "ICEBP", nil}, ".byte 0xf1 # ICEBP"},
+		{Instruction{"JMP_FAR", "JMP_FAR_M", []Operand{Mem{Base: RAX, Size: M16Seg64}}}, ".byte 0x48, 0xff, 0x28 # JMP_FAR [RAX]"},
+		{Instruction{"JMP_FAR", "JMP_FAR_M", []Operand{Mem{Base: RAX, Size: M16Seg32}}}, "ljmpl *(%rax)"},
+		{Instruction{"VADDPS", "VADDPS_FV", []Operand{Mask(ZMM1, K1).Z(), ZMM2, Round(ZMM3, RZSAE)}}, "vaddps {rz-sae}, %zmm3, %zmm2, %zmm1{%k1}{z}"},
+		{Instruction{"VADDPS", "VADDPS_FV", []Operand{ZMM1, ZMM2, Mem{Base: RAX}.Bcst(16)}}, "vaddps (%rax){1to16}, %zmm2, %zmm1"},
+		{Instruction{"VCVTUSI2SS", "VCVTUSI2SS", []Operand{XMM2, XMM1, Round(RAX, RDSAE)}}, "vcvtusi2ssq %rax, {rd-sae}, %xmm1, %xmm2"},
+	}
+	for _, tt := range tests {
+		got, err := tt.inst.GnuSyntax()
+		if err != nil {
+			t.Errorf("%s: %v", tt.inst, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: GnuSyntax = %q, want %q", tt.inst, got, tt.want)
+		}
+	}
+}
+
+// TestGnuAsmBytes checks that GNU as turns the GNU syntax of instructions
+// it spells differently, or not at all, into our encoding.
+func TestGnuAsmBytes(t *testing.T) {
+	as, err := exec.LookPath("as")
+	if err != nil {
+		t.Skip("no as command")
+	}
+	objcopy, err := exec.LookPath("objcopy")
+	if err != nil {
+		t.Skip("no objcopy command")
+	}
+	var b Builder
+	b.CALL_FAR_M(Mem{Base: RAX, Size: M16Seg64})
+	b.CALL_FAR_M(Mem{Base: RAX, Size: M16Seg32})
+	b.JMP_FAR_M(Mem{Base: R9, Disp: 8, Size: M16Seg64})
+	b.JMP_FAR_M(Mem{Base: RBX, Size: M16Seg32})
+	b.ICEBP()
+	b.UD1()
+	b.UD2()
+	b.BSWAP(R9W)
+	b.LSS(RAX, Mem{Base: RSI})
+	b.MOV_MR(RAX, RDI)
+	b.RET_NP()
+
+	want, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var src bytes.Buffer
+	if err := b.writeGnu(&src, ""); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	file, obj, bin := filepath.Join(dir, "f.s"), filepath.Join(dir, "f.o"), filepath.Join(dir, "f.bin")
+	if err := os.WriteFile(file, src.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command(as, "--64", "-o", obj, file).CombinedOutput(); err != nil {
+		t.Fatalf("as: %v\n%s\n%s", err, out, src.Bytes())
+	}
+	if out, err := exec.Command(objcopy, "-O", "binary", "-j", ".text", obj, bin).CombinedOutput(); err != nil {
+		t.Fatalf("objcopy: %v\n%s", err, out)
+	}
+	got, err := os.ReadFile(bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("as of\n%s= % x\nwant % x", src.Bytes(), got, want)
+	}
+}
+
+func TestGnuAsm(t *testing.T) {
+	var b Builder
+	b.MOV_MR(RAX, RDI)
+	b.Label("loop")
+	b.ADD_RM(RAX, Sym("one", 0))
+	b.DEC_M(RSI)
+	b.JNZ(Label("loop"))
+	b.RET_NP()
+
+	a := &GnuAsm{
+		Funcs: []GnuFunc{{Name: "add_n", Code: &b}},
+		Data:  []GnuData{{Name: "one", Bytes: []byte{1, 0, 0, 0, 0, 0, 0, 0}}},
+	}
+	var buf bytes.Buffer
+	if err := a.WriteAsm(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `# Code generated by github.com/dave/asm/x86. DO NOT EDIT.
+
+	.section .rodata
+	.balign 16
+	.type one, @object
+	.size one, 8
+one:
+	.byte 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00
+
+	.text
+
+	.globl add_n
+	.type add_n, @function
+add_n:
+	movq %rdi, %rax
+.Ladd_n_loop:
+	addq one(%rip), %rax
+	decq %rsi
+	jnz .Ladd_n_loop
+	retq
+	.size add_n, .-add_n
+
+	.section .note.GNU-stack,"",@progbits
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WriteAsm =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,584 @@
+package x86
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"strings"
+	"sync"
+)
+
+// GoAsm describes a Go assembler source file of functions built with a
+// Builder, and the Go file declaring them. Name the files with an _amd64
+// suffix, e.g. kernels_amd64.s and kernels_amd64.go, so that they are only
+// built for amd64.
+type GoAsm struct {
+	Package string // package of the declaration file
+	Funcs   []GoFunc
+	Data    []GoData
+}
+
+// GoFunc is a function of a GoAsm file.
+//
+// The function has no frame and is called with the stack-based calling
+// convention of Go assembly (ABI0): on entry the first argument is at
+// 8(SP), after the return address, and the results follow the arguments.
+// Memory operands addressing the arguments this way, e.g.
+// Mem{Base: RSP, Disp: 8}, are printed as references to their names, e.g.
+// a+0(FP), so that go vet can check them against the declaration.
+type GoFunc struct {
+	Name      string   // function name, e.g. "addAVX2"
+	Signature string   // parameters and results, e.g. "(a, b []float32) float32"
+	Doc       string   // doc comment of the declaration, without the slashes
+	Flags     string   // TEXT flags; NOSPLIT if empty
+	Code      *Builder // the body, which must end in a RET or a jump
+}
+
+// GoData is a read-only symbol of a GoAsm file, e.g. a table of constants
+// referenced from code with Sym.
+type GoData struct {
+	Name  string // symbol name, e.g. "masks<>" for a symbol private to the file
+	Bytes []byte
+}
+
+// WriteAsm writes the assembler source.
+func (a *GoAsm) WriteAsm(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by github.com/dave/asm/x86. DO NOT EDIT.\n\n")
+	buf.WriteString("#include \"textflag.h\"\n")
+	for _, d := range a.Data {
+		buf.WriteString("\n")
+		for off := 0; off < len(d.Bytes); {
+			n := 8
+			for n > len(d.Bytes)-off {
+				n /= 2
+			}
+			var v uint64
+			for i := n - 1; i >= 0; i-- {
+				v = v<<8 | uint64(d.Bytes[off+i])
+			}
+			fmt.Fprintf(&buf, "DATA %s+%d(SB)/%d, $0x%0*x\n", goSymbol(d.Name), off, n, 2*n, v)
+			off += n
+		}
+		fmt.Fprintf(&buf, "GLOBL %s(SB), RODATA|NOPTR, $%d\n", goSymbol(d.Name), len(d.Bytes))
+	}
+	for _, f := range a.Funcs {
+		frame, err := newGoFrame(f.Signature)
+		if err != nil {
+			return fmt.Errorf("x86: %s: %v", f.Name, err)
+		}
+		flags := f.Flags
+		if flags == "" {
+			flags = "NOSPLIT"
+		}
+		fmt.Fprintf(&buf, "\n// func %s%s\n", f.Name, f.Signature)
+		fmt.Fprintf(&buf, "TEXT %s(SB), %s, $0-%d\n", goSymbol(f.Name), flags, frame.size)
+		if err := f.Code.writeGo(&buf, frame); err != nil {
+			return fmt.Errorf("x86: %s: %v", f.Name, err)
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteDecl writes the Go file declaring the functions.
+func (a *GoAsm) WriteDecl(w io.Writer) error {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by github.com/dave/asm/x86. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n", a.Package)
+	for _, f := range a.Funcs {
+		buf.WriteString("\n")
+		if f.Doc != "" {
+			for _, line := range strings.Split(strings.TrimRight(f.Doc, "\n"), "\n") {
+				buf.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+			}
+		}
+		fmt.Fprintf(&buf, "func %s%s\n", f.Name, f.Signature)
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// goSymbol returns the assembler name of a package level symbol: Go
+// identifiers get the middle dot that refers to the current package.
+func goSymbol(name string) string {
+	if strings.ContainsAny(name, "·.<") {
+		return name
+	}
+	return "·" + name
+}
+
+// GoSyntax returns the instruction in Go assembler syntax, e.g.
+// "ADDQ BX, AX".
+func (i Instruction) GoSyntax() (string, error) {
+	return i.goSyntax(target{mode: Mode64}, nil)
+}
+
+// writeGo writes the program as the body of a TEXT block.
+func (b *Builder) writeGo(w io.Writer, frame *goFrame) error {
+	if b == nil {
+		return fmt.Errorf("no code")
+	}
+	if b.err != nil {
+		return b.err
+	}
+	if b.mode() != Mode64 {
+		return fmt.Errorf("x86: Go assembler output is only written for 64-bit mode, not %s", b.mode())
+	}
+	at := b.labelsAt()
+	for n := 0; n <= len(b.instructions); n++ {
+		for _, l := range at[n] {
+			fmt.Fprintf(w, "%s:\n", l)
+		}
+		if n == len(b.instructions) {
+			break
+		}
+		s, err := b.instructions[n].goSyntax(b.target(), frame)
+		if err != nil {
+			return fmt.Errorf("instruction %d: %v", n, err)
+		}
+		fmt.Fprintf(w, "\t%s\n", s)
+	}
+	return nil
+}
+
+func (i Instruction) goSyntax(t target, frame *goFrame) (string, error) {
+	c, err := i.form(t)
+	if err != nil {
+		return "", err
+	}
+	if goRaw(c, i.Args) {
+		return i.goBytes(t)
+	}
+	op, order := syntaxOrder(c, canonical(c.form).GoSyntax)
+	op = mnemonicIn(Mode64, op, c, i.Args)
+	if g, ok := goMnemonics[op]; ok {
+		op = g
+	}
+	switch {
+	case op == "MOVD":
+		// MOVD is an alias of MOVQ in the Go assembler.
+		op = "MOVL"
+	case op == "IMULW" || op == "IMULL" || op == "IMULQ":
+		if len(order) == 3 {
+			op = "IMUL3" + op[4:]
+		}
+	case goSized[op]:
+		op += map[int]string{16: "W", 32: "L", 64: "Q"}[c.args[0].width]
+	case strings.HasPrefix(op, "CMP") && len(order) == 3 && c.enc.VEX == nil:
+		// CMPPS and friends take the operands in Intel order,
+		// source first, and the predicate last.
+		order = []int{1, 0, 2}
+	}
+	op += goDecorations(i.Args)
+	args := make([]string, len(order))
+	for n, k := range order {
+		if args[n], err = goAsmOperand(i.Args[k], frame); err != nil {
+			return "", fmt.Errorf("%s: %v", i, err)
+		}
+	}
+	if len(args) == 0 {
+		return op, nil
+	}
+	return op + " " + strings.Join(args, ", "), nil
+}
+
+// goDecorations returns the opcode suffixes that the Go assembler uses for
+// the EVEX decorations of the operands: a rounding mode or .BCST, then .Z.
+func goDecorations(ops []Operand) string {
+	s, zero := "", false
+	for _, op := range ops {
+		switch op := op.(type) {
+		case Rounded:
+			if int(op.Mode) < len(roundings) {
+				s = "." + roundings[op.Mode].goop
+			}
+		case Mem:
+			if op.Broadcast != 0 {
+				s = ".BCST"
+			}
+		case Masked:
+			zero = op.Zero
+		}
+	}
+	if zero {
+		s += ".Z"
+	}
+	return s
+}
+
+// goMnemonics renames the 64-bit mode mnemonics of x86spec's Go syntax that
+// the Go assembler spells differently.
+var goMnemonics = map[string]string{
+	"CALLQ":   "CALL",
+	"JMPQ":    "JMP",
+	"RETQ":    "RET",
+	"ENTERQ":  "ENTER",
+	"LGDTL":   "LGDT",
+	"LIDTL":   "LIDT",
+	"MOVABSB": "MOVB",
+	"FLDENVL": "FLDENV",
+	"FRSTORL": "FRSTOR",
+}
+
+// goSized are the instructions whose Go mnemonic takes the suffix of the
+// operand size, which x86spec leaves out.
+var goSized = map[string]bool{
+	"RDRAND":   true,
+	"RDSEED":   true,
+	"RDFSBASE": true,
+	"RDGSBASE": true,
+	"WRFSBASE": true,
+	"WRGSBASE": true,
+}
+
+// goRaw reports whether the Go assembler has no syntax for an instruction,
+// or assembles it differently: x87, MMX and MPX instructions, port I/O,
+// moves to and from segment, control and debug registers, far branches, and
+// CRC32 of a byte into a 64-bit register, among others.
+func goRaw(c *compiledForm, ops []Operand) bool {
+	if op := c.enc.Opcode[0]; c.enc.wait() || c.enc.VEX == nil && (op >= 0xd8 && op <= 0xdf || op == 0x9b) {
+		return true
+	}
+	for _, o := range ops {
+		if r, ok := o.(Register); ok {
+			switch r.Info().Class {
+			case ClassMMX, ClassSeg, ClassCtrl, ClassDebug, ClassBnd:
+				return true
+			}
+		}
+	}
+	switch c.form.Mnemonic() {
+	case "IN", "OUT", "NOP", "ENTER", "PREFETCHW", "PREFETCHWT1", "MOVNTSS", "MOVNTSD",
+		"CALL_FAR", "JMP_FAR", "CVTPD2PI", "CVTPI2PD", "CVTPI2PS", "CVTPS2PI", "CVTTPD2PI", "CVTTPS2PI":
+		return true
+	case "RET":
+		return len(ops) > 0
+	case "RET_FAR":
+		// RETFQ is REX.W CB, the 64-bit far return; x86spec has only the
+		// 32-bit one.
+		return true
+	case "CRC32":
+		// CRC32B leaves out the REX.W of a 64-bit destination.
+		return c.enc.REXW && c.args[1].width == 8
+	case "BSWAP", "MOVSXD":
+		return c.args[0].width != 64
+	}
+	return false
+}
+
+// goBytes returns the instruction as BYTE directives, followed by its Intel
+// syntax as a comment.
+func (i Instruction) goBytes(t target) (string, error) {
+	code, err := i.encode(t)
+	if err != nil {
+		return "", err
+	}
+	s := make([]string, len(code))
+	for n, b := range code {
+		s[n] = fmt.Sprintf("BYTE $0x%02x", b)
+	}
+	return strings.Join(s, "; ") + " // " + i.String(), nil
+}
+
+// form returns the form that encodes the instruction for the target. Labels
+// and symbols stand for a displacement that is not known yet.
+func (i Instruction) form(t target) (*compiledForm, error) {
+	ops := make([]Operand, len(i.Args))
+	for n, a := range i.Args {
+		switch a := a.(type) {
+		case Label:
+			ops[n] = Rel(0)
+		case Mem:
+			if a.Symbol != "" && t.mode == Mode64 {
+				a = Mem{Base: RIP, Size: a.Size}
+			} else if a.Symbol != "" {
+				a = Mem{Size: a.Size}
+			}
+			ops[n] = a
+		default:
+			ops[n] = a
+		}
+	}
+	c, _, err := selectForm(t, i.Name, i.size(), ops)
+	return c, err
+}
+
+// canonical returns the form that a pseudo form, such as CMOVZ for CMOVE,
+// is an alias of: the form with the same encoding and arguments.
+func canonical(f *Form) *Form {
+	if !f.HasTag("pseudo") {
+		return f
+	}
+	canonicalOnce.Do(indexCanonical)
+	if g := canonicalForms[f.Opcode+" "+strings.Join(f.Args(), ", ")]; g != nil {
+		return g
+	}
+	return f
+}
+
+var (
+	canonicalOnce  sync.Once
+	canonicalForms map[string]*Form // by opcode and arguments
+)
+
+func indexCanonical() {
+	canonicalForms = map[string]*Form{}
+	for i := range forms {
+		f := &forms[i]
+		if f.Valid64 && !f.HasTag("pseudo") && !f.HasTag("pseudo64") {
+			canonicalForms[f.Opcode+" "+strings.Join(f.Args(), ", ")] = f
+		}
+	}
+}
+
+// syntaxOrder splits the Go or GNU syntax of a form into its mnemonic and,
+// for each of its arguments, the index of the same argument in the Intel
+// syntax.
+func syntaxOrder(c *compiledForm, syntax string) (string, []int) {
+	op, args := syntax, []string(nil)
+	if i := strings.IndexByte(syntax, ' '); i >= 0 {
+		op, args = syntax[:i], strings.Split(syntax[i+1:], ", ")
+	}
+	intel := c.form.Args()
+	used := make([]bool, len(intel))
+	order := make([]int, len(args))
+	for n, a := range args {
+		order[n] = len(intel) - 1 - n // reversed, unless the names say otherwise
+		for k, b := range intel {
+			if a == b && !used[k] {
+				order[n] = k
+				break
+			}
+		}
+		used[order[n]] = true
+	}
+	return op, order
+}
+
+// mnemonicIn picks the mnemonic for mode out of x86spec's spellings for
+// several modes or operand kinds: "PUSHW/PUSHL/PUSHQ" by 16-, 32- and
+// 64-bit mode, unless the operand-size prefix picks the other of the first
+// two, "STR{Q/W}" by register or memory operand, and "CALLQ*" for an
+// indirect branch.
+func mnemonicIn(mode Mode, op string, c *compiledForm, ops []Operand) string {
+	op = strings.TrimSuffix(op, "*")
+	if i := strings.IndexByte(op, '{'); i >= 0 && strings.HasSuffix(op, "}") {
+		sizes := strings.Split(op[i+1:len(op)-1], "/")
+		suffix := sizes[0]
+		for _, o := range ops {
+			if _, ok := o.(Mem); ok {
+				suffix = sizes[len(sizes)-1]
+			}
+		}
+		return op[:i] + suffix
+	}
+	if strings.Contains(op, "/") {
+		modes := strings.Split(op, "/")
+		n := map[Mode]int{Mode16: 0, Mode32: 1, Mode64: 2}[mode]
+		switch {
+		case c.operandSizePrefix(mode) && mode == Mode16:
+			n = 1
+		case c.operandSizePrefix(mode):
+			n = 0
+		}
+		if n >= len(modes) {
+			n = len(modes) - 1
+		}
+		return modes[n]
+	}
+	return op
+}
+
+// goRegisters are the Go assembler names of the general purpose registers,
+// whatever their width.
+var goRegisters = [...]string{"AX", "CX", "DX", "BX", "SP", "BP", "SI", "DI", "R8", "R9", "R10", "R11", "R12", "R13", "R14", "R15"}
+
+// goRegister returns the Go assembler name of a register.
+func goRegister(r Register) (string, error) {
+	info := r.Info()
+	switch info.Class {
+	case ClassGP:
+		if info.Width == 8 {
+			switch info.Name {
+			case "SPL", "BPL", "SIL", "DIL":
+				return info.Name[:2] + "B", nil
+			}
+			return info.Name, nil
+		}
+		return goRegisters[info.Num], nil
+	case ClassVec:
+		return fmt.Sprintf("%c%d", "XYZ"[info.Width/256], info.Num), nil
+	case ClassMask:
+		return fmt.Sprintf("K%d", info.Num), nil
+	case ClassX87:
+		return fmt.Sprintf("F%d", info.Num), nil
+	case ClassMMX:
+		return fmt.Sprintf("M%d", info.Num), nil
+	case ClassSeg, ClassCtrl, ClassDebug:
+		return info.Name, nil
+	}
+	return "", fmt.Errorf("%s has no Go assembler name", r)
+}
+
+// goAsmOperand returns an operand in Go assembler syntax.
+func goAsmOperand(op Operand, frame *goFrame) (string, error) {
+	switch op := op.(type) {
+	case Masked:
+		// The opmask is an operand of its own, just before the destination.
+		k, err := goRegister(op.Mask)
+		if err != nil {
+			return "", err
+		}
+		d, err := goAsmOperand(op.Dest, frame)
+		if err != nil {
+			return "", err
+		}
+		return k + ", " + d, nil
+	case Rounded:
+		return goAsmOperand(op.Reg, frame)
+	case Register:
+		return goRegister(op)
+	case Imm:
+		return fmt.Sprintf("$%d", int64(op)), nil
+	case Label:
+		return string(op), nil
+	case Rel:
+		return "", fmt.Errorf("the Go assembler has no byte displacements; use a Label")
+	case Mem:
+		return goAsmMem(op, frame)
+	}
+	return "", fmt.Errorf("operand %s has no Go assembler syntax", op)
+}
+
+func goAsmMem(m Mem, frame *goFrame) (string, error) {
+	if m.Symbol != "" {
+		return fmt.Sprintf("%s%s(SB)", goSymbol(m.Symbol), goOffset(m.Disp)), nil
+	}
+	if m.Segment != 0 {
+		return "", fmt.Errorf("the Go assembler has no segment overrides")
+	}
+	if m.Base.Info().Class == ClassIP {
+		return "", fmt.Errorf("the Go assembler has no RIP-relative operands; use Sym")
+	}
+	if m.Base == RSP && !m.HasIndex() && frame != nil {
+		if name, ok := frame.names[m.Disp-8]; ok {
+			return fmt.Sprintf("%s+%d(FP)", name, m.Disp-8), nil
+		}
+	}
+	s := ""
+	if m.Disp != 0 || m.Base == 0 && !m.HasIndex() {
+		s = fmt.Sprint(m.Disp)
+	}
+	if m.Base != 0 {
+		r, err := goRegister(m.Base)
+		if err != nil {
+			return "", err
+		}
+		s += "(" + r + ")"
+	}
+	if m.HasIndex() {
+		r, err := goRegister(m.Index)
+		if err != nil {
+			return "", err
+		}
+		s += fmt.Sprintf("(%s*%d)", r, m.Scale)
+	}
+	return s, nil
+}
+
+func goOffset(disp int64) string {
+	if disp == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%+d", disp)
+}
+
+// goFrame is the argument frame of a function in the ABI0 calling
+// convention, as described by its Go signature.
+type goFrame struct {
+	size  int64            // size of the arguments and results
+	names map[int64]string // names of the words of the frame, as go vet knows them
+}
+
+var amd64Sizes = types.SizesFor("gc", "amd64")
+
+// newGoFrame lays out the frame of a signature such as "(a, b int) int".
+// The signature can only use predeclared types.
+func newGoFrame(signature string) (*goFrame, error) {
+	tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, "func"+signature)
+	if err != nil {
+		return nil, err
+	}
+	sig, ok := tv.Type.(*types.Signature)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a function signature", signature)
+	}
+	f := &goFrame{names: map[int64]string{}}
+	for i := 0; i < sig.Params().Len(); i++ {
+		p := sig.Params().At(i)
+		f.add(p.Name(), p.Type())
+	}
+	f.size = align(f.size, 8)
+	for i := 0; i < sig.Results().Len(); i++ {
+		r := sig.Results().At(i)
+		name := r.Name()
+		if name == "" {
+			name = "ret"
+			if i > 0 {
+				name += fmt.Sprint(i)
+			}
+		}
+		f.add(name, r.Type())
+	}
+	f.size = align(f.size, 8)
+	return f, nil
+}
+
+// add appends an argument to the frame.
+func (f *goFrame) add(name string, t types.Type) {
+	f.size = align(f.size, amd64Sizes.Alignof(t))
+	f.name(name, t, f.size)
+	f.size += amd64Sizes.Sizeof(t)
+}
+
+// name records the names go vet gives the parts of an argument at off.
+func (f *goFrame) name(name string, t types.Type, off int64) {
+	if name == "" || name == "_" {
+		return
+	}
+	switch t := t.Underlying().(type) {
+	case *types.Basic:
+		switch t.Kind() {
+		case types.String:
+			f.names[off] = name + "_base"
+			f.names[off+8] = name + "_len"
+			return
+		case types.Complex64, types.Complex128:
+			size := amd64Sizes.Sizeof(t) / 2
+			f.names[off] = name + "_real"
+			f.names[off+size] = name + "_imag"
+			return
+		}
+	case *types.Slice:
+		f.names[off] = name + "_base"
+		f.names[off+8] = name + "_len"
+		f.names[off+16] = name + "_cap"
+		return
+	case *types.Interface:
+		if t.Empty() {
+			f.names[off] = name + "_type"
+		} else {
+			f.names[off] = name + "_itable"
+		}
+		f.names[off+8] = name + "_data"
+		return
+	}
+	f.names[off] = name
+}
+
+func align(n, a int64) int64 {
+	return (n + a - 1) / a * a
+}
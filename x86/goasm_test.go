@@ -0,0 +1,211 @@
+package x86
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGoSyntax(t *testing.T) {
+	tests := []struct {
+		inst Instruction
+		want string
+	}{
+		{Instruction{"ADD", "ADD_MR", []Operand{RAX, RBX}}, "ADDQ BX, AX"},
+		{Instruction{"ADD", "ADD_MI", []Operand{Mem{Base: RBX, Index: RCX, Scale: 8, Disp: 16, Size: M32}, Imm(1)}}, "ADDL $1, 16(BX)(CX*8)"},
+		{Instruction{"MOVZX", "MOVZX", []Operand{EAX, SPL}}, "MOVBLZX SPB, AX"},
+		{Instruction{"CMOVZ", "CMOVZ", []Operand{RAX, RBX}}, "CMOVQEQ BX, AX"},
+		{Instruction{"IMUL", "IMUL_RMI", []Operand{R9, R10, Imm(18)}}, "IMUL3Q $18, R10, R9"},
+		{Instruction{"CMPPS", "CMPPS", []Operand{XMM9, XMM10, Imm(1)}}, "CMPPS X10, X9, $1"},
+		{Instruction{"MOVD", "MOVD_RM", []Operand{XMM1, EAX}}, "MOVL AX, X1"},
+		{Instruction{"RDRAND", "RDRAND", []Operand{RAX}}, "RDRANDQ AX"},
+		{Instruction{"VADDPS", "VADDPS_RVM", []Operand{YMM1, YMM12, Mem{Base: R8, Disp: 64}}}, "VADDPS 64(R8), Y12, Y1"},
+		{Instruction{"MOVUPS", "MOVUPS_RM", []Operand{XMM0, Sym("masks<>", 16)}}, "MOVUPS masks<>+16(SB), X0"},
+		{Instruction{"JNZ", "JNZ", []Operand{Label("loop")}}, "JNE loop"},
+		{Instruction{"FADD", "FADD", []Operand{ST0, ST3}}, "BYTE $0xd8; BYTE $0xc3 // FADD ST(0), ST(3)"},
+		{Instruction{"RET_FAR", "RET_FAR_ZO", nil}, "BYTE $0xcb // RET_FAR"},
+		{Instruction{"CRC32", "CRC32", []Operand{RAX, BL}}, "BYTE $0xf2; BYTE $0x48; BYTE $0x0f; BYTE $0x38; BYTE $0xf0; BYTE $0xc3 // CRC32 RAX, BL"},
+		{Instruction{"VADDPS", "VADDPS_FV", []Operand{Mask(ZMM1, K1).Z(), ZMM2, Round(ZMM3, RZSAE)}}, "VADDPS.RZ_SAE.Z Z3, Z2, K1, Z1"},
+		{Instruction{"VADDPS", "VADDPS_FV", []Operand{ZMM1, ZMM2, Mem{Base: RAX}.Bcst(16)}}, "VADDPS.BCST (AX), Z2, Z1"},
+		{Instruction{"VCMPPS", "VCMPPS_FV", []Operand{Mask(K1, K2), ZMM2, Round(ZMM3, SAE), Imm(1)}}, "VCMPPS.SAE $1, Z3, Z2, K2, K1"},
+	}
+	for _, tt := range tests {
+		got, err := tt.inst.GoSyntax()
+		if err != nil {
+			t.Errorf("%s: %v", tt.inst, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: GoSyntax = %q, want %q", tt.inst, got, tt.want)
+		}
+	}
+
+	for _, inst := range []Instruction{
+		{"JNZ", "JNZ", []Operand{Rel(2)}},
+		{"MOV", "MOV_RM", []Operand{RAX, Mem{Base: RIP, Disp: 16}}},
+		{"MOV", "MOV_RM", []Operand{RAX, Mem{Disp: 16, Segment: GS}}},
+	} {
+		if s, err := inst.GoSyntax(); err == nil {
+			t.Errorf("%s: GoSyntax = %q, want an error", inst, s)
+		}
+	}
+}
+
+// TestGoAsmBytes checks that the Go assembler turns the Go syntax of
+// instructions it spells differently, or not at all, into our encoding.
+func TestGoAsmBytes(t *testing.T) {
+	gotool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("no go command")
+	}
+	var b Builder
+	b.CALL_FAR_M(Mem{Base: RAX, Size: M16Seg64})
+	b.CALL_FAR_M(Mem{Base: RAX, Size: M16Seg32})
+	b.JMP_FAR_M(Mem{Base: RAX, Size: M16Seg64})
+	b.JMP_FAR_M(Mem{Base: RBX, Size: M16Seg32})
+	b.MOVNTSS(Mem{Base: RAX}, XMM1)
+	b.MOVNTSD(Mem{Base: R8, Disp: 8}, XMM9)
+	b.RET_FAR_ZO()
+	b.RET_FAR_I(Imm(8))
+	b.CRC32(RAX, BL)
+	b.CRC32(EAX, BL)
+	b.CRC32(RAX, RBX)
+	b.RET_NP()
+
+	want, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var src bytes.Buffer
+	src.WriteString("TEXT ·f(SB), 4, $0-0\n")
+	if err := b.writeGo(&src, nil); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "f.s")
+	if err := os.WriteFile(file, src.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(gotool, "tool", "asm", "-p", "f", "-S", "-o", filepath.Join(dir, "f.o"), file)
+	cmd.Env = append(os.Environ(), "GOOS=linux", "GOARCH=amd64")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go tool asm: %v\n%s\n%s", err, out, src.Bytes())
+	}
+
+	// The listing ends with the code as lines of an offset, up to 16
+	// bytes in hex and the same bytes as text, two spaces apart.
+	var got []byte
+	for _, line := range strings.Split(string(out), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 || !strings.HasPrefix(f[0], "0x") || len(f[1]) != 2 {
+			continue
+		}
+		line = strings.TrimSpace(line)[len(f[0]):]
+		if i := strings.Index(line, "  "); i >= 0 {
+			line = line[:i]
+		}
+		code, err := hex.DecodeString(strings.Replace(line, " ", "", -1))
+		if err != nil {
+			t.Fatalf("listing line %q: %v", line, err)
+		}
+		got = append(got, code...)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("go tool asm of\n%s= % x\nwant % x", src.Bytes(), got, want)
+	}
+}
+
+func TestGoAsm(t *testing.T) {
+	var b Builder
+	b.MOV_RM(RAX, Mem{Base: RSP, Disp: 8, Size: M64})
+	b.MOV_RM(RCX, Mem{Base: RSP, Disp: 16, Size: M64})
+	b.Label("loop")
+	b.ADD_RM(RAX, Sym("one<>", 0))
+	b.DEC_M(RCX)
+	b.JNZ(Label("loop"))
+	b.MOV_MR(Mem{Base: RSP, Disp: 24, Size: M64}, RAX)
+	b.RET_NP()
+
+	a := &GoAsm{
+		Package: "kernels",
+		Funcs: []GoFunc{{
+			Name:      "addN",
+			Signature: "(x int, n int) int",
+			Doc:       "addN returns x plus n.",
+			Code:      &b,
+		}},
+		Data: []GoData{{Name: "one<>", Bytes: []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0}}},
+	}
+	var asm bytes.Buffer
+	if err := a.WriteAsm(&asm); err != nil {
+		t.Fatal(err)
+	}
+	want := `// Code generated by github.com/dave/asm/x86. DO NOT EDIT.
+
+#include "textflag.h"
+
+DATA one<>+0(SB)/8, $0x0000000000000001
+DATA one<>+8(SB)/4, $0x00000002
+GLOBL one<>(SB), RODATA|NOPTR, $12
+
+// func addN(x int, n int) int
+TEXT ·addN(SB), NOSPLIT, $0-24
+	MOVQ x+0(FP), AX
+	MOVQ n+8(FP), CX
+loop:
+	ADDQ one<>(SB), AX
+	DECQ CX
+	JNE loop
+	MOVQ AX, ret+16(FP)
+	RET
+`
+	if got := asm.String(); got != want {
+		t.Errorf("WriteAsm =\n%s\nwant\n%s", got, want)
+	}
+
+	var decl bytes.Buffer
+	if err := a.WriteDecl(&decl); err != nil {
+		t.Fatal(err)
+	}
+	want = `// Code generated by github.com/dave/asm/x86. DO NOT EDIT.
+
+package kernels
+
+// addN returns x plus n.
+func addN(x int, n int) int
+`
+	if got := decl.String(); got != want {
+		t.Errorf("WriteDecl =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGoFrame(t *testing.T) {
+	f, err := newGoFrame("(s []float32, name string, b byte, _ int) (float64, error)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int64]string{
+		0: "s_base", 8: "s_len", 16: "s_cap",
+		24: "name_base", 32: "name_len",
+		40: "b",
+		56: "ret",
+		64: "ret1_itable",
+		72: "ret1_data",
+	}
+	if f.size != 80 {
+		t.Errorf("size = %d, want 80", f.size)
+	}
+	for off, name := range want {
+		if f.names[off] != name {
+			t.Errorf("name at %d = %q, want %q", off, f.names[off], name)
+		}
+	}
+	if len(f.names) != len(want) {
+		t.Errorf("names = %v, want %v", f.names, want)
+	}
+}
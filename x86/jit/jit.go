@@ -0,0 +1,89 @@
+// Package jit loads machine code into executable memory and calls it from
+// Go.
+//
+// Code is mapped writable, filled, and then made read-only and executable,
+// so no page is ever writable and executable at once (W^X).
+//
+// # Calling convention
+//
+// A loaded function is called as a Go func value, so it receives its
+// arguments the way the Go compiler passes them on amd64 (the internal
+// register ABI): integer and pointer arguments in RAX, RBX, RCX, RDI, RSI,
+// R8, R9, R10 and R11, floating point arguments in X0 to X14, and results in
+// the same registers starting again from RAX and X0. The function must
+// return with RET, must preserve RSP, RBP, R14 (the current goroutine) and
+// R15, and must leave X15 zero. Every other register may be clobbered; DX
+// holds the address of the func value on entry.
+//
+// The code runs on the goroutine's stack without a stack check and is
+// invisible to the garbage collector and to tracebacks, so it should be a
+// leaf function that uses no more than a few hundred bytes of stack and
+// does not hold the only reference to any Go memory.
+package jit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Func is machine code loaded into executable memory.
+type Func struct {
+	entry uintptr // address of the code: a Func is its own func value
+	mem   []byte
+}
+
+// ErrReleased is returned when a released Func is used.
+var ErrReleased = errors.New("jit: code has been released")
+
+// Load copies code into a new executable mapping.
+func Load(code []byte) (*Func, error) {
+	if len(code) == 0 {
+		return nil, errors.New("jit: no code")
+	}
+	mem, err := mapExec(code)
+	if err != nil {
+		return nil, err
+	}
+	return &Func{entry: uintptr(unsafe.Pointer(&mem[0])), mem: mem}, nil
+}
+
+// Bind sets the func variable that fn points to, e.g. a *func(a, b int) int,
+// to call the code. The signature is not checked against the code: it is
+// only a promise about the registers the code reads and writes, as
+// described in the package documentation. The variable must not be called
+// after the code is released.
+func (f *Func) Bind(fn interface{}) error {
+	if f.mem == nil {
+		return ErrReleased
+	}
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("jit: Bind of %T, want a pointer to a func variable", fn)
+	}
+	// A func value is a pointer to a word holding the code address,
+	// followed by any closure context, which is where entry lives.
+	*(*unsafe.Pointer)(unsafe.Pointer(v.Pointer())) = unsafe.Pointer(f)
+	return nil
+}
+
+// Call calls code that takes no arguments and returns nothing.
+func (f *Func) Call() error {
+	var fn func()
+	if err := f.Bind(&fn); err != nil {
+		return err
+	}
+	fn()
+	return nil
+}
+
+// Release unmaps the code.
+func (f *Func) Release() error {
+	if f.mem == nil {
+		return ErrReleased
+	}
+	err := unmap(f.mem)
+	f.mem, f.entry = nil, 0
+	return err
+}
@@ -0,0 +1,93 @@
+//go:build linux && amd64
+// +build linux,amd64
+
+package jit
+
+import (
+	"testing"
+
+	"github.com/dave/asm/x86"
+)
+
+func TestAdd(t *testing.T) {
+	var b x86.Builder
+	b.ADD_MR(x86.RAX, x86.RBX)
+	b.RET_NP()
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Load(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Release()
+
+	var add func(a, b int) int
+	if err := f.Bind(&add); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range [][3]int{{1, 2, 3}, {-5, 3, -2}, {1 << 40, 1 << 40, 1 << 41}} {
+		if got := add(tt[0], tt[1]); got != tt[2] {
+			t.Errorf("add(%d, %d) = %d, want %d", tt[0], tt[1], got, tt[2])
+		}
+	}
+}
+
+func TestLoop(t *testing.T) {
+	// sum returns 1 + 2 + ... + n.
+	var b x86.Builder
+	b.XOR_MR(x86.ECX, x86.ECX)
+	b.TEST_MR(x86.RAX, x86.RAX)
+	b.JZ(x86.Label("done"))
+	b.Label("loop")
+	b.ADD_MR(x86.RCX, x86.RAX)
+	b.DEC_M(x86.RAX)
+	b.JNZ(x86.Label("loop"))
+	b.Label("done")
+	b.MOV_MR(x86.RAX, x86.RCX)
+	b.RET_NP()
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Load(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Release()
+
+	var sum func(n uint64) uint64
+	if err := f.Bind(&sum); err != nil {
+		t.Fatal(err)
+	}
+	if got := sum(0); got != 0 {
+		t.Errorf("sum(0) = %d", got)
+	}
+	if got := sum(100); got != 5050 {
+		t.Errorf("sum(100) = %d, want 5050", got)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := Load(nil); err == nil {
+		t.Error("Load(nil) succeeded")
+	}
+	f, err := Load([]byte{0xc3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Call(); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := f.Bind(&n); err == nil {
+		t.Error("Bind(*int) succeeded")
+	}
+	if err := f.Release(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Call(); err != ErrReleased {
+		t.Errorf("Call after Release = %v, want ErrReleased", err)
+	}
+}
@@ -0,0 +1,27 @@
+//go:build linux && amd64
+// +build linux,amd64
+
+package jit
+
+import "syscall"
+
+// mapExec copies code into fresh pages and then makes them read-only and
+// executable.
+func mapExec(code []byte) ([]byte, error) {
+	page := syscall.Getpagesize()
+	size := (len(code) + page - 1) / page * page
+	mem, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, err
+	}
+	copy(mem, code)
+	if err := syscall.Mprotect(mem, syscall.PROT_READ|syscall.PROT_EXEC); err != nil {
+		syscall.Munmap(mem)
+		return nil, err
+	}
+	return mem, nil
+}
+
+func unmap(mem []byte) error {
+	return syscall.Munmap(mem)
+}
@@ -0,0 +1,17 @@
+//go:build !linux || !amd64
+// +build !linux !amd64
+
+package jit
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func mapExec(code []byte) ([]byte, error) {
+	return nil, fmt.Errorf("jit: not supported on %s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+func unmap(mem []byte) error {
+	return nil
+}
@@ -0,0 +1,209 @@
+// Package kernel builds a routine written for several levels of processor
+// features and binds, once, the variant that the processor it runs on
+// supports.
+//
+// A kernel is declared with its variants, best first, and a Go fallback:
+//
+//	var sum func(p []float32) float32
+//
+//	var sumKernel = &kernel.Kernel{
+//		Name: "sum",
+//		Variants: []kernel.Variant{
+//			{Name: "avx512", Features: avx512, Build: sumAVX512},
+//			{Name: "avx2", Features: avx2, Build: sumAVX2},
+//			{Name: "sse2", Features: sse2, Build: sumSSE2},
+//		},
+//		Fallback: sumGo,
+//	}
+//
+//	func init() {
+//		if _, err := sumKernel.Bind(&sum); err != nil {
+//			panic(err)
+//		}
+//	}
+//
+// Every variant is built, for the processors of its features, when the
+// kernel is bound, so a variant that uses an instruction its features do
+// not include fails on every machine rather than only on those that lack
+// it. Tests call BindVariant to run each variant the machine supports.
+package kernel
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/dave/asm/x86"
+	"github.com/dave/asm/x86/cpu"
+	"github.com/dave/asm/x86/jit"
+)
+
+// FallbackName names a kernel's Fallback in BindVariant and in the result
+// of Bind and Select.
+const FallbackName = "fallback"
+
+// Variant is an implementation of a kernel for processors with some
+// features.
+type Variant struct {
+	Name     string               // e.g. "avx2"
+	Features x86.Features         // features the code may use
+	Build    func(b *x86.Builder) // emits the code, under the jit calling convention
+}
+
+// Kernel is a routine with variants for several levels of features.
+type Kernel struct {
+	Name     string
+	Variants []Variant   // in order of preference, best first
+	Fallback interface{} // a Go func with the kernel's signature, or nil
+
+	mu    sync.Mutex
+	code  map[string][]byte
+	funcs []*jit.Func
+}
+
+// Build encodes every variant and returns its code by name. Each variant is
+// built by a Builder whose Features are the variant's, so an instruction
+// outside them is an error.
+func (k *Kernel) Build() (map[string][]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.build()
+}
+
+func (k *Kernel) build() (map[string][]byte, error) {
+	if k.code != nil {
+		return k.code, nil
+	}
+	code := map[string][]byte{}
+	for _, v := range k.Variants {
+		if v.Name == "" || v.Name == FallbackName {
+			return nil, fmt.Errorf("kernel %s: variant named %q", k.Name, v.Name)
+		}
+		if _, ok := code[v.Name]; ok {
+			return nil, fmt.Errorf("kernel %s: two variants named %s", k.Name, v.Name)
+		}
+		b := x86.Builder{Features: v.Features}
+		v.Build(&b)
+		c, err := b.Encode()
+		if err != nil {
+			return nil, fmt.Errorf("kernel %s: variant %s: %v", k.Name, v.Name, err)
+		}
+		code[v.Name] = c
+	}
+	k.code = code
+	return code, nil
+}
+
+// Select returns the name of the variant that processors with the features
+// run: the first whose features they have, or FallbackName if there is none
+// and the kernel has a fallback.
+func (k *Kernel) Select(features x86.Features) (string, error) {
+	for _, v := range k.Variants {
+		if features.Contains(v.Features) {
+			return v.Name, nil
+		}
+	}
+	if k.Fallback != nil {
+		return FallbackName, nil
+	}
+	return "", fmt.Errorf("kernel %s: no variant runs on %s, and there is no fallback", k.Name, features)
+}
+
+// Bind builds the kernel and sets the func variable that fn points to, as
+// for jit.Func.Bind, to the variant that this processor runs, which it
+// returns. If the processor's features can not be detected, as where jit
+// can not load code, fn is set to the fallback. Bind is meant to be called
+// once, at startup.
+func (k *Kernel) Bind(fn interface{}) (string, error) {
+	features, err := cpu.Detect()
+	if err != nil {
+		if k.Fallback == nil {
+			return "", fmt.Errorf("kernel %s: %v", k.Name, err)
+		}
+		if err := k.BindVariant(FallbackName, fn); err != nil {
+			return "", err
+		}
+		return FallbackName, nil
+	}
+	name, err := k.Select(features)
+	if err != nil {
+		return "", err
+	}
+	if err := k.BindVariant(name, fn); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// BindVariant builds the kernel and sets the func variable that fn points
+// to to the named variant, or to the fallback if name is FallbackName. It
+// is an error if this processor lacks the variant's features.
+func (k *Kernel) BindVariant(name string, fn interface{}) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	code, err := k.build()
+	if err != nil {
+		return err
+	}
+	if name == FallbackName {
+		return k.bindFallback(fn)
+	}
+	v, ok := k.variant(name)
+	if !ok {
+		return fmt.Errorf("kernel %s: no variant named %s", k.Name, name)
+	}
+	features, err := cpu.Detect()
+	if err != nil {
+		return fmt.Errorf("kernel %s: %v", k.Name, err)
+	}
+	if !features.Contains(v.Features) {
+		return fmt.Errorf("kernel %s: variant %s needs %s, which this processor lacks", k.Name, name, v.Features&^features)
+	}
+	f, err := jit.Load(code[name])
+	if err != nil {
+		return fmt.Errorf("kernel %s: %v", k.Name, err)
+	}
+	if err := f.Bind(fn); err != nil {
+		f.Release()
+		return err
+	}
+	k.funcs = append(k.funcs, f)
+	return nil
+}
+
+func (k *Kernel) variant(name string) (Variant, bool) {
+	for _, v := range k.Variants {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Variant{}, false
+}
+
+func (k *Kernel) bindFallback(fn interface{}) error {
+	if k.Fallback == nil {
+		return fmt.Errorf("kernel %s: no fallback", k.Name)
+	}
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("kernel %s: Bind of %T, want a pointer to a func variable", k.Name, fn)
+	}
+	f := reflect.ValueOf(k.Fallback)
+	if f.Type() != v.Elem().Type() {
+		return fmt.Errorf("kernel %s: fallback is %s, want %s", k.Name, f.Type(), v.Elem().Type())
+	}
+	v.Elem().Set(f)
+	return nil
+}
+
+// Release releases the code of every variant bound. The func variables
+// they were bound to must not be called afterwards.
+func (k *Kernel) Release() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for _, f := range k.funcs {
+		f.Release()
+	}
+	k.funcs = nil
+}
@@ -0,0 +1,120 @@
+package kernel
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/dave/asm/x86"
+	"github.com/dave/asm/x86/cpu"
+)
+
+var (
+	base = x86.NewFeatures(x86.FeatureSSE, x86.FeatureSSE2)
+	bmi1 = base.With(x86.FeatureBMI1)
+)
+
+// andNot returns a &^ b, with ANDN where BMI1 is available.
+func andNot() *Kernel {
+	return &Kernel{
+		Name: "andNot",
+		Variants: []Variant{
+			{Name: "bmi1", Features: bmi1, Build: func(b *x86.Builder) {
+				b.ANDN(x86.RAX, x86.RBX, x86.RAX)
+				b.RET_NP()
+			}},
+			{Name: "base", Features: base, Build: func(b *x86.Builder) {
+				b.NOT(x86.RBX)
+				b.AND_MR(x86.RAX, x86.RBX)
+				b.RET_NP()
+			}},
+		},
+		Fallback: func(a, b uint64) uint64 { return a &^ b },
+	}
+}
+
+func TestSelect(t *testing.T) {
+	k := andNot()
+	tests := []struct {
+		features x86.Features
+		want     string
+	}{
+		{bmi1.With(x86.FeatureAVX2), "bmi1"},
+		{base, "base"},
+		{x86.NewFeatures(x86.FeatureSSE), FallbackName},
+	}
+	for _, tt := range tests {
+		if got, err := k.Select(tt.features); err != nil || got != tt.want {
+			t.Errorf("Select(%s) = %q, %v, want %q", tt.features, got, err, tt.want)
+		}
+	}
+	k.Fallback = nil
+	if _, err := k.Select(0); err == nil {
+		t.Error("Select without a fallback succeeded")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	code, err := andNot().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != 2 || len(code["bmi1"]) == 0 || len(code["base"]) == 0 {
+		t.Errorf("Build = %v", code)
+	}
+
+	// A variant may only use the instructions of its features.
+	k := andNot()
+	k.Variants[0].Features = base
+	if _, err := k.Build(); err == nil || !strings.Contains(err.Error(), "variant bmi1") {
+		t.Errorf("Build of ANDN for SSE2 = %v, want an error naming variant bmi1", err)
+	}
+}
+
+func TestBind(t *testing.T) {
+	k := andNot()
+	defer k.Release()
+
+	var fn func(a, b uint64) uint64
+	name, err := k.Bind(&fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "linux" || runtime.GOARCH != "amd64" {
+		if name != FallbackName {
+			t.Errorf("Bind on %s/%s = %s, want the fallback", runtime.GOOS, runtime.GOARCH, name)
+		}
+	}
+	if got := fn(0xff, 0x0f); got != 0xf0 {
+		t.Errorf("%s: andNot(0xff, 0x0f) = %#x, want 0xf0", name, got)
+	}
+
+	// Every variant this processor runs gives the same result.
+	features, _ := cpu.Detect()
+	for _, v := range append(k.Variants, Variant{Name: FallbackName}) {
+		err := k.BindVariant(v.Name, &fn)
+		if v.Name != FallbackName && !features.Contains(v.Features) {
+			if err == nil {
+				t.Errorf("BindVariant(%s) succeeded on %s", v.Name, features)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("BindVariant(%s): %v", v.Name, err)
+			continue
+		}
+		for _, tt := range [][3]uint64{{0xff, 0x0f, 0xf0}, {1<<63 | 1, 1, 1 << 63}, {0, ^uint64(0), 0}} {
+			if got := fn(tt[0], tt[1]); got != tt[2] {
+				t.Errorf("%s: andNot(%#x, %#x) = %#x, want %#x", v.Name, tt[0], tt[1], got, tt[2])
+			}
+		}
+	}
+
+	var wrong func(a uint64) uint64
+	if err := k.BindVariant(FallbackName, &wrong); err == nil {
+		t.Error("BindVariant of the fallback to the wrong type succeeded")
+	}
+	if err := k.BindVariant("avx512", &fn); err == nil {
+		t.Error("BindVariant of an unknown variant succeeded")
+	}
+}
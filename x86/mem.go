@@ -0,0 +1,312 @@
+package x86
+
+import (
+	"fmt"
+	"math"
+)
+
+// Mem is a memory reference operand, addressing
+// Segment:[Base + Index*Scale + Disp].
+//
+// Base is a general purpose register, RIP or EIP for RIP-relative
+// addressing, or zero for none. Index is a general purpose register, a
+// VecReg for the VSIB addressing used by gathers and scatters, or nil for
+// none. Size optionally records which of the manual's memory forms
+// (m32, m80fp, m512byte, ...) the operand is meant to match.
+//
+// Broadcast makes an AVX-512 broadcast operand, {1toN}: a single element
+// loaded from memory and repeated Broadcast times. Its Size, if given, is
+// that of the element.
+//
+// Symbol instead addresses a symbol of the assembler output, as
+// Symbol+Disp(SB) for the Go assembler or Symbol+Disp(%rip) for GNU as.
+// Such operands are printed but not encoded.
+//
+// Use NewMem, RIPRel, Abs or Sym to build a Mem; a Mem literal is accepted
+// too, but is only checked when it is encoded.
+type Mem struct {
+	Segment   SegReg
+	Base      Reg
+	Index     Register
+	Scale     uint8
+	Disp      int64
+	Size      MemSize
+	Broadcast uint8
+	Symbol    string
+}
+
+// NewMem returns the memory operand [base + index*scale + disp], or an error
+// if the combination can not be encoded. Either base or index may be zero;
+// a VecReg index makes a VSIB operand.
+func NewMem(base Reg, index Register, scale uint8, disp int64) (Mem, error) {
+	m := Mem{Base: base, Index: index, Scale: scale, Disp: disp}
+	if err := m.Validate(); err != nil {
+		return Mem{}, err
+	}
+	return m, nil
+}
+
+// MustMem is like NewMem but panics if the operand can not be encoded.
+// It simplifies building operands from constant registers.
+func MustMem(base Reg, index Register, scale uint8, disp int64) Mem {
+	m, err := NewMem(base, index, scale, disp)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// RIPRel returns the RIP-relative memory operand [RIP + disp].
+func RIPRel(disp int32) Mem {
+	return Mem{Base: RIP, Disp: int64(disp)}
+}
+
+// Abs returns a memory operand at the absolute address addr, with neither
+// base nor index. Only the moffs forms of MOV accept addresses that do not
+// fit in 32 bits.
+func Abs(addr int64) Mem {
+	return Mem{Disp: addr}
+}
+
+// Sym returns a memory operand at offset disp from the assembler symbol
+// name, e.g. "masks<>" or "·table" in Go assembler output.
+func Sym(name string, disp int64) Mem {
+	return Mem{Symbol: name, Disp: disp}
+}
+
+// Seg returns m with the segment override s.
+func (m Mem) Seg(s SegReg) Mem {
+	m.Segment = s
+	return m
+}
+
+// Sized returns m with the size hint s.
+func (m Mem) Sized(s MemSize) Mem {
+	m.Size = s
+	return m
+}
+
+// Bcst returns m as the broadcast operand {1ton}.
+func (m Mem) Bcst(n uint8) Mem {
+	m.Broadcast = n
+	return m
+}
+
+// HasIndex reports whether m has an index register.
+func (m Mem) HasIndex() bool {
+	return m.Index != nil && m.Index.Info().Class != ClassNone
+}
+
+// VSIB reports whether m is a VSIB operand, indexed by a vector register.
+func (m Mem) VSIB() bool {
+	return m.HasIndex() && m.Index.Info().Class == ClassVec
+}
+
+// Validate returns an error if m can not be encoded in any addressing mode.
+func (m Mem) Validate() error {
+	if m.Segment != 0 && m.Segment.Info().Class != ClassSeg {
+		return fmt.Errorf("x86: %s is not a segment register", m.Segment)
+	}
+	switch m.Broadcast {
+	case 0, 2, 4, 8, 16:
+	default:
+		return fmt.Errorf("x86: broadcast {1to%d} is not 1to2, 1to4, 1to8 or 1to16", m.Broadcast)
+	}
+	if m.Symbol != "" {
+		if m.Segment != 0 || m.Base != 0 || m.HasIndex() {
+			return fmt.Errorf("x86: symbol %s can not have registers", m.Symbol)
+		}
+		return nil
+	}
+	base := m.Base.Info()
+	switch {
+	case m.Base == 0:
+	case base.Class == ClassIP:
+		if m.HasIndex() {
+			return fmt.Errorf("x86: %s-relative operand can not have an index", m.Base)
+		}
+	case base.Class != ClassGP || base.Width == 8:
+		return fmt.Errorf("x86: %s can not be used as a base register", m.Base)
+	}
+	if !m.HasIndex() {
+		if m.Scale > 1 {
+			return fmt.Errorf("x86: scale %d without an index register", m.Scale)
+		}
+		if m.Base != 0 && (m.Disp < math.MinInt32 || m.Disp > math.MaxInt32) {
+			return fmt.Errorf("x86: displacement %#x does not fit in 32 bits", m.Disp)
+		}
+		return m.validate16()
+	}
+	switch m.Scale {
+	case 1, 2, 4, 8:
+	default:
+		return fmt.Errorf("x86: scale %d is not 1, 2, 4 or 8", m.Scale)
+	}
+	if m.Disp < math.MinInt32 || m.Disp > math.MaxInt32 {
+		return fmt.Errorf("x86: displacement %#x does not fit in 32 bits", m.Disp)
+	}
+	index := m.Index.Info()
+	switch index.Class {
+	case ClassVec:
+		if m.Base != 0 && base.Width == 16 {
+			return fmt.Errorf("x86: VSIB operand can not use 16-bit base %s", m.Base)
+		}
+		return nil
+	case ClassGP:
+		if index.Width == 8 {
+			return fmt.Errorf("x86: %s can not be used as an index register", m.Index)
+		}
+		if index.Num == 4 {
+			// SIB index 100 means no index, so the stack pointer can not be one.
+			return fmt.Errorf("x86: %s can not be used as an index register", m.Index)
+		}
+		if m.Base != 0 && base.Width != index.Width {
+			return fmt.Errorf("x86: base %s and index %s have different sizes", m.Base, m.Index)
+		}
+		return m.validate16()
+	}
+	return fmt.Errorf("x86: %s can not be used as an index register", m.Index)
+}
+
+// validate16 checks the restricted forms of 16-bit addressing:
+// [BX|BP] + [SI|DI] + disp, with no scaling. [SI] and [DI] may have
+// either register as the base.
+func (m Mem) validate16() error {
+	if m.Base.Info().Width != 16 && (!m.HasIndex() || m.Index.Info().Width != 16) {
+		return nil
+	}
+	if !m.HasIndex() && (m.Base == SI || m.Base == DI) {
+		return m.validateDisp16()
+	}
+	if m.Base != 0 && m.Base != BX && m.Base != BP {
+		return fmt.Errorf("x86: %s can not be used as a 16-bit base register", m.Base)
+	}
+	if m.HasIndex() {
+		if m.Index != SI && m.Index != DI {
+			return fmt.Errorf("x86: %s can not be used as a 16-bit index register", m.Index)
+		}
+		if m.Scale != 1 {
+			return fmt.Errorf("x86: 16-bit addressing can not scale the index")
+		}
+	}
+	return m.validateDisp16()
+}
+
+func (m Mem) validateDisp16() error {
+	if m.Disp < math.MinInt16 || m.Disp > math.MaxUint16 {
+		return fmt.Errorf("x86: displacement %#x does not fit in 16 bits", m.Disp)
+	}
+	return nil
+}
+
+func (m Mem) String() string {
+	s := m.Symbol
+	if m.Base != 0 {
+		s += m.Base.String()
+	}
+	if m.HasIndex() {
+		if s != "" {
+			s += "+"
+		}
+		s += fmt.Sprintf("%s*%d", m.Index, m.Scale)
+	}
+	if m.Disp != 0 || s == "" {
+		if s != "" && m.Disp >= 0 {
+			s += "+"
+		}
+		s += fmt.Sprintf("%#x", m.Disp)
+	}
+	s = "[" + s + "]"
+	if m.Segment != 0 {
+		s = m.Segment.String() + ":" + s
+	}
+	if m.Broadcast != 0 {
+		s += fmt.Sprintf("{1to%d}", m.Broadcast)
+	}
+	return s
+}
+
+// MemSize is the size hint of a memory operand, named after the memory
+// forms used in the manual's instruction syntax.
+type MemSize uint8
+
+const (
+	MemAny       MemSize = iota // m: any size
+	M8                          // m8
+	M16                         // m16
+	M32                         // m32
+	M64                         // m64
+	M128                        // m128
+	M256                        // m256
+	M512                        // m512
+	M16Int                      // m16int
+	M32Int                      // m32int
+	M64Int                      // m64int
+	M32FP                       // m32fp
+	M64FP                       // m64fp
+	M80FP                       // m80fp
+	M80BCD                      // m80bcd
+	M80Dec                      // m80dec
+	M2Byte                      // m2byte
+	M14Or28Byte                 // m14/28byte
+	M94Or108Byte                // m94/108byte
+	M512Byte                    // m512byte
+	M16Seg16                    // m16:16
+	M16Seg32                    // m16:32
+	M16Seg64                    // m16:64
+	M16And16                    // m16&16
+	M16And32                    // m16&32
+	M16And64                    // m16&64
+	M32And32                    // m32&32
+)
+
+var memSizes = [...]struct {
+	id   string // Go identifier
+	name string // manual syntax
+	bits int
+}{
+	MemAny:       {"MemAny", "m", 0},
+	M8:           {"M8", "m8", 8},
+	M16:          {"M16", "m16", 16},
+	M32:          {"M32", "m32", 32},
+	M64:          {"M64", "m64", 64},
+	M128:         {"M128", "m128", 128},
+	M256:         {"M256", "m256", 256},
+	M512:         {"M512", "m512", 512},
+	M16Int:       {"M16Int", "m16int", 16},
+	M32Int:       {"M32Int", "m32int", 32},
+	M64Int:       {"M64Int", "m64int", 64},
+	M32FP:        {"M32FP", "m32fp", 32},
+	M64FP:        {"M64FP", "m64fp", 64},
+	M80FP:        {"M80FP", "m80fp", 80},
+	M80BCD:       {"M80BCD", "m80bcd", 80},
+	M80Dec:       {"M80Dec", "m80dec", 80},
+	M2Byte:       {"M2Byte", "m2byte", 16},
+	M14Or28Byte:  {"M14Or28Byte", "m14/28byte", 0},
+	M94Or108Byte: {"M94Or108Byte", "m94/108byte", 0},
+	M512Byte:     {"M512Byte", "m512byte", 4096},
+	M16Seg16:     {"M16Seg16", "m16:16", 32},
+	M16Seg32:     {"M16Seg32", "m16:32", 48},
+	M16Seg64:     {"M16Seg64", "m16:64", 80},
+	M16And16:     {"M16And16", "m16&16", 32},
+	M16And32:     {"M16And32", "m16&32", 48},
+	M16And64:     {"M16And64", "m16&64", 80},
+	M32And32:     {"M32And32", "m32&32", 64},
+}
+
+// Bits returns the size in bits, or 0 if it depends on the operand size
+// or is not known.
+func (s MemSize) Bits() int {
+	if int(s) < len(memSizes) {
+		return memSizes[s].bits
+	}
+	return 0
+}
+
+// String returns the manual's syntax for the memory form, e.g. "m80fp".
+func (s MemSize) String() string {
+	if int(s) < len(memSizes) {
+		return memSizes[s].name
+	}
+	return fmt.Sprintf("MemSize(%d)", uint8(s))
+}
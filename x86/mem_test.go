@@ -0,0 +1,55 @@
+package x86
+
+import "testing"
+
+func TestMemValidate(t *testing.T) {
+	tests := []struct {
+		mem Mem
+		ok  bool
+	}{
+		{Mem{Base: RBX, Index: RCX, Scale: 8, Disp: 16}, true},
+		{Mem{Base: RBX, Index: RCX, Scale: 3}, false},
+		{Mem{Base: RBX, Index: RCX}, false},
+		{Mem{Base: RBX, Index: RSP, Scale: 1}, false},
+		{Mem{Base: RSP, Index: RBX, Scale: 1}, true},
+		{Mem{Base: RBX, Index: R12, Scale: 1}, true},
+		{Mem{Base: RBX, Index: ECX, Scale: 1}, false},
+		{Mem{Base: AL}, false},
+		{Mem{Base: RIP, Disp: -8}, true},
+		{Mem{Base: RIP, Index: RCX, Scale: 1}, false},
+		{Mem{Base: RAX, Index: YMM3, Scale: 4}, true},
+		{Mem{Base: RAX, Index: K1, Scale: 4}, false},
+		{Mem{Base: RAX, Disp: 1 << 40}, false},
+		{Mem{Disp: 1 << 40}, true},
+		{Mem{Base: BP, Index: SI, Scale: 1}, true},
+		{Mem{Base: AX, Index: SI, Scale: 1}, false},
+		{Mem{Base: BX, Index: SI, Scale: 2}, false},
+		{Mem{Base: SI, Disp: 2}, true},
+		{Mem{Base: SI, Index: DI, Scale: 1}, false},
+		{Mem{Base: RAX, Segment: FS}, true},
+	}
+	for _, tt := range tests {
+		err := tt.mem.Validate()
+		if (err == nil) != tt.ok {
+			t.Errorf("%v: Validate() = %v, want ok=%v", tt.mem, err, tt.ok)
+		}
+	}
+}
+
+func TestMemString(t *testing.T) {
+	tests := []struct {
+		mem  Mem
+		want string
+	}{
+		{MustMem(RBX, RCX, 8, 16), "[RBX+RCX*8+0x10]"},
+		{MustMem(RBP, nil, 0, -8), "[RBP-0x8]"},
+		{RIPRel(0x20), "[RIP+0x20]"},
+		{Abs(0x1000).Seg(GS), "GS:[0x1000]"},
+		{MustMem(0, ZMM7, 4, 0), "[ZMM7*4]"},
+	}
+	for _, tt := range tests {
+		if got := tt.mem.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
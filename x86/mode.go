@@ -0,0 +1,125 @@
+package x86
+
+import (
+	"fmt"
+)
+
+// Mode is an operating mode of the processor, named after its default
+// address size. The mode decides which forms are available and which
+// prefixes select the operand and address sizes of a form: ADD EAX, 1 is
+// 83 C0 01 in 32-bit mode and 66 83 C0 01 in 16-bit mode, and
+// ADD RAX, 1 can only be encoded in 64-bit mode.
+//
+// Encode, EncodeSized, Assemble and Decode are those of Mode64.
+type Mode int
+
+const (
+	Mode16 Mode = 16 // real mode, virtual-8086 mode and 16-bit protected mode
+	Mode32 Mode = 32 // 32-bit protected mode and compatibility mode
+	Mode64 Mode = 64 // 64-bit mode
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Mode16, Mode32, Mode64:
+		return fmt.Sprintf("%d-bit mode", int(m))
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// Encode returns the machine code of the instruction in mode m, as Encode
+// does for 64-bit mode.
+func (m Mode) Encode(name string, ops ...Operand) ([]byte, error) {
+	_, code, err := selectForm(target{mode: m}, name, 0, ops)
+	return code, err
+}
+
+// EncodeSized returns the machine code of the instruction in mode m, as
+// EncodeSized does for 64-bit mode.
+func (m Mode) EncodeSized(name string, bits int, ops ...Operand) ([]byte, error) {
+	_, code, err := selectForm(target{mode: m}, name, bits, ops)
+	return code, err
+}
+
+// Assemble calls f with an empty Builder for mode m and returns the
+// machine code of the instructions f records in it, as Encode does.
+func (m Mode) Assemble(f func(b *Builder)) ([]byte, error) {
+	b := Builder{Mode: m}
+	f(&b)
+	return b.Encode()
+}
+
+// target is what code is built for: a mode, and optionally the features
+// of the processors that run it, which are not checked if zero.
+type target struct {
+	mode     Mode
+	features Features
+}
+
+// check returns an error if m is not one of Mode16, Mode32 and Mode64.
+func (m Mode) check() error {
+	switch m {
+	case Mode16, Mode32, Mode64:
+		return nil
+	}
+	return fmt.Errorf("x86: %s is not 16-, 32- or 64-bit mode", m)
+}
+
+// valid reports whether the form can be encoded in mode m. Forms tagged
+// pseudo64 stand for a 64-bit form with a redundant REX prefix.
+func (m Mode) valid(f *Form) bool {
+	if m == Mode64 {
+		return f.Valid64 && !f.HasTag("pseudo64")
+	}
+	return f.Valid32
+}
+
+// operandSize returns the operand size in bits that mode m uses without
+// an operand-size prefix: 16 in 16-bit mode, and 32 otherwise, unless
+// REX.W or the instruction makes it 64.
+func (m Mode) operandSize() int {
+	if m == Mode16 {
+		return 16
+	}
+	return 32
+}
+
+// overrideSize returns the operand size in bits that the 66 prefix
+// selects in mode m.
+func (m Mode) overrideSize() int {
+	if m == Mode16 {
+		return 32
+	}
+	return 16
+}
+
+// sizeTags lists the tags of the forms that apply to a single operand
+// size.
+var sizeTags = [...]struct {
+	bits             int
+	operand, address string
+}{
+	{16, "operand16", "address16"},
+	{32, "operand32", "address32"},
+	{64, "operand64", "address64"},
+}
+
+// prefixes returns whether the form needs the 66 operand-size prefix and
+// the 67 address-size prefix in mode m. A form tagged with the operand or
+// address sizes it applies to needs the prefix when the mode's default size
+// is not one of them; in 64-bit mode, a form tagged operand64 is REX.W or
+// defaults to 64 bits, like PUSH.
+func (m Mode) prefixes(f *Form) (operand, address bool) {
+	var opTagged, opDefault, addrTagged, addrDefault bool
+	for _, t := range sizeTags {
+		if f.HasTag(t.operand) {
+			opTagged = true
+			opDefault = opDefault || t.bits == m.operandSize() || m == Mode64 && t.bits == 64
+		}
+		if f.HasTag(t.address) {
+			addrTagged = true
+			addrDefault = addrDefault || t.bits == int(m)
+		}
+	}
+	return opTagged && !opDefault, addrTagged && !addrDefault
+}
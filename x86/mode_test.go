@@ -0,0 +1,135 @@
+package x86
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestModeEncode(t *testing.T) {
+	tests := []struct {
+		mode Mode
+		name string
+		ops  []Operand
+		want []byte
+	}{
+		{Mode32, "ADD", []Operand{EAX, Imm(1)}, []byte{0x83, 0xc0, 0x01}},
+		{Mode32, "INC", []Operand{ECX}, []byte{0x41}},
+		{Mode32, "PUSH", []Operand{EBP}, []byte{0x55}},
+		{Mode32, "PUSH", []Operand{BP}, []byte{0x66, 0x55}},
+		{Mode32, "MOV", []Operand{EAX, MustMem(EBX, ESI, 4, 8)}, []byte{0x8b, 0x44, 0xb3, 0x08}},
+		{Mode32, "MOV", []Operand{AX, MustMem(BX, SI, 1, 8)}, []byte{0x67, 0x66, 0x8b, 0x40, 0x08}},
+		{Mode32, "MOV", []Operand{ECX, Abs(0x1000)}, []byte{0x8b, 0x0d, 0x00, 0x10, 0x00, 0x00}},
+		{Mode32, "MOV", []Operand{EAX, Abs(0x1000)}, []byte{0xa1, 0x00, 0x10, 0x00, 0x00}},
+		{Mode32, "AAA", nil, []byte{0x37}},
+		{Mode32, "PUSHAD", nil, []byte{0x60}},
+		{Mode32, "JECXZ", []Operand{Rel(0)}, []byte{0xe3, 0x00}},
+		{Mode32, "VADDPS", []Operand{ZMM1, ZMM2, MustMem(EAX, nil, 0, 64)}, []byte{0x62, 0xf1, 0x6c, 0x48, 0x58, 0x48, 0x01}},
+
+		{Mode16, "ADD", []Operand{EAX, Imm(1)}, []byte{0x66, 0x83, 0xc0, 0x01}},
+		{Mode16, "PUSH", []Operand{BP}, []byte{0x55}},
+		{Mode16, "MOV", []Operand{AX, MustMem(BX, SI, 1, 8)}, []byte{0x8b, 0x40, 0x08}},
+		{Mode16, "MOV", []Operand{AX, MustMem(BP, nil, 0, 0)}, []byte{0x8b, 0x46, 0x00}},
+		{Mode16, "MOV", []Operand{AX, MustMem(DI, nil, 0, 0x300)}, []byte{0x8b, 0x85, 0x00, 0x03}},
+		{Mode16, "MOV", []Operand{EAX, MustMem(EBX, ESI, 4, 8)}, []byte{0x67, 0x66, 0x8b, 0x44, 0xb3, 0x08}},
+		{Mode16, "MOV", []Operand{AL, Abs(0x1000)}, []byte{0xa0, 0x00, 0x10}},
+		{Mode16, "PUSHA", nil, []byte{0x60}},
+		{Mode16, "JCXZ", []Operand{Rel(0)}, []byte{0xe3, 0x00}},
+	}
+	for _, tt := range tests {
+		got, err := tt.mode.Encode(tt.name, tt.ops...)
+		if err != nil {
+			t.Errorf("%s: %s %s: %v", tt.mode, tt.name, formatOperands(tt.ops), err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: %s %s = % x, want % x", tt.mode, tt.name, formatOperands(tt.ops), got, tt.want)
+		}
+		inst, err := tt.mode.Decode(tt.want)
+		if err != nil {
+			t.Errorf("%s: Decode(% x): %v", tt.mode, tt.want, err)
+			continue
+		}
+		if want := tt.name + " " + formatOperands(tt.ops); inst.Len != len(tt.want) || inst.String() != strings.TrimSpace(want) {
+			t.Errorf("%s: Decode(% x) = %s, length %d, want %s", tt.mode, tt.want, inst, inst.Len, want)
+		}
+	}
+
+	// Outside 64-bit mode, 40-4F are INC and DEC, and C5 is LDS unless a
+	// register follows.
+	for _, tt := range []struct {
+		mode Mode
+		code []byte
+		want string
+	}{
+		{Mode32, []byte{0x48, 0x01, 0xc3}, "DEC EAX"},
+		{Mode32, []byte{0xc5, 0x00}, "LDS EAX, [EAX]"},
+		{Mode32, []byte{0xc5, 0xf8, 0x58, 0xc1}, "VADDPS XMM0, XMM0, XMM1"},
+		{Mode16, []byte{0x8b, 0x46, 0x00}, "MOV AX, [BP]"},
+	} {
+		if inst, err := tt.mode.Decode(tt.code); err != nil || inst.String() != tt.want {
+			t.Errorf("%s: Decode(% x) = %v, %v, want %s", tt.mode, tt.code, inst, err, tt.want)
+		}
+	}
+}
+
+func TestModeErrors(t *testing.T) {
+	tests := []struct {
+		mode Mode
+		name string
+		ops  []Operand
+	}{
+		{Mode32, "ADD", []Operand{RAX, Imm(1)}},
+		{Mode32, "ADD", []Operand{R8D, Imm(1)}},
+		{Mode32, "MOV", []Operand{EAX, MustMem(RAX, nil, 0, 0)}},
+		{Mode32, "MOV", []Operand{EAX, RIPRel(0)}},
+		{Mode32, "MOV", []Operand{SIL, AL}},
+		{Mode32, "VADDPS", []Operand{XMM8, XMM1, XMM2}},
+		{Mode32, "SYSCALL", nil},
+		{Mode16, "MOV", []Operand{EAX, MustMem(R9D, nil, 0, 0)}},
+		{Mode64, "AAA", nil},
+		{Mode64, "MOV", []Operand{AX, MustMem(BX, SI, 1, 0)}},
+		{Mode(8), "NOP", nil},
+	}
+	for _, tt := range tests {
+		if got, err := tt.mode.Encode(tt.name, tt.ops...); err == nil {
+			t.Errorf("%s: %s %s = % x, want error", tt.mode, tt.name, formatOperands(tt.ops), got)
+		}
+	}
+}
+
+func TestBuilderMode(t *testing.T) {
+	b := Builder{Mode: Mode32}
+	b.PUSH_O(EBP)
+	b.MOV_MR(EBP, ESP)
+	b.MOV_RM(EAX, MustMem(EBP, nil, 0, 8))
+	b.Label("loop")
+	b.DEC_M(EAX)
+	b.JNZ(Label("loop"))
+	b.POP_O(EBP)
+	b.RET_NP()
+	code, err := b.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x55, 0x89, 0xe5, 0x8b, 0x45, 0x08, 0x48, 0x75, 0xfd, 0x5d, 0xc3}
+	if !bytes.Equal(code, want) {
+		t.Errorf("Encode = % x, want % x", code, want)
+	}
+
+	a := &GnuAsm{Funcs: []GnuFunc{{Name: "f", Code: &b}}}
+	var buf bytes.Buffer
+	if err := a.WriteAsm(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"\t.code32\n", "\tpushl %ebp\n", "\tretl\n"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("WriteAsm output lacks %q:\n%s", s, buf.String())
+		}
+	}
+
+	g := &GoAsm{Package: "p", Funcs: []GoFunc{{Name: "f", Signature: "()", Code: &b}}}
+	if err := g.WriteAsm(&buf); err == nil {
+		t.Error("GoAsm.WriteAsm of 32-bit code succeeded")
+	}
+}
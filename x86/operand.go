@@ -0,0 +1,89 @@
+package x86
+
+import "fmt"
+
+// Operand is a single instruction operand. Every parameter of the generated
+// instruction functions is an Operand, or one of the narrower interfaces and
+// types below, so that passing the wrong kind of value is a compile error.
+type Operand interface {
+	String() string
+	isOperand()
+}
+
+// Register is any register operand. Each register file has its own type;
+// the register values themselves are generated in generated_registers.go.
+type Register interface {
+	Operand
+	Info() RegInfo
+	isRegister()
+}
+
+// RegMem is an operand that may be encoded in the r/m field of a ModRM byte:
+// either a register or a memory reference.
+type RegMem interface {
+	Operand
+	isRegMem()
+}
+
+// Reg is a general purpose register.
+type Reg uint8
+
+// VecReg is a vector register: XMM, YMM or ZMM.
+type VecReg uint8
+
+// MaskReg is an AVX-512 opmask register.
+type MaskReg uint8
+
+// SegReg is a segment register.
+type SegReg uint8
+
+// X87Reg is an x87 floating point stack register.
+type X87Reg uint8
+
+// Imm is an immediate operand.
+type Imm int64
+
+// Rel is a branch target, expressed as a displacement from the end of the
+// instruction.
+type Rel int32
+
+// Target is the operand of a relative branch: a Rel, or a Label of a
+// Builder program that is resolved when the program is encoded.
+type Target interface {
+	Operand
+	isTarget()
+}
+
+// Label is a named position in a Builder program, placed with
+// Builder.Label. Branches may refer to a label before it is placed.
+type Label string
+
+func (Reg) isOperand()     {}
+func (VecReg) isOperand()  {}
+func (MaskReg) isOperand() {}
+func (SegReg) isOperand()  {}
+func (X87Reg) isOperand()  {}
+func (Mem) isOperand()     {}
+func (Imm) isOperand()     {}
+func (Rel) isOperand()     {}
+func (Label) isOperand()   {}
+
+func (Rel) isTarget()   {}
+func (Label) isTarget() {}
+
+func (Reg) isRegister()     {}
+func (VecReg) isRegister()  {}
+func (MaskReg) isRegister() {}
+func (SegReg) isRegister()  {}
+func (X87Reg) isRegister()  {}
+
+func (Reg) isRegMem()     {}
+func (VecReg) isRegMem()  {}
+func (MaskReg) isRegMem() {}
+func (Mem) isRegMem()     {}
+
+func (i Imm) String() string { return fmt.Sprintf("%#x", int64(i)) }
+
+func (r Rel) String() string { return fmt.Sprintf(".%+#x", int32(r)) }
+
+func (l Label) String() string { return string(l) }
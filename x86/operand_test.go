@@ -0,0 +1,85 @@
+package x86
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestOperandTypes type-checks calls of the generated functions against the
+// package, to check that a register of the wrong file is a compile error.
+func TestOperandTypes(t *testing.T) {
+	fset := token.NewFileSet()
+	names, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*ast.File
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+
+	tests := []struct {
+		call string
+		ok   bool
+	}{
+		{"ADD_MR(RAX, RBX)", true},
+		{"ADD_MR(MustMem(RAX, nil, 0, 0), RBX)", true},
+		{"ADD_MR(RAX, XMM0)", false},
+		{"ADD_MR(RAX, K1)", false},
+		{"ADDPD(XMM0, XMM1)", true},
+		{"ADDPD(RAX, XMM1)", false},
+		{"ANDN(RAX, RBX, RCX)", true},
+		{"ANDN(RAX, XMM1, RCX)", false},
+		{"BNDMOV_RM(BND0, BND1)", true},
+		{"BNDMOV_RM(RAX, BND1)", false},
+		{"VADDPD_FV(Mask(ZMM0, K1), ZMM1, ZMM2)", true},
+		{"VADDPD_FV(ZMM0, RAX, ZMM2)", false},
+	}
+	// The calls are on the lines from the fourth on.
+	src := "package x86\n\nfunc _() {\n"
+	for _, test := range tests {
+		src += "\t" + test.call + "\n"
+	}
+	f, err := parser.ParseFile(fset, "calls.go", src+"}\n", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := map[int]bool{}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			if pos := fset.Position(err.(types.Error).Pos); pos.Filename == "calls.go" {
+				failed[pos.Line-4] = true
+			} else {
+				t.Error(err)
+			}
+		},
+	}
+	conf.Check("github.com/dave/asm/x86", fset, append(files, f), nil)
+	for i, test := range tests {
+		if test.ok && failed[i] {
+			t.Errorf("%s does not compile", test.call)
+		}
+		if !test.ok && !failed[i] {
+			t.Errorf("%s compiles", test.call)
+		}
+	}
+}
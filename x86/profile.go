@@ -0,0 +1,122 @@
+package x86
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Profile is a named set of features: a level of the x86-64 psABI, which
+// GOAMD64 selects, or a processor core. Set a Builder's Features to those
+// of a profile to restrict it to the instructions the profile runs.
+//
+// A profile only restricts the instructions that the manual gives a CPUID
+// feature flag, so POPCNT and MOVBE, for instance, are allowed in every
+// profile.
+type Profile struct {
+	Name     string // e.g. "x86-64-v3" or "haswell"
+	Features Features
+}
+
+var (
+	featuresV1 = NewFeatures(Feature486, FeaturePentium, FeaturePentiumII, FeatureMMX, FeatureSSE, FeatureSSE2)
+	featuresV2 = featuresV1.With(FeatureSSE3, FeatureSSSE3, FeatureSSE41, FeatureSSE42)
+	featuresV3 = featuresV2.With(FeatureAVX, FeatureAVX2, FeatureBMI1, FeatureBMI2, FeatureF16C, FeatureFMA, FeatureLZCNT)
+	featuresV4 = featuresV3.With(FeatureAVX512F, FeatureAVX512BW, FeatureAVX512CD, FeatureAVX512DQ, FeatureAVX512VL)
+
+	featuresHaswell = featuresV3.With(FeatureAES, FeaturePCLMULQDQ, FeatureRDRAND, FeatureFSGSBASE, FeatureINVPCID, FeatureXSAVEOPT)
+	featuresSkylake = featuresHaswell.With(FeatureADX, FeatureRDSEED, FeaturePRFCHW)
+)
+
+// profiles lists the known profiles, levels first.
+var profiles = []Profile{
+	{"x86-64-v1", featuresV1},
+	{"x86-64-v2", featuresV2},
+	{"x86-64-v3", featuresV3},
+	{"x86-64-v4", featuresV4},
+	{"nehalem", featuresV2},
+	{"haswell", featuresHaswell},
+	{"skylake", featuresSkylake},
+	{"skylake-avx512", featuresSkylake.With(FeatureAVX512F, FeatureAVX512BW, FeatureAVX512CD, FeatureAVX512DQ, FeatureAVX512VL)},
+	{"icelake-server", featuresSkylake.With(FeatureAVX512F, FeatureAVX512BW, FeatureAVX512CD, FeatureAVX512DQ, FeatureAVX512VL,
+		FeatureAVX512VBMI, FeatureAVX512VBMI2, FeatureAVX512IFMA, FeatureAVX512VNNI, FeatureAVX512BITALG, FeatureAVX512VPOPCNTDQ,
+		FeatureGFNI, FeatureVAES, FeatureVPCLMULQDQ, FeatureSHA, FeatureRDPID)},
+	{"znver2", featuresV3.With(FeatureAES, FeaturePCLMULQDQ, FeatureSHA, FeatureRDRAND, FeatureRDSEED, FeatureRDPID, FeatureADX, FeatureFSGSBASE, FeatureXSAVEOPT, FeaturePRFCHW)},
+}
+
+// Profiles returns the known profiles: the x86-64 levels, then the cores
+// nehalem, haswell, skylake, skylake-avx512, icelake-server and znver2,
+// named as by GCC's -march.
+func Profiles() []Profile {
+	return append([]Profile(nil), profiles...)
+}
+
+var profileAliases = map[string]string{
+	"x86-64": "x86-64-v1",
+	"v1":     "x86-64-v1",
+	"v2":     "x86-64-v2",
+	"v3":     "x86-64-v3",
+	"v4":     "x86-64-v4",
+}
+
+// LookupProfile returns the profile with the given name. The levels may
+// also be named by their GOAMD64 value, e.g. "v3", and x86-64-v1 as
+// "x86-64".
+func LookupProfile(name string) (Profile, bool) {
+	if alias, ok := profileAliases[name]; ok {
+		name = alias
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+// GOAMD64 returns the profile of a GOAMD64 setting, "v1" to "v4". An empty
+// setting is v1, as for the go command, so that
+//
+//	p, err := x86.GOAMD64(os.Getenv("GOAMD64"))
+//
+// restricts a generator to the level of the build it is part of.
+func GOAMD64(level string) (Profile, error) {
+	if level == "" {
+		level = "v1"
+	}
+	if p, ok := LookupProfile(level); ok && strings.HasPrefix(level, "v") {
+		return p, nil
+	}
+	return Profile{}, fmt.Errorf("x86: GOAMD64=%s is not v1, v2, v3 or v4", level)
+}
+
+// Unsupported returns the generated functions, sorted by name, that have
+// no 64-bit mode form the profile runs.
+func (p Profile) Unsupported() []string {
+	formsOnce.Do(indexForms)
+	runs := map[string]bool{}
+	for i := range forms {
+		f := &forms[i]
+		if !Mode64.valid(f) || f.Func == "" {
+			continue
+		}
+		ok := f.RunsOn(p.Features)
+		for _, fn := range []string{f.Func, f.Sized} {
+			if fn != "" {
+				runs[fn] = runs[fn] || ok
+			}
+		}
+	}
+	var names []string
+	for fn, ok := range runs {
+		if !ok {
+			names = append(names, fn)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p Profile) String() string {
+	return p.Name
+}
@@ -0,0 +1,59 @@
+package x86
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestProfiles(t *testing.T) {
+	v3, err := GOAMD64("v3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v3.Name != "x86-64-v3" || !v3.Features.Has(FeatureAVX2) || v3.Features.Has(FeatureAVX512F) {
+		t.Errorf("GOAMD64(v3) = %s: %s", v3, v3.Features)
+	}
+	if p, err := GOAMD64(""); err != nil || p.Name != "x86-64-v1" {
+		t.Errorf("GOAMD64(\"\") = %s, %v", p, err)
+	}
+	for _, level := range []string{"v5", "haswell", "x86-64-v2"} {
+		if _, err := GOAMD64(level); err == nil {
+			t.Errorf("GOAMD64(%s) succeeded", level)
+		}
+	}
+
+	// Each level and core runs everything the level before it does.
+	ps := Profiles()
+	for i := 1; i < 4; i++ {
+		if !ps[i].Features.Contains(ps[i-1].Features) {
+			t.Errorf("%s lacks features of %s", ps[i], ps[i-1])
+		}
+	}
+	haswell, ok := LookupProfile("haswell")
+	if !ok || !haswell.Features.Contains(v3.Features) || !haswell.Features.Has(FeatureAES) {
+		t.Errorf("LookupProfile(haswell) = %s, %v", haswell.Features, ok)
+	}
+
+	unsupported := v3.Unsupported()
+	has := func(fn string) bool {
+		i := sort.SearchStrings(unsupported, fn)
+		return i < len(unsupported) && unsupported[i] == fn
+	}
+	for fn, want := range map[string]bool{"VPMAXSQ": true, "VPMAXSD_RVM": false, "ADD_MI": false, "AESENC": true} {
+		if has(fn) != want {
+			t.Errorf("%s unsupported by %s: %v, want %v", fn, v3, has(fn), want)
+		}
+	}
+	v4, _ := LookupProfile("v4")
+	for _, fn := range v4.Unsupported() {
+		if fn == "VPMAXSQ" {
+			t.Errorf("VPMAXSQ unsupported by %s", v4)
+		}
+	}
+
+	b := Builder{Features: v3.Features}
+	b.VPMAXSQ(XMM1, XMM2, XMM3)
+	if _, err := b.Encode(); err == nil {
+		t.Errorf("Encode of VPMAXSQ for %s succeeded", v3)
+	}
+}
@@ -0,0 +1,91 @@
+package x86
+
+import "strconv"
+
+// RegClass identifies the register file a register belongs to.
+type RegClass uint8
+
+const (
+	ClassNone  RegClass = iota
+	ClassGP             // general purpose: AL ... R15
+	ClassIP             // instruction pointer: EIP, RIP
+	ClassVec            // vector: XMM, YMM and ZMM
+	ClassMask           // AVX-512 opmask: K0 ... K7
+	ClassSeg            // segment: ES, CS, SS, DS, FS, GS
+	ClassX87            // x87 stack: ST(0) ... ST(7)
+	ClassMMX            // MMX: MM0 ... MM7
+	ClassCtrl           // control: CR0, CR2 ... CR4 and CR8
+	ClassDebug          // debug: DR0 ... DR7
+	ClassBnd            // MPX bound: BND0 ... BND3
+)
+
+// RegInfo describes a register. The table of all registers is generated
+// from the register files known to x86spec.
+type RegInfo struct {
+	Name  string
+	Class RegClass
+	Width int   // width in bits
+	Num   uint8 // encoding number
+	REX   bool  // can only be encoded with a REX prefix
+	NoREX bool  // can not be encoded when a REX prefix is present
+	EVEX  bool  // can only be encoded with an EVEX prefix
+}
+
+// MMXReg is an MMX register.
+type MMXReg uint8
+
+// CtrlReg is a control register.
+type CtrlReg uint8
+
+// DebugReg is a debug register.
+type DebugReg uint8
+
+// BndReg is an MPX bound register.
+type BndReg uint8
+
+func (MMXReg) isOperand()   {}
+func (CtrlReg) isOperand()  {}
+func (DebugReg) isOperand() {}
+func (BndReg) isOperand()   {}
+
+func (MMXReg) isRegister()   {}
+func (CtrlReg) isRegister()  {}
+func (DebugReg) isRegister() {}
+func (BndReg) isRegister()   {}
+
+func (MMXReg) isRegMem() {}
+func (BndReg) isRegMem() {}
+
+func (r Reg) Info() RegInfo      { return regInfo(uint8(r)) }
+func (r VecReg) Info() RegInfo   { return regInfo(uint8(r)) }
+func (r MaskReg) Info() RegInfo  { return regInfo(uint8(r)) }
+func (r SegReg) Info() RegInfo   { return regInfo(uint8(r)) }
+func (r X87Reg) Info() RegInfo   { return regInfo(uint8(r)) }
+func (r MMXReg) Info() RegInfo   { return regInfo(uint8(r)) }
+func (r CtrlReg) Info() RegInfo  { return regInfo(uint8(r)) }
+func (r DebugReg) Info() RegInfo { return regInfo(uint8(r)) }
+func (r BndReg) Info() RegInfo   { return regInfo(uint8(r)) }
+
+func (r Reg) String() string      { return regString("Reg", uint8(r)) }
+func (r VecReg) String() string   { return regString("VecReg", uint8(r)) }
+func (r MaskReg) String() string  { return regString("MaskReg", uint8(r)) }
+func (r SegReg) String() string   { return regString("SegReg", uint8(r)) }
+func (r X87Reg) String() string   { return regString("X87Reg", uint8(r)) }
+func (r MMXReg) String() string   { return regString("MMXReg", uint8(r)) }
+func (r CtrlReg) String() string  { return regString("CtrlReg", uint8(r)) }
+func (r DebugReg) String() string { return regString("DebugReg", uint8(r)) }
+func (r BndReg) String() string   { return regString("BndReg", uint8(r)) }
+
+func regInfo(r uint8) RegInfo {
+	if int(r) < len(registers) {
+		return registers[r]
+	}
+	return RegInfo{}
+}
+
+func regString(typ string, r uint8) string {
+	if name := regInfo(r).Name; name != "" {
+		return name
+	}
+	return typ + "(" + strconv.Itoa(int(r)) + ")"
+}